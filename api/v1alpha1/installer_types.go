@@ -54,6 +54,14 @@ type SyncStatus struct {
 	Health        HealthStatus         `json:"health,omitempty" protobuf:"bytes,6,opt,name=health"`
 	History       RevisionHistories    `json:"history,omitempty" protobuf:"bytes,7,opt,name=history"`
 	StatusMessage SyncStatusMessage    `json:"status_message" protobuf:"bytes,8,opt,name=statusMessage,casttype=SyncStatusMessage"`
+	// Exit is set when the script was ended by an exit statement
+	Exit *ExitStatus `json:"exit,omitempty" protobuf:"bytes,9,opt,name=exit"`
+}
+
+// ExitStatus holds the code and the line of the exit statement which ended the script
+type ExitStatus struct {
+	Code int `json:"code" protobuf:"varint,1,opt,name=code"`
+	Line int `json:"line" protobuf:"varint,2,opt,name=line"`
 }
 
 // ResourceStatus holds the current sync and health status of a resource
@@ -82,6 +90,7 @@ const (
 	SyncStatusCodeOutOfSync  SyncStatusCode = "OutOfSync"
 	SyncStatusCodeApplied    SyncStatusCode = "Applied"
 	SyncStatusCodeDownloaded SyncStatusCode = "Downloaded"
+	SyncStatusCodeFailed     SyncStatusCode = "Failed"
 )
 
 type SyncStatusMessage string
@@ -92,6 +101,7 @@ const (
 	SyncStatusMessageOutOfSync  SyncStatusMessage = "OutOfSync - URL change has been detected, new manifest will be downloaded and status will be changed to Downloaded"
 	SyncStatusMessageApplied    SyncStatusMessage = "Applied- Manifest as been Applied  and system is ready to use with newer version"
 	SyncStatusMessageDownloaded SyncStatusMessage = "Downloaded - Installation manifest been downloaded and now it will be applied, it will take approx 30 mins for it to complete. Once it is complete status will change to Applied"
+	SyncStatusMessageFailed     SyncStatusMessage = "Failed - Script has errors, exited with a non zero code or an uncaught error, check conditions for details. Set reSync to run it again"
 )
 
 // InstallerCondition contains details about current application condition
@@ -129,6 +139,8 @@ const (
 	InstallerConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// InstallerConditionOrphanedResourceWarning indicates that application has orphaned resources
 	InstallerConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// InstallerConditionScriptWarning indicates that the script has mistakes which did not stop it from running
+	InstallerConditionScriptWarning = "ScriptWarning"
 )
 
 // RevisionHistories is a array of history, oldest first and newest last
@@ -39,6 +39,21 @@ func (in *ApplicationSource) DeepCopy() *ApplicationSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExitStatus) DeepCopyInto(out *ExitStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExitStatus.
+func (in *ExitStatus) DeepCopy() *ExitStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExitStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthStatus) DeepCopyInto(out *HealthStatus) {
 	*out = *in
@@ -250,6 +265,11 @@ func (in *SyncStatus) DeepCopyInto(out *SyncStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Exit != nil {
+		in, out := &in.Exit, &out.Exit
+		*out = new(ExitStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncStatus.
@@ -0,0 +1,62 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/devtron-labs/inception/pkg/language"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+	"io"
+	"os"
+)
+
+// check prints the syntax errors and diagnostics of every file, it returns 1 if any file has errors
+func check(files []string) int {
+	if len(files) == 0 {
+		fmt.Fprint(os.Stderr, usage)
+		return 2
+	}
+	code := 0
+	for _, file := range files {
+		script, err := readFile(file)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			code = 1
+			continue
+		}
+		if _, ok := parseAndCheck(os.Stdout, file, script, language.NewKlangListener(nil)); !ok {
+			code = 1
+		}
+	}
+	return code
+}
+
+// parseAndCheck prints the syntax errors or the diagnostics of script, it is false if script must not be run
+func parseAndCheck(w io.Writer, file string, script string, listener *language.KlangListener) (parser.IParseContext, bool) {
+	tree, syntaxErrors := language.Parse(script)
+	if len(syntaxErrors) != 0 {
+		for _, e := range syntaxErrors {
+			fmt.Fprintf(w, "%s:%d:%d: error: %s\n", file, e.Line, e.Column, e.Message)
+		}
+		return nil, false
+	}
+	diagnostics := listener.Check(tree)
+	for _, d := range diagnostics {
+		fmt.Fprintf(w, "%s:%d:%d: %s: %s\n", file, d.Line, d.Column, d.Severity, d.Message)
+	}
+	return tree, !language.HasErrors(diagnostics)
+}
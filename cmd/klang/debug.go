@@ -0,0 +1,103 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/devtron-labs/inception/pkg/language"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+	"os"
+	"strings"
+)
+
+var (
+	ruleNames     = parser.NewKlangParser(nil).RuleNames
+	symbolicNames = parser.NewKlangLexer(nil).SymbolicNames
+	literalNames  = parser.NewKlangLexer(nil).LiteralNames
+)
+
+// tokens prints every token of the lexer with its position and type, comments are marked as hidden
+func tokens(args []string) int {
+	_, script, ok := readScript(args)
+	if !ok {
+		return 2
+	}
+	lexer := parser.NewKlangLexer(antlr.NewInputStream(script))
+	for t := lexer.NextToken(); t.GetTokenType() != antlr.TokenEOF; t = lexer.NextToken() {
+		name := tokenName(t.GetTokenType())
+		if t.GetChannel() == antlr.TokenHiddenChannel {
+			name += " (hidden)"
+		}
+		fmt.Printf("%d:%d\t%s\t%q\n", t.GetLine(), t.GetColumn(), name, t.GetText())
+	}
+	return 0
+}
+
+// tree prints the parse tree with a rule or token per line, syntax errors are printed on stderr
+func tree(args []string) int {
+	file, script, ok := readScript(args)
+	if !ok {
+		return 2
+	}
+	t, syntaxErrors := language.Parse(script)
+	printTree(t, 0)
+	for _, e := range syntaxErrors {
+		fmt.Fprintf(os.Stderr, "%s:%d:%d: error: %s\n", file, e.Line, e.Column, e.Message)
+	}
+	if len(syntaxErrors) != 0 {
+		return 1
+	}
+	return 0
+}
+
+func printTree(t antlr.Tree, depth int) {
+	indent := strings.Repeat("  ", depth)
+	switch v := t.(type) {
+	case antlr.TerminalNode:
+		fmt.Printf("%s%s %q\n", indent, tokenName(v.GetSymbol().GetTokenType()), v.GetText())
+		return
+	case antlr.RuleContext:
+		fmt.Printf("%s%s\n", indent, ruleName(v))
+	}
+	for _, child := range t.GetChildren() {
+		printTree(child, depth+1)
+	}
+}
+
+// ruleName adds the label of the alternative, like expr:AdditiveExpr, to the name of the rule
+func ruleName(ctx antlr.RuleContext) string {
+	name := ruleNames[ctx.GetRuleIndex()]
+	label := strings.TrimSuffix(strings.TrimPrefix(fmt.Sprintf("%T", ctx), "*parser."), "Context")
+	if strings.EqualFold(label, name) {
+		return name
+	}
+	return name + ":" + label
+}
+
+func tokenName(tokenType int) string {
+	if tokenType == antlr.TokenEOF {
+		return "EOF"
+	}
+	if tokenType < len(symbolicNames) && len(symbolicNames[tokenType]) != 0 {
+		return symbolicNames[tokenType]
+	}
+	if tokenType < len(literalNames) {
+		return literalNames[tokenType]
+	}
+	return fmt.Sprintf("%d", tokenType)
+}
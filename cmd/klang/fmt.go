@@ -0,0 +1,81 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/devtron-labs/inception/pkg/language"
+	"io/ioutil"
+	"os"
+)
+
+// format prints the files in the canonical format, with -w the files are rewritten instead and with -l
+// the files which are not formatted are listed and the exit code is 1 if there is any.
+// Without files the script is read from stdin.
+func format(args []string) int {
+	fs := flag.NewFlagSet("fmt", flag.ExitOnError)
+	write := fs.Bool("w", false, "write the result to the files instead of stdout")
+	list := fs.Bool("l", false, "list the files which are not formatted instead of printing them")
+	fs.Parse(args)
+	files := fs.Args()
+	if len(files) == 0 {
+		if *write {
+			fmt.Fprintln(os.Stderr, "cannot use -w with stdin")
+			return 2
+		}
+		files = []string{"-"}
+	}
+	code := 0
+	for _, file := range files {
+		script, err := readFile(file)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			code = 1
+			continue
+		}
+		formatted, err := language.Format(script)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", file, err)
+			code = 1
+			continue
+		}
+		if formatted == script && (*write || *list) {
+			continue
+		}
+		if *list {
+			fmt.Println(file)
+			code = 1
+			continue
+		}
+		if !*write {
+			fmt.Print(formatted)
+			continue
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			code = 1
+			continue
+		}
+		if err := ioutil.WriteFile(file, []byte(formatted), info.Mode()); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			code = 1
+		}
+	}
+	return code
+}
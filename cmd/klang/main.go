@@ -0,0 +1,95 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// klang runs, checks and formats Klang scripts outside of the controller
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+)
+
+const usage = `usage: klang <command> [arguments]
+
+commands:
+  run [-var name=value]... [-json-var name=json]... [-kubeconfig path] <file>
+                   run a script against the cluster of the kubeconfig
+  check <file>...  report syntax errors and mistakes found without running the scripts
+  fmt [-w] [-l] [file]...
+                   print scripts in the canonical format, rewrite them with -w or
+                   list the ones not formatted with -l
+  repl [-dry-run] [-kubeconfig path]
+                   evaluate statements and expressions typed line by line, with -dry-run
+                   kubectl commands are printed instead of being sent to the cluster
+  lsp              serve the language server protocol on stdin and stdout
+  tokens <file>    print the tokens of the lexer
+  ast <file>       print the parse tree
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	args := os.Args[2:]
+	switch os.Args[1] {
+	case "run":
+		os.Exit(run(args))
+	case "check":
+		os.Exit(check(args))
+	case "fmt":
+		os.Exit(format(args))
+	case "repl":
+		os.Exit(repl(args))
+	case "lsp":
+		os.Exit(lspServer(args))
+	case "tokens":
+		os.Exit(tokens(args))
+	case "ast":
+		os.Exit(tree(args))
+	case "help", "-h", "--help":
+		fmt.Print(usage)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %s\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+}
+
+// readScript reads a single file argument, - reads the script from stdin
+func readScript(args []string) (string, string, bool) {
+	if len(args) != 1 {
+		fmt.Fprint(os.Stderr, usage)
+		return "", "", false
+	}
+	script, err := readFile(args[0])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return "", "", false
+	}
+	return args[0], script, true
+}
+
+func readFile(file string) (string, error) {
+	var content []byte
+	var err error
+	if file == "-" {
+		content, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		content, err = ioutil.ReadFile(file)
+	}
+	return string(content), err
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/devtron-labs/inception/pkg/language"
+	log "github.com/sirupsen/logrus"
+	"os"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// repl evaluates the lines typed on stdin, with -dry-run kubectl commands are printed and no cluster is needed
+func repl(args []string) int {
+	fs := flag.NewFlagSet("repl", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "print kubectl commands instead of sending them to the cluster")
+	kubeconfig := fs.String("kubeconfig", "", "path to the kubeconfig, $KUBECONFIG or ~/.kube/config if not set")
+	fs.Parse(args)
+	if len(fs.Args()) != 0 {
+		fmt.Fprint(os.Stderr, usage)
+		return 2
+	}
+	if len(*kubeconfig) != 0 {
+		os.Setenv("KUBECONFIG", *kubeconfig)
+	}
+	var mapper *language.Mapper
+	if !*dryRun {
+		//the mapper exits without a message if there is no kubeconfig
+		if _, err := ctrl.GetConfig(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		mapper = language.NewMapperFactory()
+	}
+	//messages logged at info level would be mixed with the output
+	log.SetLevel(log.WarnLevel)
+	prompt := false
+	if info, err := os.Stdin.Stat(); err == nil {
+		prompt = info.Mode()&os.ModeCharDevice != 0
+	}
+	return language.NewRepl(mapper, *dryRun, os.Stdout).Run(os.Stdin, prompt)
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/devtron-labs/inception/pkg/language"
+	"os"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sort"
+	"strings"
+)
+
+// variables collects name=value flags, values are strings unless decoded as json
+type variables struct {
+	json   bool
+	values map[string]interface{}
+}
+
+func (v *variables) String() string {
+	return ""
+}
+
+func (v *variables) Set(s string) error {
+	i := strings.Index(s, "=")
+	if i <= 0 {
+		return fmt.Errorf("expected name=value, found %q", s)
+	}
+	name, value := s[:i], s[i+1:]
+	if !v.json {
+		v.values[name] = value
+		return nil
+	}
+	var decoded interface{}
+	d := json.NewDecoder(bytes.NewReader([]byte(value)))
+	d.UseNumber()
+	if err := d.Decode(&decoded); err != nil {
+		return fmt.Errorf("invalid json value of %s, %v", name, err)
+	}
+	v.values[name] = decoded
+	return nil
+}
+
+// run executes a script against the cluster of the kubeconfig, the exit code is the code of its exit statement
+func run(args []string) int {
+	values := make(map[string]interface{})
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	fs.Var(&variables{values: values}, "var", "set the variable name to a string, name=value, can be repeated")
+	fs.Var(&variables{json: true, values: values}, "json-var", "set the variable name to a json value, name=json, can be repeated")
+	kubeconfig := fs.String("kubeconfig", "", "path to the kubeconfig, $KUBECONFIG or ~/.kube/config if not set")
+	fs.Parse(args)
+	file, script, ok := readScript(fs.Args())
+	if !ok {
+		return 2
+	}
+	if len(*kubeconfig) != 0 {
+		os.Setenv("KUBECONFIG", *kubeconfig)
+	}
+	//the mapper exits without a message if there is no kubeconfig
+	if _, err := ctrl.GetConfig(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	listener := language.NewKlangListener(language.NewMapperFactory())
+	if file != "-" {
+		listener.SetLocation(file)
+	}
+	for name, value := range values {
+		if err := listener.SetValue(name, value); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 2
+		}
+	}
+	tree, ok := parseAndCheck(os.Stderr, file, script, listener)
+	if !ok {
+		return 1
+	}
+	listener.Run(tree)
+	printResources(listener)
+	if err := listener.Err(); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: script aborted, %v\n", file, err)
+		return 1
+	}
+	if exit := listener.Exit(); exit != nil {
+		return exit.Code
+	}
+	return 0
+}
+
+// printResources lists the resources changed by the script on stderr, stdout has the output of log statements
+func printResources(listener *language.KlangListener) {
+	var lines []string
+	for _, resources := range listener.KubernetesResources() {
+		for _, r := range resources {
+			line := fmt.Sprintf("%s %s/%s", r.Operation, strings.ToLower(r.Kind), r.Name)
+			if len(r.Namespace) != 0 {
+				line += " -n " + r.Namespace
+			}
+			line += " " + string(r.Status)
+			if len(r.Message) != 0 {
+				line += ", " + r.Message
+			}
+			lines = append(lines, line)
+		}
+	}
+	sort.Strings(lines)
+	for _, line := range lines {
+		fmt.Fprintln(os.Stderr, line)
+	}
+}
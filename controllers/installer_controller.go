@@ -23,10 +23,8 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"github.com/antlr/antlr4/runtime/Go/antlr"
 	installerv1alpha1 "github.com/devtron-labs/inception/api/v1alpha1"
 	"github.com/devtron-labs/inception/pkg/language"
-	parser2 "github.com/devtron-labs/inception/pkg/language/parser"
 	"github.com/go-logr/logr"
 	"github.com/patrickmn/go-cache"
 	"github.com/posthog/posthog-go"
@@ -110,6 +108,7 @@ const (
 	SpecChanged ObjectEventType = "SpecChanged"
 	Downloaded  ObjectEventType = "Downloaded"
 	Applied     ObjectEventType = "Applied"
+	ApplyFailed ObjectEventType = "ApplyFailed"
 )
 
 func (r *InstallerReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
@@ -153,6 +152,9 @@ func (r *InstallerReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		r.apply(installer)
 		updated = true
 		objectEventType = Applied
+		if installer.Status.Sync.Status == installerv1alpha1.SyncStatusCodeFailed {
+			objectEventType = ApplyFailed
+		}
 	}
 
 	//TODO - setup correct event trigger points
@@ -202,12 +204,16 @@ func (r *InstallerReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 			} else if installEvent == 1 && objectEventType == Applied {
 				payload.EventType = InstallationSuccess
 				installEvent = 2
+			} else if installEvent == 1 && objectEventType == ApplyFailed {
+				payload.EventType = InstallationFailure
 			} else if installEvent == 2 && objectEventType == SpecChanged {
 				payload.EventType = UpgradeStart
 			} else if installEvent == 2 && objectEventType == Downloaded {
 				payload.EventType = UpgradeInProgress
 			} else if installEvent == 2 && objectEventType == Applied {
 				payload.EventType = UpgradeSuccess
+			} else if installEvent == 2 && objectEventType == ApplyFailed {
+				payload.EventType = UpgradeFailure
 			}
 			if installEvent == -1 {
 				payload.EventType = InstallationInternalApplicationError
@@ -280,14 +286,21 @@ func (r *InstallerReconciler) sync(installer *installerv1alpha1.Installer) error
 func (r *InstallerReconciler) apply(installer *installerv1alpha1.Installer) *language.KlangListener {
 	url := installer.Status.Sync.URL
 	data := installer.Status.Sync.Data
-	//parse and process data
-	is := antlr.NewInputStream(data)
-	lexer := parser2.NewKlangLexer(is)
-	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
-	p := parser2.NewKlangParser(stream)
-	p.BuildParseTrees = true
+	//parse and process data, nothing is run if the script has syntax errors
+	tree, syntaxErrors := language.Parse(data)
+	if len(syntaxErrors) != 0 {
+		updateInvalidSpecStatus(installer, fmt.Sprintf("script has %d syntax errors, %s", len(syntaxErrors), language.JoinSyntaxErrors(syntaxErrors)))
+		return nil
+	}
+	//mistakes found by the checker stop the script before it touches the cluster, warnings are only reported
 	listener := language.NewKlangListener(r.Mapper)
-	antlr.ParseTreeWalkerDefault.Walk(listener, p.Parse())
+	listener.SetLocation(url)
+	diagnostics := listener.Check(tree)
+	if language.HasErrors(diagnostics) {
+		updateInvalidSpecStatus(installer, fmt.Sprintf("script has errors, %s", language.JoinDiagnostics(diagnostics, language.SeverityError)))
+		return nil
+	}
+	listener.Run(tree)
 	//TODO: Use r.Parser.Values() to check data and get resources
 	//Update the status of resources
 	var resourceStatuses []installerv1alpha1.ResourceStatus
@@ -313,9 +326,77 @@ func (r *InstallerReconciler) apply(installer *installerv1alpha1.Installer) *lan
 	installer.Status.Sync.Status = installerv1alpha1.SyncStatusCodeApplied
 	installer.Status.Sync.StatusMessage = installerv1alpha1.SyncStatusMessageApplied
 	installer.Status.Sync.Resources = resourceStatuses
+	updateRunStatus(installer, listener)
+	updateWarningStatus(installer, diagnostics)
 	return listener
 }
 
+// updateRunStatus marks the run as failed if the script exited with a non zero code or was aborted by an uncaught error
+func updateRunStatus(installer *installerv1alpha1.Installer, listener *language.KlangListener) {
+	installer.Status.Sync.Exit = nil
+	message := ""
+	if exit := listener.Exit(); exit != nil {
+		installer.Status.Sync.Exit = &installerv1alpha1.ExitStatus{Code: exit.Code, Line: exit.Line}
+		if exit.Code != 0 {
+			message = fmt.Sprintf("script exited with code %d at line %d", exit.Code, exit.Line)
+		}
+	} else if listener.Err() != nil {
+		message = fmt.Sprintf("script aborted, %s", listener.Err().Error())
+	}
+	conditions := withoutRunConditions(installer.Status.Sync.Conditions)
+	if len(message) != 0 {
+		now := metav1.Now()
+		conditions = append(conditions, installerv1alpha1.InstallerCondition{
+			Type:               installerv1alpha1.InstallerConditionSyncError,
+			Message:            message,
+			LastTransitionTime: &now,
+		})
+		installer.Status.Sync.Status = installerv1alpha1.SyncStatusCodeFailed
+		installer.Status.Sync.StatusMessage = installerv1alpha1.SyncStatusMessageFailed
+	}
+	installer.Status.Sync.Conditions = conditions
+}
+
+// updateInvalidSpecStatus marks the run as failed because of mistakes found in the script before running it
+func updateInvalidSpecStatus(installer *installerv1alpha1.Installer, message string) {
+	installer.Status.Sync.Exit = nil
+	now := metav1.Now()
+	installer.Status.Sync.Conditions = append(withoutRunConditions(installer.Status.Sync.Conditions), installerv1alpha1.InstallerCondition{
+		Type:               installerv1alpha1.InstallerConditionInvalidSpecError,
+		Message:            message,
+		LastTransitionTime: &now,
+	})
+	installer.Status.Sync.Status = installerv1alpha1.SyncStatusCodeFailed
+	installer.Status.Sync.StatusMessage = installerv1alpha1.SyncStatusMessageFailed
+}
+
+// updateWarningStatus reports the warnings of the checker, they do not fail the run
+func updateWarningStatus(installer *installerv1alpha1.Installer, diagnostics []language.Diagnostic) {
+	warnings := language.JoinDiagnostics(diagnostics, language.SeverityWarning)
+	if len(warnings) == 0 {
+		return
+	}
+	now := metav1.Now()
+	installer.Status.Sync.Conditions = append(installer.Status.Sync.Conditions, installerv1alpha1.InstallerCondition{
+		Type:               installerv1alpha1.InstallerConditionScriptWarning,
+		Message:            fmt.Sprintf("script has warnings, %s", warnings),
+		LastTransitionTime: &now,
+	})
+}
+
+// withoutRunConditions drops the conditions set by the previous run
+func withoutRunConditions(conditions []installerv1alpha1.InstallerCondition) []installerv1alpha1.InstallerCondition {
+	var remaining []installerv1alpha1.InstallerCondition
+	for _, condition := range conditions {
+		switch condition.Type {
+		case installerv1alpha1.InstallerConditionSyncError, installerv1alpha1.InstallerConditionInvalidSpecError, installerv1alpha1.InstallerConditionScriptWarning:
+		default:
+			remaining = append(remaining, condition)
+		}
+	}
+	return remaining
+}
+
 func toSyncStatus(code language.ResourceSyncStatusCode) installerv1alpha1.SyncStatusCode {
 	switch code {
 	case language.ResourceSyncStatusCodeOutOfSync:
@@ -21,8 +21,10 @@ import (
 	"github.com/devtron-labs/inception/pkg/language"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
+	"reflect"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"strings"
 	"testing"
 )
 
@@ -82,6 +84,98 @@ func TestInstallerReconciler_apply(t *testing.T) {
 	}
 }
 
+func TestInstallerReconciler_runStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		status     installerv1alpha1.SyncStatusCode
+		exit       *installerv1alpha1.ExitStatus
+		conditions int
+		invalid    bool
+		messages   []string
+	}{
+		{
+			name:   "completed",
+			data:   "x = 1;",
+			status: installerv1alpha1.SyncStatusCodeApplied,
+		},
+		{
+			name:   "exit with zero code",
+			data:   "x = 1;\nexit 0;\nx = 2;",
+			status: installerv1alpha1.SyncStatusCodeApplied,
+			exit:   &installerv1alpha1.ExitStatus{Code: 0, Line: 2},
+			//the unreachable statement after exit is a warning
+			conditions: 1,
+			messages:   []string{"line 3:0 warning: unreachable code after exit"},
+		},
+		{
+			name:       "exit with non zero code",
+			data:       "x = 1;\nif x == 1 {\n  exit 4;\n}",
+			status:     installerv1alpha1.SyncStatusCodeFailed,
+			exit:       &installerv1alpha1.ExitStatus{Code: 4, Line: 3},
+			conditions: 1,
+		},
+		{
+			name:       "uncaught error",
+			data:       "throw \"failed\";",
+			status:     installerv1alpha1.SyncStatusCodeFailed,
+			conditions: 1,
+		},
+		{
+			name:       "syntax errors",
+			data:       "x = 1;\ny = ;\nz = 2 @ 3;",
+			status:     installerv1alpha1.SyncStatusCodeFailed,
+			conditions: 1,
+			invalid:    true,
+			messages:   []string{"line 2:4", "line 3:6"},
+		},
+		{
+			name:       "semantic errors",
+			data:       "x = 1;\nlog y;\nf(x);",
+			status:     installerv1alpha1.SyncStatusCodeFailed,
+			conditions: 1,
+			invalid:    true,
+			messages:   []string{"line 2:4 error: undefined variable y", "line 3:0 error: function f is not defined"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			installer := &installerv1alpha1.Installer{
+				Status: installerv1alpha1.InstallerStatus{
+					Sync: installerv1alpha1.SyncStatus{
+						Status: installerv1alpha1.SyncStatusCodeDownloaded,
+						Data:   tt.data,
+						Exit:   &installerv1alpha1.ExitStatus{Code: 1, Line: 1},
+						Conditions: []installerv1alpha1.InstallerCondition{
+							{Type: installerv1alpha1.InstallerConditionSyncError, Message: "previous run"},
+						},
+					},
+				},
+			}
+			r := &InstallerReconciler{}
+			listener := r.apply(installer)
+			if tt.invalid != (listener == nil) {
+				t.Errorf("expected script to run %v", !tt.invalid)
+			}
+			if installer.Status.Sync.Status != tt.status {
+				t.Errorf("expected status %s, found %s", tt.status, installer.Status.Sync.Status)
+			}
+			if !reflect.DeepEqual(installer.Status.Sync.Exit, tt.exit) {
+				t.Errorf("expected exit %+v, found %+v", tt.exit, installer.Status.Sync.Exit)
+			}
+			if len(installer.Status.Sync.Conditions) != tt.conditions {
+				t.Errorf("expected %d conditions, found %+v", tt.conditions, installer.Status.Sync.Conditions)
+			}
+			for _, message := range tt.messages {
+				condition := installer.Status.Sync.Conditions[0]
+				if tt.invalid != (condition.Type == installerv1alpha1.InstallerConditionInvalidSpecError) || !strings.Contains(condition.Message, message) {
+					t.Errorf("expected condition with %q, found %+v", message, condition)
+				}
+			}
+		})
+	}
+}
+
 func Test_downloadDSL(t *testing.T) {
 	type args struct {
 		url string
@@ -21,6 +21,7 @@ require (
 	k8s.io/api v0.21.2
 	k8s.io/apiextensions-apiserver v0.20.7
 	k8s.io/apimachinery v0.21.2
+	k8s.io/cli-runtime v0.20.7
 	k8s.io/client-go v1.5.2
 	k8s.io/klog v1.0.0
 	k8s.io/kubectl v0.20.7
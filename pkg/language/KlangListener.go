@@ -25,10 +25,10 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	parser "github.com/devtron-labs/inception/pkg/language/parser"
 	log "github.com/sirupsen/logrus"
+	"github.com/tidwall/gjson"
 	"io"
 	"io/ioutil"
 	"k8s.io/apimachinery/pkg/api/meta"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"net/http"
 	"os"
 	"os/exec"
@@ -46,19 +46,25 @@ type valHolder struct {
 type DataType string
 
 const (
-	STRING  DataType = "STRING"
-	BOOLEAN DataType = "BOOLEAN"
-	INT     DataType = "INT"
-	FLOAT   DataType = "FLOAT"
-	NIL     DataType = "NIL"
-	ID      DataType = "ID"
-	ERR     DataType = "ERROR"
+	STRING   DataType = "STRING"
+	BOOLEAN  DataType = "BOOLEAN"
+	INT      DataType = "INT"
+	FLOAT    DataType = "FLOAT"
+	NIL      DataType = "NIL"
+	ID       DataType = "ID"
+	ERR      DataType = "ERROR"
+	LIST     DataType = "LIST"
+	MAP      DataType = "MAP"
+	DURATION DataType = "DURATION"
 )
 
 const (
-	APPLY  string = "APPLY"
-	PATCH  string = "PATCH"
-	DELETE string = "DELETE"
+	APPLY   string = "APPLY"
+	PATCH   string = "PATCH"
+	DELETE  string = "DELETE"
+	CREATE  string = "CREATE"
+	REPLACE string = "REPLACE"
+	SCALE   string = "SCALE"
 )
 
 const yamlSeperator = "\n---\n"
@@ -88,35 +94,78 @@ const (
 	ResourceSyncStatusCodeOutOfSync ResourceSyncStatusCode = "OutOfSync"
 )
 
+// KlangListener runs a parsed script, Run dispatches every statement to its handler which evaluates the
+// statements nested in it, so each part of the tree is evaluated only when and as often as it runs
 type KlangListener struct {
-	*parser.BaseKlangListener
-	ifWhileCount        int
 	values              map[string]valHolder
 	kubernetesResources map[string][]Resource
 	mapper              *Mapper
 	stepReceivers       []StepReceiver
 	resourceReceivers   []ResourceReceiver
 	shouldExit          bool
+	breaking            bool
+	loops               int
+	funcs               map[string]*klangFunc
+	frames              []*callFrame
+	scopes              []*scope
+	globals             map[string]valHolder
+	builtins            map[string]BuiltinFunc
+	tryDepth            int
+	thrown              *klangError
+	err                 error
+	exit                *ExitStatus
+	deadlines           []deadline
+	location            string
+	modules             map[string]*klangModule
+	loader              *moduleLoader
+	dryRun              bool
+	out                 io.Writer
 }
 
 func NewKlangListener(mapper *Mapper) *KlangListener {
+	values := make(map[string]valHolder, 0)
 	return &KlangListener{
-		ifWhileCount:        0,
-		values:              make(map[string]valHolder, 0),
+		values:              values,
 		mapper:              mapper,
 		kubernetesResources: make(map[string][]Resource, 0),
 		shouldExit:          false,
+		funcs:               make(map[string]*klangFunc, 0),
+		globals:             values,
+		scopes:              []*scope{newScope(values)},
+		builtins:            newBuiltins(),
+		modules:             make(map[string]*klangModule, 0),
+		loader:              newModuleLoader(),
+		out:                 os.Stdout,
 	}
 }
 
+// Values returns the top-level variables, variables declared in blocks and function locals are not included
 func (l *KlangListener) Values() map[string]valHolder {
-	t := make(map[string]valHolder, len(l.values))
-	for k, v := range l.values {
+	t := make(map[string]valHolder, len(l.globals))
+	for k, v := range l.globals {
 		t[k] = v
 	}
 	return t
 }
 
+// SetOutput sets where log statements and dry run commands are printed, they are printed on stdout by default
+func (l *KlangListener) SetOutput(out io.Writer) {
+	l.out = out
+}
+
+// SetValue sets the top-level variable name before the script runs, value is converted like the result of a builtin
+func (l *KlangListener) SetValue(name string, value interface{}) error {
+	if !isIdentifier(name) {
+		return fmt.Errorf("invalid variable name %q", name)
+	}
+	val := fromNative(value)
+	if val.dataType == ERR {
+		return val.value.(error)
+	}
+	val.name = name
+	return l.assign(val)
+}
+
 func (l *KlangListener) KubernetesResources() map[string][]Resource {
 	t := make(map[string][]Resource, len(l.kubernetesResources))
 	for k, vs := range l.kubernetesResources {
@@ -159,126 +208,124 @@ func newEmptyHolder() valHolder {
 	return valHolder{}
 }
 
-func (l *KlangListener) ExitBlock(ctx *parser.BlockContext) {
-	if l.shouldExit {
-		return
-	}
-}
-
-func (l *KlangListener) ExitStat(ctx *parser.StatContext) {
-	log.Infof("evaluated %+v\n", ctx.GetText())
-}
-
-//ExitBlock is not implemented
 func (l *KlangListener) handleBlock(ctx *parser.BlockContext) {
+	l.pushScope()
+	defer l.popScope()
 	for _, stat := range ctx.AllStat() {
+		if l.unwinding() {
+			return
+		}
 		l.handleStat(stat)
 	}
 }
 
-//ExitStat not implemented, called from ExitIf_stat and ExitWhile_stat
+//handleStat is the only dispatch of statements, blocks and loops call it for the statements they run
 func (l *KlangListener) handleStat(ctx parser.IStatContext) {
-	ts := ctx.(*parser.StatContext)
-	if ts.Assignment() != nil {
-		//ExitAssignment is implemented but is skipped in case of If_stat and While_stat
-		// as it should be processed here depending on whether condition is true or not
-		ta := ts.Assignment().(*parser.AssignmentContext)
-		l.handleAssignment(ta)
-	} else if ts.Json_edit_fn() != nil {
-		//ExitJsonedit_assignment is implemented but is skipped in case of If_stat and While_stat
-		// as it should be processed here depending on whether condition is true or not
-		tja := ts.Json_edit_fn().(*parser.Json_edit_fnContext)
-		l.handleJson_edit_fn(tja)
-	} else if ts.Yaml_edit_fn() != nil {
-		tya := ts.Yaml_edit_fn().(*parser.Yaml_edit_fnContext)
-		l.handleYaml_edit_fn(tya)
-	} else if ts.Json_delete_fn() != nil {
-		//ExitJsondelete'
-		//]_assignment is implemented but is skipped in case of If_stat and While_stat
-		// as it should be processed here depending on whether condition is true or not
-		tja := ts.Json_delete_fn().(*parser.Json_delete_fnContext)
-		l.handleJson_delete_fn(tja)
-	} else if ts.Yaml_delete_fn() != nil {
-		tya := ts.Yaml_delete_fn().(*parser.Yaml_delete_fnContext)
-		l.handleYaml_delete_fn(tya)
-	} else if ts.Kube_json_delete_fn() != nil {
-		kjd := ts.Kube_json_delete_fn().(*parser.Kube_json_delete_fnContext)
-		l.handleKube_json_delete_fn(kjd)
-	} else if ts.Kube_json_edit_fn() != nil {
-		kje := ts.Kube_json_edit_fn().(*parser.Kube_json_edit_fnContext)
-		l.handleKube_json_edit_fn(kje)
-	} else if ts.Kube_yaml_delete_fn() != nil {
-		kyd := ts.Kube_yaml_delete_fn().(*parser.Kube_yaml_delete_fnContext)
-		l.handleKube_yaml_delete_fn(kyd)
-	} else if ts.Kube_yaml_edit_fn() != nil {
-		kye := ts.Kube_yaml_edit_fn().(*parser.Kube_yaml_edit_fnContext)
-		l.handleKube_yaml_edit_fn(kye)
-	} else if ts.Sleep_fn() != nil {
-		ef := ts.Sleep_fn().(*parser.Sleep_fnContext)
-		l.handleSleep_fn(ef)
-	} else if ts.Exit_fn() != nil {
-		ef := ts.Exit_fn().(*parser.Exit_fnContext)
-		l.handleExit_fn(ef)
-	} else if ts.Log() != nil {
-		log := ts.Log().(*parser.LogContext)
-		l.handleLog(log)
-	} else if ts.If_stat() != nil {
-		//skip as is handled by ExitIf_stat
-	} else if ts.While_stat() != nil {
-		//skip as is handled by ExitWhile_stat
+	if l.deadlineExceeded() {
+		return
+	}
+	//the text of compound statements includes their blocks, it is only built if it is logged
+	if log.IsLevelEnabled(log.DebugLevel) {
+		log.Debugf("evaluating %s at line %d\n", ctx.GetText(), ctx.GetStart().GetLine())
+	}
+	switch v := ctx.GetChild(0).(type) {
+	case *parser.AssignmentContext:
+		l.handleAssignment(v)
+	case *parser.Json_edit_fnContext:
+		l.handleJson_edit_fn(v)
+	case *parser.Yaml_edit_fnContext:
+		l.handleYaml_edit_fn(v)
+	case *parser.Json_delete_fnContext:
+		l.handleJson_delete_fn(v)
+	case *parser.Yaml_delete_fnContext:
+		l.handleYaml_delete_fn(v)
+	case *parser.Kube_json_delete_fnContext:
+		l.handleKube_json_delete_fn(v)
+	case *parser.Kube_json_edit_fnContext:
+		l.handleKube_json_edit_fn(v)
+	case *parser.Kube_yaml_delete_fnContext:
+		l.handleKube_yaml_delete_fn(v)
+	case *parser.Kube_yaml_edit_fnContext:
+		l.handleKube_yaml_edit_fn(v)
+	case *parser.Sleep_fnContext:
+		l.handleSleep_fn(v)
+	case *parser.Exit_fnContext:
+		l.handleExit_fn(v)
+	case *parser.LogContext:
+		l.handleLog(v)
+	case *parser.Call_statContext:
+		l.handleCall_stat(v)
+	case *parser.Return_statContext:
+		l.handleReturn_stat(v)
+	case *parser.Break_statContext:
+		l.handleBreak_stat(v)
+	case *parser.Try_statContext:
+		l.handleTry_stat(v)
+	case *parser.Throw_statContext:
+		l.handleThrow_stat(v)
+	case *parser.Import_statContext:
+		l.handleImport_stat(v)
+	case *parser.Timeout_statContext:
+		l.handleTimeout_stat(v)
+	case *parser.StepInfoContext:
+		l.handleStepInfo(v)
+	case *parser.If_statContext:
+		l.handleIf_stat(v)
+	case *parser.While_statContext:
+		l.handleWhile_stat(v)
+	case *parser.For_statContext:
+		l.handleFor_stat(v)
+	case *parser.Func_declContext:
+		//declared by Run before the top level statement containing it is run
 	}
 }
 
-func (l *KlangListener) ExitExit_fn(ctx *parser.Exit_fnContext) {
-	if l.ifWhileCount != 0 {
-		return
-	}
-	l.handleExit_fn(ctx)
+// ExitStatus records the exit statement which ended the script
+type ExitStatus struct {
+	Code int
+	Line int
+}
+
+// Exit returns the exit statement which ended the script, nil if the script did not exit
+func (l *KlangListener) Exit() *ExitStatus {
+	return l.exit
 }
 
-//TODO: fix shouldn't execute further steps in case of exit but keep on retrying after sometime
-//cant do it by os.Exit
+//exit stops the evaluation of the script, whether the run failed is decided by the caller using the exit code
 func (l *KlangListener) handleExit_fn(ctx *parser.Exit_fnContext) {
 	ns := ctx.NUMBER().GetText()
 	exitCode, err := strconv.Atoi(ns)
 	if err != nil {
-		os.Exit(1)
-	}
-	os.Exit(exitCode)
-}
-
-func (l *KlangListener) ExitSleep_fn(ctx *parser.Sleep_fnContext) {
-	if l.ifWhileCount != 0 {
-		return
+		newErrHolder(fmt.Errorf("invalid exit code %s at line %d", ns, ctx.GetStart().GetLine()))
+		exitCode = 1
 	}
-	l.handleSleep_fn(ctx)
+	l.exit = &ExitStatus{Code: exitCode, Line: ctx.GetStart().GetLine()}
+	l.shouldExit = true
 }
 
 func (l *KlangListener) handleSleep_fn(ctx *parser.Sleep_fnContext) {
-	ns := ctx.NUMBER().GetText()
-	sleepTime, err := strconv.Atoi(ns)
+	r := l.handleExpr(ctx.Expr())
+	r = l.getValIfID(r)
+	sleepTime, err := toDuration(r)
 	if err != nil {
-		os.Exit(1)
-	}
-	time.Sleep(time.Duration(sleepTime))
-}
-
-// ExitLog is called when production log is exited.
-func (l *KlangListener) ExitLog(ctx *parser.LogContext) {
-	if l.ifWhileCount != 0 {
+		r = newErrHolder(fmt.Errorf("invalid sleep time at line %d: %v", ctx.GetStart().GetLine(), err))
+		l.raiseIfErr(r, ctx.GetStart().GetLine())
 		return
 	}
-	l.handleLog(ctx)
+	l.sleep(sleepTime)
 }
 
 func (l *KlangListener) handleLog(ctx *parser.LogContext) {
 	out := l.handleExpr(ctx.Expr())
 	out = l.getValIfID(out)
-	fmt.Println(out.value)
+	if l.raiseIfErr(out, ctx.GetStart().GetLine()) {
+		return
+	}
+	out = toDocument(out)
+	fmt.Fprintln(l.out, out.value)
 }
 
-// ExitStepInfo is called when production stepInfo is exited.
-func (l *KlangListener) ExitStepInfo(ctx *parser.StepInfoContext) {
+func (l *KlangListener) handleStepInfo(ctx *parser.StepInfoContext) {
 	stepName := ""
 	if ctx.STRING() != nil {
 		stepName = StripQuotes(ctx.STRING().GetText())
@@ -290,7 +337,7 @@ func (l *KlangListener) ExitStepInfo(ctx *parser.StepInfoContext) {
 	}
 }
 
-//ExitStat_block not implemented, called from ExitIf_stat and ExitWhile_stat
+//handleStat_block runs the block of if, while and for statements in a scope of its own
 func (l *KlangListener) handleStat_block(ctx parser.IStat_blockContext) {
 	switch v := ctx.(type) {
 	case *parser.Stat_blockContext:
@@ -298,39 +345,80 @@ func (l *KlangListener) handleStat_block(ctx parser.IStat_blockContext) {
 			tb := v.Block().(*parser.BlockContext)
 			l.handleBlock(tb)
 		} else if v.Stat() != nil {
+			l.pushScope()
 			l.handleStat(v.Stat())
+			l.popScope()
 		}
 	}
 	//fmt.Printf("print %s\n", ctx.GetText())
 }
 
-// EnterIf_stat is called when production if_stat is entered.
-func (l *KlangListener) EnterIf_stat(ctx *parser.If_statContext) {
-	l.ifWhileCount++
+func (l *KlangListener) handleWhile_stat(ctx *parser.While_statContext) {
+	for !l.unwinding() && !l.deadlineExceeded() && l.condition(ctx.Expr()) {
+		if !l.handleLoop_body(ctx.Stat_block()) {
+			return
+		}
+	}
 }
 
-// EnterWhile_stat is called when production while_stat is entered.
-func (l *KlangListener) EnterWhile_stat(ctx *parser.While_statContext) {
-	l.ifWhileCount++
+func (l *KlangListener) handleFor_stat(ctx *parser.For_statContext) {
+	res := l.handleExpr(ctx.Expr())
+	res = l.getValIfID(res)
+	items, err := forItems(res)
+	if err != nil {
+		newErrHolder(fmt.Errorf("for at line %d: %v", ctx.GetStart().GetLine(), err))
+		return
+	}
+	indexName := ""
+	itemName := ctx.ID(0).GetText()
+	if len(ctx.AllID()) > 1 {
+		indexName = itemName
+		itemName = ctx.ID(1).GetText()
+	}
+	for i, item := range items {
+		if l.unwinding() {
+			return
+		}
+		//loop variables are local to each iteration
+		l.pushScope()
+		if len(indexName) != 0 {
+			index := newIntValHolder(int64(i))
+			index.name = indexName
+			l.declare(index, false)
+		}
+		item.name = itemName
+		l.declare(item, false)
+		next := l.handleLoop_body(ctx.Stat_block())
+		l.popScope()
+		if !next {
+			return
+		}
+	}
 }
 
-// ExitWhile_stat is called when production while_stat is exited.
-func (l *KlangListener) ExitWhile_stat(ctx *parser.While_statContext) {
-	defer func() { l.ifWhileCount-- }()
-	res := l.handleExpr(ctx.Expr())
-	for res.value.(bool) {
-		l.handleStat_block(ctx.Stat_block())
-		res = l.handleExpr(ctx.Expr())
+//forItems splits lists, json arrays, yaml sequences, kubernetes Lists and multi document yaml into items
+func forItems(val valHolder) ([]valHolder, error) {
+	if val.dataType == LIST {
+		return val.value.([]valHolder), nil
+	}
+	val = toDocument(val)
+	if val.dataType != STRING {
+		return nil, fmt.Errorf("cannot iterate over %s", val.dataType)
+	}
+	s := strings.TrimSpace(val.value.(string))
+	if len(s) == 0 {
+		return nil, nil
+	}
+	if (strings.HasPrefix(s, "[") || strings.HasPrefix(s, "{")) && gjson.Valid(s) {
+		return JsonItems(s)
 	}
+	return YamlItems(val.value.(string))
 }
 
-// ExitIf_stat is called when production if_stat is exited.
-func (l *KlangListener) ExitIf_stat(ctx *parser.If_statContext) {
-	defer func() { l.ifWhileCount-- }()
+func (l *KlangListener) handleIf_stat(ctx *parser.If_statContext) {
 	for _, cb := range ctx.AllCondition_block() {
 		tcb := cb.(*parser.Condition_blockContext)
-		res := l.handleCondition_block(tcb)
-		if res.value.(bool) == true {
+		if l.condition(tcb.Expr()) {
 			if tcb.Stat_block() != nil {
 				l.handleStat_block(tcb.Stat_block())
 			}
@@ -342,45 +430,58 @@ func (l *KlangListener) ExitIf_stat(ctx *parser.If_statContext) {
 	}
 }
 
-// ExitAssignment is called when production assignment is exited.
-func (l *KlangListener) ExitAssignment(ctx *parser.AssignmentContext) {
-	if l.ifWhileCount != 0 {
-		return
-	}
-	l.handleAssignment(ctx)
-}
-
 func (l *KlangListener) handleAssignment(ctx *parser.AssignmentContext) {
 	if ctx.Expr() != nil {
 		r := l.handleExpr(ctx.Expr())
 		r = l.getValIfID(r)
+		if l.raiseIfErr(r, ctx.GetStart().GetLine()) {
+			return
+		}
 		o := valHolder{
 			dataType: r.dataType,
 			name:     ctx.ID().GetText(),
 			value:    r.value,
 		}
-		l.values[o.name] = o
+		l.store(ctx, o)
 	} else if ctx.Load_fn() != nil {
 		r := newStringValHolder("")
 		r.name = ctx.ID().GetText()
 		loadContext := ctx.Load_fn().(*parser.Load_fnContext)
 		fName := l.GetTextFromStringOrId(loadContext.String_or_id().(*parser.String_or_idContext))
 		if len(fName) == 0 {
-			l.values[r.name] = r
+			l.store(ctx, r)
 			return
 		}
 		data, err := ioutil.ReadFile(fName)
 		if err != nil {
-			l.values[r.name] = r
+			l.store(ctx, r)
 			return
 		}
 		r.value = string(data)
-		l.values[r.name] = r
+		l.store(ctx, r)
+	}
+}
+
+//store declares the variable for let and const, otherwise assigns it
+func (l *KlangListener) store(ctx *parser.AssignmentContext, val valHolder) {
+	var err error
+	if ctx.LET() != nil || ctx.CONST() != nil {
+		err = l.declare(val, ctx.CONST() != nil)
+	} else {
+		err = l.assign(val)
+	}
+	if err != nil {
+		r := newErrHolder(fmt.Errorf("%v at line %d", err, ctx.GetStart().GetLine()))
+		l.raiseIfErr(r, ctx.GetStart().GetLine())
 	}
 }
 
 func (l *KlangListener) handleShell_script(ctx *parser.Shell_scriptContext) valHolder {
-	script := l.GetTextFromStringOrId(ctx.String_or_id().(*parser.String_or_idContext))
+	if l.dryRun {
+		return notInDryRun(ctx.SHELLSCRIPT().GetText(), ctx.GetStart().GetLine())
+	}
+	//shell scripts are not interpolated as ${} is used by the shell itself
+	script := l.stringOrIdText(ctx.String_or_id().(*parser.String_or_idContext), false)
 	if len(script) == 0 {
 		return newEmptyHolder()
 	}
@@ -400,34 +501,60 @@ func (l *KlangListener) handleShell_script(ctx *parser.Shell_scriptContext) valH
 }
 
 func (l *KlangListener) GetTextFromStringOrId(stringOrId *parser.String_or_idContext) string {
+	return l.stringOrIdText(stringOrId, true)
+}
+
+func (l *KlangListener) stringOrIdText(stringOrId *parser.String_or_idContext, interpolate bool) string {
 	pattern := ""
 	if stringOrId.ID() != nil {
-		if pval, ok := l.values[stringOrId.ID().GetText()]; ok {
+		if pval, ok := l.getValue(stringOrId.ID().GetText()); ok {
 			if pval.dataType == ERR {
 				return ""
 			}
 			if pval.value == nil {
 				return ""
 			}
+			pval = toDocument(pval)
 			pattern = pval.value.(string)
 			return pattern
 		}
 	} else if stringOrId.STRING() != nil {
 		pattern = stringOrId.STRING().GetText()
-		return pattern[1 : len(pattern)-1]
+		pattern = pattern[1 : len(pattern)-1]
 	} else if stringOrId.RAW_STRING_LIT() != nil {
 		pattern = stringOrId.RAW_STRING_LIT().GetText()
-		return pattern[1 : len(pattern)-1]
+		pattern = pattern[1 : len(pattern)-1]
+	}
+	if !interpolate {
+		return pattern
+	}
+	pattern, err := l.interpolate(pattern)
+	if err != nil {
+		newErrHolder(err)
+		return ""
 	}
 	return pattern
 }
 
 func (l *KlangListener) handleDownload_fn(ctx *parser.Download_fnContext) valHolder {
+	if l.dryRun {
+		return notInDryRun(ctx.DOWNLOAD().GetText(), ctx.GetStart().GetLine())
+	}
 	url := l.GetTextFromStringOrId(ctx.String_or_id(0).(*parser.String_or_idContext))
 	fileName := ""
 	if ctx.String_or_id(1) != nil {
 		fileName = l.GetTextFromStringOrId(ctx.String_or_id(1).(*parser.String_or_idContext))
-	} else {
+	}
+	data, err := downloadFile(url, fileName)
+	if err != nil {
+		return newErrHolder(err)
+	}
+	return newStringValHolder(data)
+}
+
+//downloadFile saves url to fileName, or to a file named after the url hash if fileName is empty, and returns its content
+func downloadFile(url, fileName string) (string, error) {
+	if len(fileName) == 0 {
 		hasher := sha1.New()
 		hasher.Write([]byte(url))
 		hash := hex.EncodeToString(hasher.Sum(nil))
@@ -436,38 +563,33 @@ func (l *KlangListener) handleDownload_fn(ctx *parser.Download_fnContext) valHol
 	//Create a empty file
 	file, err := os.Create(fileName)
 	if err != nil {
-		return newErrHolder(err)
+		return "", err
 	}
 
 	defer file.Close()
 
 	resp, err := http.Get(url)
 	if err != nil {
-		return newErrHolder(err)
+		return "", err
 	}
 	defer resp.Body.Close()
 
 	_, err = io.Copy(file, resp.Body)
 	if err != nil {
-		return newErrHolder(err)
+		return "", err
 	}
 
 	data, err := ioutil.ReadFile(fileName)
 	if err != nil {
-		return newErrHolder(err)
+		return "", err
 	}
 
-	return newStringValHolder(string(data))
+	return string(data), nil
 }
 
-func (l *KlangListener) handleCondition_block(ctx *parser.Condition_blockContext) valHolder {
-	res := l.handleExpr(ctx.Expr())
-	if res.dataType != BOOLEAN {
-		res = l.isFalse(res)
-		res = newBooleanValHolder(!res.value.(bool))
-	}
-	//fmt.Printf("final result %v\n", res)
-	return res
+//condition evaluates the condition of if and while statements, see isTrue
+func (l *KlangListener) condition(ctx parser.IExprContext) bool {
+	return l.isTrue(l.getValIfID(l.handleExpr(ctx)))
 }
 
 func (l *KlangListener) handleExpr(ctx parser.IExprContext) valHolder {
@@ -491,7 +613,9 @@ func (l *KlangListener) handleExpr(ctx parser.IExprContext) valHolder {
 		c3 := lhs.dataType == INT && rhs.dataType == FLOAT && logicalIntFloatOperation(lhs, rhs, op)
 		c4 := lhs.dataType == FLOAT && rhs.dataType == INT && logicalFloatIntOperation(lhs, rhs, op)
 		c5 := lhs.dataType == STRING && rhs.dataType == STRING && logicalStringStringOperation(lhs, rhs, op)
-		c := c1 || c2 || c3 || c4 || c5
+		c6 := lhs.dataType == DURATION && rhs.dataType == DURATION && logicalDurationDurationOperation(lhs, rhs, op)
+		c7 := isCollection(lhs) && isCollection(rhs) && logicalCollectionCollectionOperation(lhs, rhs, op)
+		c := c1 || c2 || c3 || c4 || c5 || c6 || c7
 		return newBooleanValHolder(c)
 	case *parser.KubectlExprContext:
 		return l.handleKubectl_command(v.Kubectl_command())
@@ -517,69 +641,34 @@ func (l *KlangListener) handleExpr(ctx parser.IExprContext) valHolder {
 		c3 := lhs.dataType == INT && rhs.dataType == FLOAT && logicalIntFloatOperation(lhs, rhs, op)
 		c4 := lhs.dataType == FLOAT && rhs.dataType == INT && logicalFloatIntOperation(lhs, rhs, op)
 		c5 := lhs.dataType == STRING && rhs.dataType == STRING && logicalStringStringOperation(lhs, rhs, op)
-		c := c1 || c2 || c3 || c4 || c5
+		c6 := lhs.dataType == DURATION && rhs.dataType == DURATION && logicalDurationDurationOperation(lhs, rhs, op)
+		c7 := isCollection(lhs) && isCollection(rhs) && logicalCollectionCollectionOperation(lhs, rhs, op)
+		c := c1 || c2 || c3 || c4 || c5 || c6 || c7
 		return newBooleanValHolder(c)
 	case *parser.AndExprContext:
-		lhs := l.handleExpr(v.Expr(0))
-		rhs := l.handleExpr(v.Expr(1))
-		lhs = l.getValIfID(lhs)
-		rhs = l.getValIfID(rhs)
-		lhs = l.isFalse(lhs)
-		rhs = l.isFalse(rhs)
-		if lhs.dataType != BOOLEAN || rhs.dataType != BOOLEAN {
-			return valHolder{}
+		//the right operand is only evaluated if the left one is true, it may run kubectl commands
+		lhs := l.getValIfID(l.handleExpr(v.Expr(0)))
+		if !l.isTrue(lhs) {
+			return newBooleanValHolder(false)
 		}
-		isTrue := lhs.value.(bool) != true && rhs.value.(bool) != true
-		return newBooleanValHolder(isTrue)
+		rhs := l.getValIfID(l.handleExpr(v.Expr(1)))
+		return newBooleanValHolder(l.isTrue(rhs))
 	case *parser.OrExprContext:
-		lhs := l.handleExpr(v.Expr(0))
-		rhs := l.handleExpr(v.Expr(1))
-		lhs = l.getValIfID(lhs)
-		rhs = l.getValIfID(rhs)
-		lhs = l.isFalse(lhs)
-		rhs = l.isFalse(rhs)
-		if lhs.dataType != BOOLEAN || rhs.dataType != BOOLEAN {
-			return valHolder{}
+		//the right operand is only evaluated if the left one is false
+		lhs := l.getValIfID(l.handleExpr(v.Expr(0)))
+		if l.isTrue(lhs) {
+			return newBooleanValHolder(true)
 		}
-		isTrue := lhs.value.(bool) != true || rhs.value.(bool) != true
-		return newBooleanValHolder(isTrue)
+		rhs := l.getValIfID(l.handleExpr(v.Expr(1)))
+		return newBooleanValHolder(l.isTrue(rhs))
 	case *parser.AdditiveExprContext:
 		op := PLUS
 		if v.MINUS() != nil {
 			op = MINUS
 		}
-		lhs := l.handleExpr(v.Expr(0))
-		rhs := l.handleExpr(v.Expr(1))
-		lhs = l.getValIfID(lhs)
-		rhs = l.getValIfID(rhs)
-		if lhs.dataType == INT && rhs.dataType == INT {
-			return mathematicalIntIntOperation(lhs, rhs, op)
-		}
-		if lhs.dataType == FLOAT && rhs.dataType == FLOAT {
-			return mathematicalFloatFloatOperation(lhs, rhs, op)
-		}
-		if lhs.dataType == INT && rhs.dataType == FLOAT {
-			return mathematicalIntFloatOperation(lhs, rhs, op)
-		}
-		if lhs.dataType == FLOAT && rhs.dataType == INT {
-			return mathematicalFloatIntOperation(lhs, rhs, op)
-		}
-		if lhs.dataType == STRING && rhs.dataType == STRING {
-			return mathematicalStringStringOperation(lhs, rhs, op)
-		}
-		if lhs.dataType == STRING && rhs.dataType == INT {
-			return mathematicalStringIntOperation(lhs, rhs, op)
-		}
-		if lhs.dataType == STRING && rhs.dataType == FLOAT {
-			return mathematicalStringFloatOperation(lhs, rhs, op)
-		}
-		if lhs.dataType == INT && rhs.dataType == STRING {
-			return mathematicalIntStringOperation(lhs, rhs, op)
-		}
-		if lhs.dataType == FLOAT && rhs.dataType == STRING {
-			return mathematicalFloatStringOperation(lhs, rhs, op)
-		}
-		return newEmptyHolder()
+		lhs := l.getValIfID(l.handleExpr(v.Expr(0)))
+		rhs := l.getValIfID(l.handleExpr(v.Expr(1)))
+		return mathematicalOperation(lhs, rhs, op)
 	case *parser.MultiplicationExprContext:
 		op := MULT
 		if v.DIV() != nil {
@@ -587,67 +676,76 @@ func (l *KlangListener) handleExpr(ctx parser.IExprContext) valHolder {
 		} else if v.MOD() != nil {
 			op = MOD
 		}
-		lhs := l.handleExpr(v.Expr(0))
-		rhs := l.handleExpr(v.Expr(1))
-		lhs = l.getValIfID(lhs)
-		rhs = l.getValIfID(rhs)
-		if lhs.dataType == INT && rhs.dataType == INT {
-			return mathematicalIntIntOperation(lhs, rhs, op)
-		}
-		if lhs.dataType == FLOAT && rhs.dataType == FLOAT {
-			return mathematicalFloatFloatOperation(lhs, rhs, op)
-		}
-		if lhs.dataType == INT && rhs.dataType == FLOAT {
-			return mathematicalIntFloatOperation(lhs, rhs, op)
-		}
-		if lhs.dataType == FLOAT && rhs.dataType == INT {
-			return mathematicalFloatIntOperation(lhs, rhs, op)
-		}
-		return newEmptyHolder()
+		lhs := l.getValIfID(l.handleExpr(v.Expr(0)))
+		rhs := l.getValIfID(l.handleExpr(v.Expr(1)))
+		return mathematicalOperation(lhs, rhs, op)
+	case *parser.PowExprContext:
+		lhs := l.getValIfID(l.handleExpr(v.Expr(0)))
+		rhs := l.getValIfID(l.handleExpr(v.Expr(1)))
+		return mathematicalOperation(lhs, rhs, POW)
+	case *parser.UnaryMinusExprContext:
+		return negate(l.getValIfID(l.handleExpr(v.Expr())))
 	case *parser.ShellScriptContext:
 		return l.handleShell_script(v.Shell_script().(*parser.Shell_scriptContext))
 	case *parser.DownloadFnContext:
 		return l.handleDownload_fn(v.Download_fn().(*parser.Download_fnContext))
+	case *parser.FuncCallExprContext:
+		return l.handleFunc_call(v.Func_call().(*parser.Func_callContext))
+	case *parser.IndexExprContext:
+		return l.handleIndexExpr(v)
+	case *parser.MemberExprContext:
+		return l.handleMemberExpr(v)
 	case *parser.NotExprContext:
 		r := l.handleExpr(v.Expr())
 		r = l.getValIfID(r)
-		return l.isFalse(r)
+		return newBooleanValHolder(!l.isTrue(r))
 	default:
 		break
 	}
 	return newEmptyHolder()
 }
 
-func (l *KlangListener) isFalse(r valHolder) valHolder {
+// isTrue is the truth of a value in conditions and for !, && and ||, by data type:
+//
+//	BOOLEAN              the value
+//	INT, FLOAT, DURATION true unless zero
+//	STRING, LIST, MAP    true unless empty, so "false" and "0" are true
+//	ID                   the truth of the variable, false if it is not defined
+//	NIL, ERR             false, so failed kubectl commands and function calls are false
+//
+// Values without a data type, like the result of an invalid operation, are false.
+func (l *KlangListener) isTrue(r valHolder) bool {
 	if r.value == nil {
-		return newBooleanValHolder(true)
+		return false
 	}
 	switch r.dataType {
-	case INT:
-		return newBooleanValHolder(r.value.(int64) == 0)
-	case STRING:
-		return newBooleanValHolder(len(r.value.(string)) == 0)
 	case BOOLEAN:
-		return newBooleanValHolder(!r.value.(bool))
+		return r.value.(bool)
+	case INT:
+		return r.value.(int64) != 0
 	case FLOAT:
-		return newBooleanValHolder(r.value.(float64) == 0.0)
-	case NIL:
-		return newBooleanValHolder(true)
+		return r.value.(float64) != 0
+	case DURATION:
+		return r.value.(time.Duration) != 0
+	case STRING:
+		return len(r.value.(string)) != 0
+	case LIST:
+		return len(r.value.([]valHolder)) != 0
+	case MAP:
+		return len(r.value.(map[string]valHolder)) != 0
 	case ID:
-		if val, ok := l.values[r.name]; ok {
-			return l.isFalse(val)
+		if val, ok := l.getValue(r.name); ok {
+			return l.isTrue(val)
 		}
-		return newBooleanValHolder(true)
-	case ERR:
-		return newBooleanValHolder(true)
+		return false
 	default:
-		return newBooleanValHolder(true)
+		return false
 	}
 }
 
 func (l *KlangListener) getValIfID(val valHolder) valHolder {
 	if val.dataType == ID {
-		if v, ok := l.values[val.value.(string)]; ok {
+		if v, ok := l.getValue(val.value.(string)); ok {
 			return v
 		}
 		return newEmptyHolder()
@@ -673,22 +771,28 @@ func (l *KlangListener) handleAtom(ctx parser.IAtomContext) valHolder {
 		}
 	case *parser.StringAtomContext:
 		val := ""
-		l := len(v.GetText())
-		if l > 2 {
-			val = v.GetText()[1 : l-1]
+		n := len(v.GetText())
+		if n > 2 {
+			val = v.GetText()[1 : n-1]
 		}
-		return newStringValHolder(val)
+		return l.interpolatedString(val)
 	case *parser.RawStringAtomContext:
 		val := ""
-		l := len(v.GetText())
-		if l > 2 {
-			val = v.GetText()[1 : l-1]
+		n := len(v.GetText())
+		if n > 2 {
+			val = v.GetText()[1 : n-1]
 		}
-		return newStringValHolder(val)
+		return l.interpolatedString(val)
 	case *parser.ParExprContext:
 		return l.handleExpr(v.Expr())
 	case *parser.NilAtomContext:
 		return newNilValHolder()
+	case *parser.DurationAtomContext:
+		val, err := time.ParseDuration(v.GetText())
+		if err != nil {
+			return newErrHolder(err)
+		}
+		return newDurationValHolder(val)
 	case *parser.BooleanAtomContext:
 		val, err := strconv.ParseBool(v.GetText())
 		if err != nil {
@@ -706,18 +810,27 @@ func (l *KlangListener) handleAtom(ctx parser.IAtomContext) valHolder {
 		return rval
 	case *parser.JsonAtomContext:
 		return newStringValHolder(v.GetText())
+	case *parser.ListAtomContext:
+		return l.handleList_literal(v.List_literal().(*parser.List_literalContext))
+	case *parser.MapAtomContext:
+		return l.handleMap_literal(v.Map_literal().(*parser.Map_literalContext))
+	case *parser.PathAtomContext:
+		return l.handlePathAtom(v)
 	default:
 		return valHolder{}
 	}
 }
 
 func (l *KlangListener) handleKubectl_command(ctx parser.IKubectl_commandContext) valHolder {
+	if l.dryRun {
+		return l.handleDryRunKubectl(ctx)
+	}
 	switch v := ctx.(type) {
 	case *parser.ApplyKubectlCommandContext:
 		patterns := []string{"apiVersion", "kind", "metadata.name"}
 		namespace := "default"
 		if len(v.AllNs()) != 0 {
-			namespace = v.Ns(len(v.AllNs()) - 1).GetText()
+			namespace = l.resolveNs(v.Ns(len(v.AllNs()) - 1))
 		}
 		updateConfig := ""
 		if len(v.AllKubernetes_object_config()) != 0 {
@@ -782,7 +895,7 @@ func (l *KlangListener) handleKubectl_command(ctx parser.IKubectl_commandContext
 		k := NewKubectl()
 		namespace := "default"
 		if len(v.AllNs()) > 0 {
-			namespace = v.Ns(len(v.AllNs()) - 1).GetText()
+			namespace = l.resolveNs(v.Ns(len(v.AllNs()) - 1))
 		}
 		if len(v.AllString_or_id()) == 0 {
 			return newErrHolder(fmt.Errorf("patch cannot be empty (found size %d)", len(v.AllString_or_id())))
@@ -862,7 +975,7 @@ func (l *KlangListener) handleKubectl_command(ctx parser.IKubectl_commandContext
 		var resp []string
 		namespace := "default"
 		if len(v.AllNs()) > 0 {
-			namespace = v.Ns(len(v.AllNs()) - 1).GetText()
+			namespace = l.resolveNs(v.Ns(len(v.AllNs()) - 1))
 		}
 		resources := v.AllResource()
 		var args []string
@@ -877,6 +990,13 @@ func (l *KlangListener) handleKubectl_command(ctx parser.IKubectl_commandContext
 			}
 			return newErrHolder(fmt.Errorf(strings.Join(errs, "\n")))
 		}
+		listOptions := l.listOptionsOf(v.AllSelector())
+		selected := len(listOptions.LabelSelector) != 0 || len(listOptions.FieldSelector) != 0
+		allNamespaces := len(v.AllALLNAMESPACES()) != 0
+		outputFormat := l.outputFormatOf(v.AllOutput())
+		if allNamespaces {
+			namespace = ""
+		}
 		isList := false
 		if len(a.ResourceTuples()) != 0 {
 			rts := a.ResourceTuples()
@@ -885,11 +1005,17 @@ func (l *KlangListener) handleKubectl_command(ctx parser.IKubectl_commandContext
 				if err != nil {
 					return newErrHolder(err)
 				}
+				if len(rt.Name) != 0 && selected {
+					return newErrHolder(fmt.Errorf("name cannot be provided when a selector is specified"))
+				}
+				if len(rt.Name) != 0 && allNamespaces {
+					return newErrHolder(fmt.Errorf("a resource cannot be retrieved by name across all namespaces"))
+				}
 				if len(rt.Name) == 0 {
 					lr := ListRequest{
-						Namespace:            namespace,
+						Namespace:            listNamespace(resource, namespace),
 						GroupVersionResource: resource.Resource,
-						ListOptions:          metav1.ListOptions{},
+						ListOptions:          listOptions,
 					}
 					manifests, err := k.ListResources(context.Background(), &lr)
 					if err != nil {
@@ -916,14 +1042,28 @@ func (l *KlangListener) handleKubectl_command(ctx parser.IKubectl_commandContext
 		if len(resp) > 1 || isList {
 			out = "{\"apiVersion\": \"v1\",    \"items\": [" + out + "], \"kind\": \"List\", \"metadata\": { \"resourceVersion\": \"\", \"selfLink\": \"\" }}"
 		}
+		out, err := formatOutput(out, outputFormat)
+		if err != nil {
+			return newErrHolder(err)
+		}
 		return newStringValHolder(out)
+	case *parser.WaitKubectlCommandContext:
+		return l.handleKubectlWait(v)
+	case *parser.RolloutKubectlCommandContext:
+		return l.handleKubectlRollout(v)
+	case *parser.CreateKubectlCommandContext:
+		return l.handleManifestCommand(CREATE, v.AllNs(), v.AllString_or_id(), v.AllKubernetes_object_config())
+	case *parser.ReplaceKubectlCommandContext:
+		return l.handleManifestCommand(REPLACE, v.AllNs(), v.AllString_or_id(), v.AllKubernetes_object_config())
+	case *parser.ScaleKubectlCommandContext:
+		return l.handleKubectlScale(v)
 	case *parser.DeleteKubectlCommandContext:
 		a := NewFactory(l.mapper)
 		k := NewKubectl()
 		//var resp []string
 		namespace := "default"
 		if len(v.AllNs()) > 0 {
-			namespace = v.Ns(len(v.AllNs()) - 1).GetText()
+			namespace = l.resolveNs(v.Ns(len(v.AllNs()) - 1))
 		}
 		resources := v.AllResource()
 		var args []string
@@ -938,7 +1078,11 @@ func (l *KlangListener) handleKubectl_command(ctx parser.IKubectl_commandContext
 			}
 			return newErrHolder(fmt.Errorf(strings.Join(errs, "\n")))
 		}
+		listOptions := l.listOptionsOf(v.AllSelector())
+		selected := len(listOptions.LabelSelector) != 0 || len(listOptions.FieldSelector) != 0
+		all := len(v.AllALL()) != 0
 		var err error
+		failed := false
 		if len(a.ResourceTuples()) != 0 {
 			rts := a.ResourceTuples()
 			for _, rt := range rts {
@@ -946,48 +1090,67 @@ func (l *KlangListener) handleKubectl_command(ctx parser.IKubectl_commandContext
 				resource, err = a.mappingFor(rt.Resource)
 				if err != nil {
 					fmt.Printf("{\"err\": \"%s\"}\n", err.Error())
+					failed = true
 					continue
 					//return newErrHolder(err)
 				}
-				if len(rt.Name) == 0 {
-					fmt.Printf("{\"err\": \"%s\"}\n", "resource name cannot be empty with delete statement")
+				names := []string{rt.Name}
+				if len(rt.Name) != 0 && (selected || all) {
+					fmt.Printf("{\"err\": \"%s\"}\n", "name cannot be provided when a selector or --all is specified")
+					failed = true
 					continue
 				}
-				dr := DeleteRequest{
-					Name:             rt.Name,
-					Namespace:        namespace,
-					GroupVersionKind: resource.GroupVersionKind,
-				}
-				_, err = k.DeleteResource(context.Background(), &dr)
-				res := Resource{
-					Operation: DELETE,
-					Group:     dr.GroupVersionKind.Group,
-					Version:   dr.GroupVersionKind.Version,
-					Kind:      dr.GroupVersionKind.Kind,
-					Namespace: dr.Namespace,
-					Name:      dr.Name,
-					Message:   "",
-					Status:    ResourceSyncStatusCodeSynced,
-				}
-				resourceKey := kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)
-				if _, ok := l.kubernetesResources[resourceKey.String()]; !ok {
-					l.kubernetesResources[resourceKey.String()] = make([]Resource, 0)
-				}
-				//If its an error still try others
-				if err != nil {
-					res.Message = err.Error()
-					res.Status = ResourceSyncStatusCodeOutOfSync
-					//l.kubernetesResources[resourceKey.String()] = append(l.kubernetesResources[resourceKey.String()], res)
-					//return newErrHolder(err)
+				if len(rt.Name) == 0 {
+					if !selected && !all {
+						fmt.Printf("{\"err\": \"%s\"}\n", "resource name cannot be empty with delete statement, use -l, --field-selector or --all")
+						failed = true
+						continue
+					}
+					names, err = selectedNames(k, resource, namespace, listOptions)
+					if err != nil {
+						fmt.Printf("{\"err\": \"%s\"}\n", err.Error())
+						failed = true
+						continue
+					}
 				}
-				l.kubernetesResources[resourceKey.String()] = append(l.kubernetesResources[resourceKey.String()], res)
-				for _, r := range l.resourceReceivers {
-					r.ReceiveResource(res)
+				for _, name := range names {
+					dr := DeleteRequest{
+						Name:             name,
+						Namespace:        namespace,
+						GroupVersionKind: resource.GroupVersionKind,
+					}
+					_, err = k.DeleteResource(context.Background(), &dr)
+					res := Resource{
+						Operation: DELETE,
+						Group:     dr.GroupVersionKind.Group,
+						Version:   dr.GroupVersionKind.Version,
+						Kind:      dr.GroupVersionKind.Kind,
+						Namespace: dr.Namespace,
+						Name:      dr.Name,
+						Message:   "",
+						Status:    ResourceSyncStatusCodeSynced,
+					}
+					resourceKey := kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)
+					if _, ok := l.kubernetesResources[resourceKey.String()]; !ok {
+						l.kubernetesResources[resourceKey.String()] = make([]Resource, 0)
+					}
+					//If its an error still try others
+					if err != nil {
+						res.Message = err.Error()
+						res.Status = ResourceSyncStatusCodeOutOfSync
+						failed = true
+						//l.kubernetesResources[resourceKey.String()] = append(l.kubernetesResources[resourceKey.String()], res)
+						//return newErrHolder(err)
+					}
+					l.kubernetesResources[resourceKey.String()] = append(l.kubernetesResources[resourceKey.String()], res)
+					for _, r := range l.resourceReceivers {
+						r.ReceiveResource(res)
+					}
+					//resp = append(resp, mresp.Manifest)
 				}
-				//resp = append(resp, mresp.Manifest)
 			}
 		}
-		if err != nil {
+		if failed {
 			return newBooleanValHolder(false)
 		}
 		return newBooleanValHolder(true)
@@ -997,6 +1160,18 @@ func (l *KlangListener) handleKubectl_command(ctx parser.IKubectl_commandContext
 	return valHolder{}
 }
 
+//ids and paths are namespace names, strings are interpolated
+func (l *KlangListener) resolveNs(val parser.INsContext) string {
+	nc := val.(*parser.NsContext)
+	if nc.String_or_id() != nil {
+		stringOrId := nc.String_or_id().(*parser.String_or_idContext)
+		if stringOrId.ID() == nil {
+			return l.GetTextFromStringOrId(stringOrId)
+		}
+	}
+	return nc.GetText()
+}
+
 func (l *KlangListener) resolveResource(val parser.IResourceContext) string {
 	rc := val.(*parser.ResourceContext)
 	if rc.String_or_id() != nil {
@@ -17,11 +17,15 @@ limitations under the License.
 package language
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 	parser2 "github.com/devtron-labs/inception/pkg/language/parser"
 	"io/ioutil"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestKlangListener_handleNestedIf(t *testing.T) {
@@ -150,6 +154,202 @@ x = x+1;
 	}
 }
 
+func TestKlangListener_handleFor(t *testing.T) {
+	type fields struct {
+		input  string
+		values map[string]valHolder
+	}
+	tests := []struct {
+		name   string
+		fields fields
+	}{
+		{
+			name: "json array",
+			fields: fields{
+				input: `
+x = ` + "`" + `[1, 2, 3]` + "`" + `;
+sum = 0;
+for item in x {
+sum = sum + item;
+}`,
+				values: map[string]valHolder{
+					"sum": {
+						dataType: FLOAT,
+						name:     "sum",
+						value:    float64(6),
+					},
+				},
+			},
+		},
+		{
+			name: "json array with index",
+			fields: fields{
+				input: `
+x = ` + "`" + `["a", "b", "c"]` + "`" + `;
+names = "";
+last = 0;
+for i, item in x {
+names = names + item;
+last = i;
+}`,
+				values: map[string]valHolder{
+					"names": {
+						dataType: STRING,
+						name:     "names",
+						value:    "abc",
+					},
+					"last": {
+						dataType: INT,
+						name:     "last",
+						value:    int64(2),
+					},
+				},
+			},
+		},
+		{
+			name: "kubernetes list",
+			fields: fields{
+				input: `
+x = {"apiVersion": "v1",    "items": [{"kind": "Pod", "metadata": {"name": "a"}},{"kind": "Pod", "metadata": {"name": "b"}}], "kind": "List", "metadata": { "resourceVersion": "", "selfLink": "" }};
+names = "";
+for pod in x {
+names = names + jsonSelect(pod, "metadata.name");
+}`,
+				values: map[string]valHolder{
+					"names": {
+						dataType: STRING,
+						name:     "names",
+						value:    "ab",
+					},
+				},
+			},
+		},
+		{
+			name: "yaml sequence",
+			fields: fields{
+				input: "x = `\n- name: a\n- name: b\n`;\nnames = \"\";\nfor item in x {\nnames = names + yamlSelect(item, \"name\");\n}",
+				values: map[string]valHolder{
+					"names": {
+						dataType: STRING,
+						name:     "names",
+						value:    "ab",
+					},
+				},
+			},
+		},
+		{
+			name: "multi document yaml",
+			fields: fields{
+				input: "x = `kind: ConfigMap\n---\nkind: Secret\n`;\nkinds = \"\";\nfor doc in x {\nkinds = kinds + yamlSelect(doc, \"kind\");\n}",
+				values: map[string]valHolder{
+					"kinds": {
+						dataType: STRING,
+						name:     "kinds",
+						value:    "ConfigMapSecret",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := setup(tt.fields.input)
+			m := make(map[string]valHolder, len(tt.fields.values))
+			for k := range tt.fields.values {
+				if d, ok := r.values[k]; ok {
+					m[k] = d
+				}
+			}
+			if diff := compare(tt.fields.values, m); !diff {
+				t.Errorf("expected %+v, found %+v\n", tt.fields.values, r.Values())
+			}
+		})
+	}
+}
+
+func TestKlangListener_handleExit_fn(t *testing.T) {
+	type fields struct {
+		input  string
+		values map[string]valHolder
+		exit   *ExitStatus
+	}
+	tests := []struct {
+		name   string
+		fields fields
+	}{
+		{
+			name: "top level exit",
+			fields: fields{
+				input: `
+x = 1;
+exit 3;
+x = 2;
+`,
+				values: map[string]valHolder{
+					"x": {
+						dataType: INT,
+						name:     "x",
+						value:    int64(1),
+					},
+				},
+				exit: &ExitStatus{Code: 3, Line: 3},
+			},
+		},
+		{
+			name: "exit in loop and function",
+			fields: fields{
+				input: `
+func stop(n) {
+  if n == 2 {
+    exit 0;
+  }
+  return n;
+}
+i = 0;
+while i < 5 {
+  i = i + 1;
+  stop(i);
+}
+`,
+				values: map[string]valHolder{
+					"i": {
+						dataType: INT,
+						name:     "i",
+						value:    int64(2),
+					},
+				},
+				exit: &ExitStatus{Code: 0, Line: 4},
+			},
+		},
+		{
+			name: "no exit",
+			fields: fields{
+				input: `
+x = 1;
+`,
+				values: map[string]valHolder{
+					"x": {
+						dataType: INT,
+						name:     "x",
+						value:    int64(1),
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := setup(tt.fields.input)
+			if !compare(r.Values(), tt.fields.values) {
+				t.Errorf("expected %+v, found %+v\n", tt.fields.values, r.Values())
+			}
+			if !reflect.DeepEqual(r.Exit(), tt.fields.exit) {
+				t.Errorf("expected exit %+v, found %+v\n", tt.fields.exit, r.Exit())
+			}
+		})
+	}
+}
+
 func TestKlangListener_handlekubectlget(t *testing.T) {
 	d := `x = kubectl get -n dev cm/test-cm;
 z = "metadata.name";
@@ -661,10 +861,167 @@ func setup(input string) *KlangListener {
 	p.BuildParseTrees = true
 	mapper := NewMapperFactory()
 	r := NewKlangListener(mapper)
-	antlr.ParseTreeWalkerDefault.Walk(r, p.Parse())
+	r.Run(p.Parse())
 	return r
 }
 
+func TestKlangListener_SetValue(t *testing.T) {
+	r := NewKlangListener(nil)
+	if err := r.SetValue("release", "web"); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.SetValue("replicas", int64(2)); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.SetValue("1st", "x"); err == nil {
+		t.Errorf("expected invalid variable name error")
+	}
+	tree, errs := Parse(`name = "${release}-${replicas}"; next = replicas + 1;`)
+	if len(errs) != 0 {
+		t.Fatal(JoinSyntaxErrors(errs))
+	}
+	r.Run(tree)
+	if name := r.Values()["name"]; name.value != "web-2" {
+		t.Errorf("expected web-2, found %+v", name)
+	}
+	if next := r.Values()["next"]; next.value != int64(3) {
+		t.Errorf("expected 3, found %+v", next)
+	}
+}
+
+type stepRecorder struct {
+	steps []string
+}
+
+func (s *stepRecorder) ReceiveStep(name string) {
+	s.steps = append(s.steps, name)
+}
+
+func TestKlangListener_stepInfo(t *testing.T) {
+	r := NewKlangListener(nil)
+	recorder := &stepRecorder{}
+	r.stepReceivers = append(r.stepReceivers, recorder)
+	tree, errs := Parse("stepInfo \"install\";\nif false {\n  stepInfo \"skipped\";\n}\nfor i in [1] {\n  stepInfo `verify`;\n}\n")
+	if len(errs) != 0 {
+		t.Fatal(JoinSyntaxErrors(errs))
+	}
+	r.Run(tree)
+	if !reflect.DeepEqual(recorder.steps, []string{"install", "verify"}) {
+		t.Errorf("expected steps install, verify, found %v", recorder.steps)
+	}
+}
+
+func TestKlangListener_shortCircuit(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		values   map[string]interface{}
+		commands string
+	}{
+		{
+			name:   "and skips the command if the left operand is false",
+			input:  "exists = false;\nr = exists && kubectl delete ns/apps;",
+			values: map[string]interface{}{"r": false},
+		},
+		{
+			name:     "and runs the command if the left operand is true",
+			input:    "exists = 1;\nr = exists && kubectl delete ns/apps;",
+			values:   map[string]interface{}{"r": true},
+			commands: "dry run: kubectl delete ns/apps\n",
+		},
+		{
+			name:   "or skips the command if the left operand is true",
+			input:  "r = \"apps\" || kubectl apply \"kind: Namespace\";",
+			values: map[string]interface{}{"r": true},
+		},
+		{
+			name:     "or runs the command if the left operand is false",
+			input:    "r = nil || kubectl delete ns/apps;",
+			values:   map[string]interface{}{"r": true},
+			commands: "dry run: kubectl delete ns/apps\n",
+		},
+		{
+			name:   "failed command is false",
+			input:  "r = kubectl get ns/apps && kubectl delete ns/apps;\nn = !kubectl get ns/apps;",
+			values: map[string]interface{}{"r": false, "n": true},
+		},
+		{
+			name:   "nested operands",
+			input:  "r = false && (kubectl delete ns/a || kubectl delete ns/b);\ns = true || false && kubectl delete ns/c;",
+			values: map[string]interface{}{"r": false, "s": true},
+		},
+		{
+			name:     "conditions",
+			input:    "if 0 && kubectl delete ns/a {\n  x = 1;\n}\nif \"\" || kubectl delete ns/b {\n  y = 1;\n}",
+			values:   map[string]interface{}{"y": int64(1)},
+			commands: "dry run: kubectl delete ns/b\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, errs := Parse(tt.input)
+			if len(errs) != 0 {
+				t.Fatal(JoinSyntaxErrors(errs))
+			}
+			r := NewKlangListener(nil)
+			r.SetDryRun(true)
+			var commands bytes.Buffer
+			r.SetOutput(&commands)
+			r.Run(tree)
+			if commands.String() != tt.commands {
+				t.Errorf("expected commands %q, found %q", tt.commands, commands.String())
+			}
+			for name, want := range tt.values {
+				if got := r.Values()[name]; got.value != want {
+					t.Errorf("expected %s = %v, found %+v", name, want, got)
+				}
+			}
+			if _, ok := r.Values()["x"]; ok {
+				t.Errorf("expected x not to be assigned")
+			}
+		})
+	}
+}
+
+func TestKlangListener_isTrue(t *testing.T) {
+	tests := []struct {
+		name string
+		val  valHolder
+		want bool
+	}{
+		{name: "true", val: newBooleanValHolder(true), want: true},
+		{name: "false", val: newBooleanValHolder(false)},
+		{name: "int", val: newIntValHolder(-1), want: true},
+		{name: "zero int", val: newIntValHolder(0)},
+		{name: "float", val: newFloatValHolder(0.5), want: true},
+		{name: "zero float", val: newFloatValHolder(0)},
+		{name: "duration", val: newDurationValHolder(time.Second), want: true},
+		{name: "zero duration", val: newDurationValHolder(0)},
+		{name: "string", val: newStringValHolder("false"), want: true},
+		{name: "empty string", val: newStringValHolder("")},
+		{name: "list", val: newListValHolder([]valHolder{newNilValHolder()}), want: true},
+		{name: "empty list", val: newListValHolder(nil)},
+		{name: "map", val: newMapValHolder(map[string]valHolder{"a": newNilValHolder()}), want: true},
+		{name: "empty map", val: newMapValHolder(map[string]valHolder{})},
+		{name: "defined variable", val: valHolder{dataType: ID, name: "ready", value: "ready"}, want: true},
+		{name: "undefined variable", val: valHolder{dataType: ID, name: "missing", value: "missing"}},
+		{name: "nil", val: newNilValHolder()},
+		{name: "error", val: valHolder{dataType: ERR, value: errors.New("failed")}},
+		{name: "no value", val: newEmptyHolder()},
+	}
+	l := NewKlangListener(nil)
+	if err := l.SetValue("ready", "yes"); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := l.isTrue(tt.val); got != tt.want {
+				t.Errorf("isTrue() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
 func compare(first, second map[string]valHolder) bool {
 	return checkFirstInSecond(first, second) && checkFirstInSecond(second, first)
 }
@@ -0,0 +1,138 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"fmt"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+	"sort"
+)
+
+// BuiltinFunc is a function callable from klang scripts. Arguments and the result are plain go values:
+// string, bool, int64, float64, nil, []interface{} and map[string]interface{}
+type BuiltinFunc func(args ...interface{}) (interface{}, error)
+
+var defaultBuiltins = map[string]BuiltinFunc{}
+
+func registerDefaultBuiltins(builtins map[string]BuiltinFunc) {
+	for name, fn := range builtins {
+		defaultBuiltins[name] = fn
+	}
+}
+
+func newBuiltins() map[string]BuiltinFunc {
+	builtins := make(map[string]BuiltinFunc, len(defaultBuiltins))
+	for name, fn := range defaultBuiltins {
+		builtins[name] = fn
+	}
+	return builtins
+}
+
+// BuiltinNames returns the sorted names of the functions every script can call without declaring them
+func BuiltinNames() []string {
+	names := []string{waitForFunc}
+	for name := range defaultBuiltins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RegisterFunction makes fn callable as name from scripts run by this listener, replacing any builtin
+// of the same name. Functions declared in the script take precedence over registered ones.
+func (l *KlangListener) RegisterFunction(name string, fn BuiltinFunc) error {
+	if !isIdentifier(name) {
+		return fmt.Errorf("invalid function name %q", name)
+	}
+	if fn == nil {
+		return fmt.Errorf("function %s cannot be nil", name)
+	}
+	l.builtins[name] = fn
+	return nil
+}
+
+func isIdentifier(name string) bool {
+	if len(name) == 0 {
+		return false
+	}
+	for i, c := range name {
+		letter := c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+		if !letter && (i == 0 || c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+func (l *KlangListener) handleBuiltin_call(ctx *parser.Func_callContext, fn BuiltinFunc) valHolder {
+	name := ctx.Func_name().GetText()
+	args := make([]interface{}, 0, len(ctx.AllExpr()))
+	for _, arg := range ctx.AllExpr() {
+		r := l.handleExpr(arg)
+		r = l.getValIfID(r)
+		if r.dataType == ERR {
+			return r
+		}
+		args = append(args, toNative(r))
+	}
+	out, err := fn(args...)
+	if err != nil {
+		return newErrHolder(fmt.Errorf("%s: %v", name, err))
+	}
+	return fromNative(out)
+}
+
+func checkArgCount(args []interface{}, min int, max int) error {
+	if len(args) < min || (max != -1 && len(args) > max) {
+		if min == max {
+			return fmt.Errorf("expects %d arguments, found %d", min, len(args))
+		}
+		if max == -1 {
+			return fmt.Errorf("expects at least %d arguments, found %d", min, len(args))
+		}
+		return fmt.Errorf("expects %d to %d arguments, found %d", min, max, len(args))
+	}
+	return nil
+}
+
+func stringArg(args []interface{}, i int) (string, error) {
+	s, ok := args[i].(string)
+	if !ok {
+		return "", fmt.Errorf("argument %d should be a string, found %T", i+1, args[i])
+	}
+	return s, nil
+}
+
+func intArg(args []interface{}, i int) (int64, error) {
+	n, ok := args[i].(int64)
+	if !ok {
+		return 0, fmt.Errorf("argument %d should be an int, found %T", i+1, args[i])
+	}
+	return n, nil
+}
+
+func stringArgs(args []interface{}) ([]string, error) {
+	out := make([]string, 0, len(args))
+	for i := range args {
+		s, err := stringArg(args, i)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, s)
+	}
+	return out, nil
+}
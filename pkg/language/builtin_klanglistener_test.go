@@ -0,0 +1,234 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"fmt"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	parser2 "github.com/devtron-labs/inception/pkg/language/parser"
+	"testing"
+)
+
+func TestKlangListener_handleBuiltin_call(t *testing.T) {
+	type fields struct {
+		input  string
+		values map[string]valHolder
+	}
+	tests := []struct {
+		name   string
+		fields fields
+	}{
+		{
+			name: "image tag",
+			fields: fields{
+				input: `
+image = "quay.io/devtron/inception:v0.1.2";
+parts = split(image, ":");
+tag = parts[len(parts) - 1];
+repo = join([parts[0], "latest"], ":");
+`,
+				values: map[string]valHolder{
+					"tag": {
+						dataType: STRING,
+						name:     "tag",
+						value:    "v0.1.2",
+					},
+					"repo": {
+						dataType: STRING,
+						name:     "repo",
+						value:    "quay.io/devtron/inception:latest",
+					},
+				},
+			},
+		},
+		{
+			name: "predicates",
+			fields: fields{
+				input: `
+s = "  Hello World ";
+t = trim(s);
+a = contains(t, "lo W") && hasPrefix(t, "Hello") && hasSuffix(t, "World");
+b = regexMatch(t, "^H.*d$");
+c = contains(["a", "b"], "c");
+`,
+				values: map[string]valHolder{
+					"t": {
+						dataType: STRING,
+						name:     "t",
+						value:    "Hello World",
+					},
+					"a": {
+						dataType: BOOLEAN,
+						name:     "a",
+						value:    true,
+					},
+					"b": {
+						dataType: BOOLEAN,
+						name:     "b",
+						value:    true,
+					},
+					"c": {
+						dataType: BOOLEAN,
+						name:     "c",
+						value:    false,
+					},
+				},
+			},
+		},
+		{
+			name: "transformations",
+			fields: fields{
+				input: `
+a = upper(replace("a-b-c", "-", "_"));
+b = lower(substr("INCEPTION", 0, 2));
+c = regexReplace("v1.2.3", ` + "`" + `v(\d+)\..*` + "`" + `, "major-$1");
+d = format("%s has %d replicas", "web", 3);
+e = trim("--name--", "-");
+`,
+				values: map[string]valHolder{
+					"a": {
+						dataType: STRING,
+						name:     "a",
+						value:    "A_B_C",
+					},
+					"b": {
+						dataType: STRING,
+						name:     "b",
+						value:    "in",
+					},
+					"c": {
+						dataType: STRING,
+						name:     "c",
+						value:    "major-1",
+					},
+					"d": {
+						dataType: STRING,
+						name:     "d",
+						value:    "web has 3 replicas",
+					},
+					"e": {
+						dataType: STRING,
+						name:     "e",
+						value:    "name",
+					},
+				},
+			},
+		},
+		{
+			name: "script functions shadow builtins",
+			fields: fields{
+				input: `
+func upper(s) {
+  return "shadowed";
+}
+x = upper("a");
+`,
+				values: map[string]valHolder{
+					"x": {
+						dataType: STRING,
+						name:     "x",
+						value:    "shadowed",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := setup(tt.fields.input)
+			m := make(map[string]valHolder, len(tt.fields.values))
+			for k := range tt.fields.values {
+				if d, ok := r.values[k]; ok {
+					m[k] = d
+				}
+			}
+			if diff := compare(tt.fields.values, m); !diff {
+				t.Errorf("expected %+v, found %+v\n", tt.fields.values, r.Values())
+			}
+		})
+	}
+}
+
+func TestKlangListener_RegisterFunction(t *testing.T) {
+	l := NewKlangListener(nil)
+	if err := l.RegisterFunction("1bad", builtinUpper); err == nil {
+		t.Errorf("expected error for invalid name")
+	}
+	if err := l.RegisterFunction("nilFn", nil); err == nil {
+		t.Errorf("expected error for nil function")
+	}
+	err := l.RegisterFunction("greet", func(args ...interface{}) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("expects 1 argument")
+		}
+		return fmt.Sprintf("hello %v", args[0]), nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := parser2.NewKlangParser(antlr.NewCommonTokenStream(parser2.NewKlangLexer(antlr.NewInputStream(`x = greet("klang"); y = greet();`)), antlr.TokenDefaultChannel))
+	l.Run(p.Parse())
+	if x := l.values["x"]; x.dataType != STRING || x.value != "hello klang" {
+		t.Errorf("expected hello klang, found %+v", x)
+	}
+	if y := l.values["y"]; y.dataType != ERR {
+		t.Errorf("expected error, found %+v", y)
+	}
+	if _, ok := NewKlangListener(nil).builtins["greet"]; ok {
+		t.Errorf("registered function leaked to other listeners")
+	}
+}
+
+func TestKlangListener_handleEncodingBuiltins(t *testing.T) {
+	input := `
+password = "s3cr3t";
+secret = {"apiVersion": "v1", "kind": "Secret", "data": {}};
+jsonEdit(secret, "data.password", base64Encode(password));
+encoded = secret.data.password;
+decoded = base64Decode(encoded);
+checksum = sha256(toJson(secret));
+short = sha1("abc");
+hex = hexEncode("hi");
+query = urlEncode("a b&c");
+m = fromJson(` + "`" + `{"replicas": 2, "labels": ["a"]}` + "`" + `);
+replicas = m.replicas;
+back = toJson(m);
+bad = base64Decode("%%%");
+`
+	r := setup(input)
+	expected := map[string]string{
+		"encoded":  `"czNjcjN0"`,
+		"decoded":  `"s3cr3t"`,
+		"short":    `"a9993e364706816aba3e25717850c26c9cd0d89d"`,
+		"hex":      `"6869"`,
+		"query":    `"a+b%26c"`,
+		"replicas": `2`,
+		"back":     `"{\"labels\":[\"a\"],\"replicas\":2}"`,
+	}
+	for k, e := range expected {
+		found, err := toJsonString(r.values[k])
+		if err != nil || found != e {
+			t.Errorf("%s expected %s, found %s %v", k, e, found, err)
+		}
+	}
+	if checksum := r.values["checksum"]; checksum.dataType != STRING || len(checksum.value.(string)) != 64 {
+		t.Errorf("expected sha256 checksum, found %+v", checksum)
+	}
+	if bad := r.values["bad"]; bad.dataType != ERR {
+		t.Errorf("expected error, found %+v", bad)
+	}
+}
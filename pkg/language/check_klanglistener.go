@@ -0,0 +1,667 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"fmt"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+	"sort"
+	"strings"
+)
+
+// Severity of a Diagnostic, scripts with errors must not be run while warnings are only reported
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Diagnostic is a mistake found in a script without running it
+type Diagnostic struct {
+	Severity Severity
+	Line     int
+	Column   int
+	Message  string
+}
+
+func (d Diagnostic) Error() string {
+	return fmt.Sprintf("line %d:%d %s: %s", d.Line, d.Column, d.Severity, d.Message)
+}
+
+// HasErrors is true if any of diagnostics is an error
+func HasErrors(diagnostics []Diagnostic) bool {
+	for _, d := range diagnostics {
+		if d.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// JoinDiagnostics formats the diagnostics of severity on one line for conditions and error messages
+func JoinDiagnostics(diagnostics []Diagnostic, severity Severity) string {
+	var messages []string
+	for _, d := range diagnostics {
+		if d.Severity == severity {
+			messages = append(messages, d.Error())
+		}
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Check finds mistakes in tree before it is walked: undefined variables and functions, wrong number of
+// arguments, edits of variables which are never assigned, unreachable statements and while loops whose
+// condition never changes. Values, functions and built-ins already known to l are taken into account.
+// tree must not have syntax errors, diagnostics are sorted by position.
+func (l *KlangListener) Check(tree parser.IParseContext) []Diagnostic {
+	c := newChecker(l)
+	if pc, ok := tree.(*parser.ParseContext); ok && pc.Block() != nil {
+		block := pc.Block().(*parser.BlockContext)
+		c.collect(block, false)
+		c.checkStats(block.AllStat())
+	}
+	return c.sorted()
+}
+
+// checkExpr finds the mistakes of an expression evaluated on its own with the values and functions known to l
+func (l *KlangListener) checkExpr(expr parser.IExprContext) []Diagnostic {
+	c := newChecker(l)
+	c.visit(expr)
+	return c.sorted()
+}
+
+// checker follows the scoping of the listener: blocks have their own scope, functions see their
+// parameters, locals and globals but not the scopes of the caller
+type checker struct {
+	builtins map[string]BuiltinFunc
+	known    map[string]*klangFunc
+	funcs    map[string]*parser.Func_declContext
+	//bound holds every variable assigned anywhere in the script
+	bound map[string]bool
+	//globals holds the variables assigned outside functions, functions may be called after they are assigned
+	globals map[string]bool
+	//funcAssigned holds the variables assigned inside functions, a call may change them
+	funcAssigned map[string]bool
+	modules      map[string]bool
+	imported     map[string]bool
+	scopes       []map[string]bool
+	fn           *parser.Func_declContext
+	timeouts     int
+	loops        int
+	diagnostics  []Diagnostic
+}
+
+func newChecker(l *KlangListener) *checker {
+	globals := make(map[string]bool)
+	for name := range l.values {
+		globals[name] = true
+	}
+	imported := make(map[string]bool)
+	for alias := range l.modules {
+		imported[alias] = true
+	}
+	return &checker{
+		builtins:     l.builtins,
+		known:        l.funcs,
+		funcs:        make(map[string]*parser.Func_declContext),
+		bound:        make(map[string]bool),
+		globals:      make(map[string]bool),
+		funcAssigned: make(map[string]bool),
+		modules:      make(map[string]bool),
+		imported:     imported,
+		scopes:       []map[string]bool{globals},
+	}
+}
+
+func (c *checker) sorted() []Diagnostic {
+	sort.SliceStable(c.diagnostics, func(i, j int) bool {
+		a, b := c.diagnostics[i], c.diagnostics[j]
+		return a.Line < b.Line || (a.Line == b.Line && a.Column < b.Column)
+	})
+	return c.diagnostics
+}
+
+func (c *checker) report(severity Severity, token antlr.Token, format string, a ...interface{}) {
+	c.diagnostics = append(c.diagnostics, Diagnostic{
+		Severity: severity,
+		Line:     token.GetLine(),
+		Column:   token.GetColumn(),
+		Message:  fmt.Sprintf(format, a...),
+	})
+}
+
+// collect finds the functions, modules and variables of the script before it is checked in order
+func (c *checker) collect(tree antlr.Tree, inFunc bool) {
+	switch v := tree.(type) {
+	case *parser.Func_declContext:
+		if !inFunc {
+			c.funcs[v.ID().GetText()] = v
+		}
+		if v.Param_list() != nil {
+			for _, param := range v.Param_list().(*parser.Param_listContext).AllID() {
+				c.bound[param.GetText()] = true
+			}
+		}
+		inFunc = true
+	case *parser.AssignmentContext:
+		name := v.ID().GetText()
+		c.bound[name] = true
+		if inFunc {
+			c.funcAssigned[name] = true
+		} else {
+			c.globals[name] = true
+		}
+	case *parser.For_statContext:
+		for _, id := range v.AllID() {
+			c.bound[id.GetText()] = true
+		}
+	case *parser.Catch_clauseContext:
+		c.bound[v.ID().GetText()] = true
+	case *parser.Import_statContext:
+		//the alias of a module is also a variable holding the variables of the module
+		name := v.ID().GetText()
+		c.modules[name] = true
+		c.bound[name] = true
+		if inFunc {
+			c.funcAssigned[name] = true
+		} else {
+			c.globals[name] = true
+		}
+	}
+	for _, child := range tree.GetChildren() {
+		c.collect(child, inFunc)
+	}
+}
+
+func (c *checker) pushScope() {
+	c.scopes = append(c.scopes, make(map[string]bool))
+}
+
+func (c *checker) popScope() {
+	c.scopes = c.scopes[:len(c.scopes)-1]
+}
+
+func (c *checker) declare(name string) {
+	c.scopes[len(c.scopes)-1][name] = true
+}
+
+func (c *checker) assign(name string) {
+	if !c.defined(name) {
+		c.scopes[0][name] = true
+	}
+}
+
+func (c *checker) defined(name string) bool {
+	for i := len(c.scopes) - 1; i >= 0; i-- {
+		if c.scopes[i][name] {
+			return true
+		}
+	}
+	return c.fn != nil && c.globals[name]
+}
+
+func (c *checker) use(name string, token antlr.Token) {
+	if c.defined(name) {
+		return
+	}
+	if c.bound[name] {
+		c.report(SeverityWarning, token, "variable %s may be used before it is assigned", name)
+		return
+	}
+	c.report(SeverityError, token, "undefined variable %s", name)
+}
+
+func (c *checker) edit(id antlr.TerminalNode) {
+	name := id.GetText()
+	if c.defined(name) {
+		return
+	}
+	if c.bound[name] {
+		c.report(SeverityWarning, id.GetSymbol(), "variable %s may be edited before it is assigned", name)
+		return
+	}
+	c.report(SeverityError, id.GetSymbol(), "cannot edit %s, it is never assigned", name)
+}
+
+// checkStats checks the statements of a block in order, statements after one which always ends
+// the script, the function or the block are reported once
+func (c *checker) checkStats(stats []parser.IStatContext) {
+	ended := ""
+	for _, stat := range stats {
+		sc := stat.(*parser.StatContext)
+		if len(ended) != 0 && sc.Func_decl() == nil {
+			c.report(SeverityWarning, sc.GetStart(), "unreachable code after %s", ended)
+			ended = ""
+		}
+		c.checkStat(sc)
+		if end := endOf(sc); len(end) != 0 {
+			ended = end
+		}
+	}
+}
+
+func (c *checker) checkBlock(ctx parser.IBlockContext) {
+	c.pushScope()
+	c.checkStats(ctx.(*parser.BlockContext).AllStat())
+	c.popScope()
+}
+
+func (c *checker) checkStat_block(ctx parser.IStat_blockContext) {
+	sb := ctx.(*parser.Stat_blockContext)
+	if sb.Block() != nil {
+		c.checkBlock(sb.Block())
+		return
+	}
+	c.pushScope()
+	c.checkStat(sb.Stat().(*parser.StatContext))
+	c.popScope()
+}
+
+func (c *checker) checkStat(ctx *parser.StatContext) {
+	switch v := ctx.GetChild(0).(type) {
+	case *parser.AssignmentContext:
+		c.visitChildren(v)
+		if v.LET() != nil || v.CONST() != nil {
+			c.declare(v.ID().GetText())
+		} else {
+			c.assign(v.ID().GetText())
+		}
+	case *parser.Json_edit_fnContext:
+		c.edit(v.ID())
+		c.visitChildren(v)
+	case *parser.Json_delete_fnContext:
+		c.edit(v.ID())
+		c.visitChildren(v)
+	case *parser.Yaml_edit_fnContext:
+		c.edit(v.ID())
+		c.visitChildren(v)
+	case *parser.Yaml_delete_fnContext:
+		c.edit(v.ID())
+		c.visitChildren(v)
+	case *parser.Kube_json_edit_fnContext:
+		c.edit(v.ID())
+		c.visitChildren(v)
+	case *parser.Kube_json_delete_fnContext:
+		c.edit(v.ID())
+		c.visitChildren(v)
+	case *parser.Kube_yaml_edit_fnContext:
+		c.edit(v.ID())
+		c.visitChildren(v)
+	case *parser.Kube_yaml_delete_fnContext:
+		c.edit(v.ID())
+		c.visitChildren(v)
+	case *parser.If_statContext:
+		for _, cb := range v.AllCondition_block() {
+			cb := cb.(*parser.Condition_blockContext)
+			c.visit(cb.Expr())
+			c.checkStat_block(cb.Stat_block())
+		}
+		if v.Stat_block() != nil {
+			c.checkStat_block(v.Stat_block())
+		}
+	case *parser.While_statContext:
+		c.visit(v.Expr())
+		c.checkWhile(v)
+		c.loops++
+		c.checkStat_block(v.Stat_block())
+		c.loops--
+	case *parser.For_statContext:
+		c.visit(v.Expr())
+		c.pushScope()
+		for _, id := range v.AllID() {
+			c.declare(id.GetText())
+		}
+		c.loops++
+		c.checkStat_block(v.Stat_block())
+		c.loops--
+		c.popScope()
+	case *parser.Func_declContext:
+		if c.fn != nil {
+			c.report(SeverityError, v.GetStart(), "function %s cannot be declared inside function %s", v.ID().GetText(), c.fn.ID().GetText())
+			return
+		}
+		c.checkFunc_decl(v)
+	case *parser.Return_statContext:
+		if c.fn == nil {
+			c.report(SeverityError, v.GetStart(), "return outside function")
+		}
+		c.visitChildren(v)
+	case *parser.Break_statContext:
+		if c.loops == 0 {
+			c.report(SeverityError, v.GetStart(), "break outside loop")
+		}
+	case *parser.Try_statContext:
+		c.checkBlock(v.Block())
+		if v.Catch_clause() != nil {
+			cc := v.Catch_clause().(*parser.Catch_clauseContext)
+			c.pushScope()
+			c.declare(cc.ID().GetText())
+			c.checkBlock(cc.Block())
+			c.popScope()
+		}
+		if v.Finally_clause() != nil {
+			c.checkBlock(v.Finally_clause().(*parser.Finally_clauseContext).Block())
+		}
+	case *parser.Import_statContext:
+		c.visitChildren(v)
+		c.imported[v.ID().GetText()] = true
+		c.assign(v.ID().GetText())
+	case *parser.Timeout_statContext:
+		c.visit(v.Expr())
+		c.timeouts++
+		c.checkBlock(v.Block())
+		c.timeouts--
+	default:
+		c.visit(v)
+	}
+}
+
+// checkFunc_decl checks the body of a function in a frame of its own
+func (c *checker) checkFunc_decl(ctx *parser.Func_declContext) {
+	frame := make(map[string]bool)
+	if ctx.Param_list() != nil {
+		for _, param := range ctx.Param_list().(*parser.Param_listContext).AllID() {
+			frame[param.GetText()] = true
+		}
+	}
+	scopes, timeouts, loops := c.scopes, c.timeouts, c.loops
+	c.scopes, c.timeouts, c.loops, c.fn = []map[string]bool{frame}, 0, 0, ctx
+	c.checkBlock(ctx.Block())
+	c.scopes, c.timeouts, c.loops, c.fn = scopes, timeouts, loops, nil
+}
+
+// visit checks the variables, functions and interpolations used by an expression or a statement
+// which does not bind variables
+func (c *checker) visit(tree antlr.Tree) {
+	switch v := tree.(type) {
+	case *parser.IdAtomContext:
+		c.use(v.ID().GetText(), v.ID().GetSymbol())
+	case *parser.PathAtomContext:
+		path := v.PATH().GetText()
+		i := strings.Index(path, ".")
+		if i == -1 {
+			c.report(SeverityError, v.GetStart(), "undefined variable %s, operators must be separated by spaces in expressions like a - b", path)
+			return
+		}
+		c.use(path[:i], v.GetStart())
+	case *parser.StringAtomContext:
+		c.checkInterpolations(v.STRING().GetSymbol())
+	case *parser.RawStringAtomContext:
+		c.checkInterpolations(v.RAW_STRING_LIT().GetSymbol())
+	case *parser.JsonAtomContext:
+		return
+	case *parser.String_or_idContext:
+		c.checkString_or_id(v, true)
+	case *parser.NsContext, *parser.ResourceContext, *parser.Patch_typeContext:
+		//namespaces, resources and patch types which are not variables are used as written
+		for _, child := range v.GetChildren() {
+			if soi, ok := child.(*parser.String_or_idContext); ok {
+				c.checkString_or_id(soi, false)
+			}
+		}
+	case *parser.Json_select_fnContext:
+		c.use(v.ID().GetText(), v.ID().GetSymbol())
+		c.visitChildren(v)
+	case *parser.Yaml_select_fnContext:
+		c.use(v.ID().GetText(), v.ID().GetSymbol())
+		c.visitChildren(v)
+	case *parser.Func_callContext:
+		c.checkFunc_call(v)
+		c.visitChildren(v)
+	default:
+		c.visitChildren(tree)
+	}
+}
+
+func (c *checker) visitChildren(tree antlr.Tree) {
+	for _, child := range tree.GetChildren() {
+		if _, ok := child.(antlr.TerminalNode); !ok {
+			c.visit(child)
+		}
+	}
+}
+
+func (c *checker) checkString_or_id(ctx *parser.String_or_idContext, variable bool) {
+	switch {
+	case ctx.ID() != nil:
+		if variable {
+			c.use(ctx.ID().GetText(), ctx.ID().GetSymbol())
+		}
+	case ctx.STRING() != nil:
+		c.checkInterpolations(ctx.STRING().GetSymbol())
+	case ctx.RAW_STRING_LIT() != nil:
+		c.checkInterpolations(ctx.RAW_STRING_LIT().GetSymbol())
+	}
+}
+
+// checkInterpolations checks the expressions of ${expr} in a string, they are reported at the string
+func (c *checker) checkInterpolations(token antlr.Token) {
+	text := token.GetText()
+	if len(text) < 2 {
+		return
+	}
+	sources, err := interpolations(text[1 : len(text)-1])
+	if err != nil {
+		c.report(SeverityError, token, "%v", err)
+	}
+	for _, source := range sources {
+		expr, err := parseInterpolation(source)
+		if err != nil {
+			c.report(SeverityError, token, "%v", err)
+			continue
+		}
+		start := len(c.diagnostics)
+		c.visit(expr)
+		//positions are relative to the expression, not the script
+		for i := start; i < len(c.diagnostics); i++ {
+			c.diagnostics[i].Line = token.GetLine()
+			c.diagnostics[i].Column = token.GetColumn()
+		}
+	}
+}
+
+func (c *checker) checkFunc_call(ctx *parser.Func_callContext) {
+	fnc := ctx.Func_name().(*parser.Func_nameContext)
+	name := fnc.GetText()
+	args := len(ctx.AllExpr())
+	token := ctx.GetStart()
+	if fnc.PATH() != nil {
+		alias := name[:strings.Index(name, ".")]
+		if !c.modules[alias] && !c.imported[alias] {
+			c.report(SeverityError, token, "module %s is not imported", alias)
+		} else if c.fn == nil && !c.imported[alias] {
+			c.report(SeverityError, token, "module %s is used before it is imported", alias)
+		}
+		return
+	}
+	if decl, ok := c.funcs[name]; ok {
+		if c.fn == nil && decl.GetStart().GetTokenIndex() > token.GetTokenIndex() {
+			c.report(SeverityError, token, "function %s is called before it is declared", name)
+		}
+		params := 0
+		if decl.Param_list() != nil {
+			params = len(decl.Param_list().(*parser.Param_listContext).AllID())
+		}
+		if params != args {
+			c.report(SeverityError, token, "function %s expects %d arguments, found %d", name, params, args)
+		}
+		return
+	}
+	if f, ok := c.known[name]; ok {
+		if len(f.params) != args {
+			c.report(SeverityError, token, "function %s expects %d arguments, found %d", name, len(f.params), args)
+		}
+		return
+	}
+	if name == waitForFunc {
+		if args < 1 || args > 3 {
+			c.report(SeverityError, token, "%s expects 1 to 3 arguments, found %d", waitForFunc, args)
+		}
+		return
+	}
+	if _, ok := c.builtins[name]; !ok {
+		c.report(SeverityError, token, "function %s is not defined", name)
+	}
+}
+
+// checkWhile warns about loops which cannot end: the condition has no side effects, the body does not
+// change any of its variables and nothing in the body leaves the loop
+func (c *checker) checkWhile(ctx *parser.While_statContext) {
+	if c.timeouts != 0 || hasSideEffects(ctx.Expr()) || leavesLoop(ctx.Stat_block(), true) {
+		return
+	}
+	vars := make(map[string]bool)
+	conditionVars(ctx.Expr(), vars)
+	if len(vars) == 0 {
+		c.report(SeverityWarning, ctx.Expr().GetStart(), "while condition is constant, the loop never ends if it runs")
+		return
+	}
+	changed := make(map[string]bool)
+	if c.changedVars(ctx.Stat_block(), changed) {
+		for name := range c.funcAssigned {
+			changed[name] = true
+		}
+	}
+	var names []string
+	for name := range vars {
+		if changed[name] {
+			return
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	c.report(SeverityWarning, ctx.Expr().GetStart(), "while condition never changes, %s not assigned in the loop", strings.Join(names, ", "))
+}
+
+// hasSideEffects is true for expressions which can change between evaluations without assignments,
+// calls, kubectl, shell scripts, downloads and interpolations are not looked into
+func hasSideEffects(tree antlr.Tree) bool {
+	switch v := tree.(type) {
+	case *parser.Func_callContext, *parser.Kubectl_commandContext, *parser.KubectlExprContext,
+		*parser.Shell_scriptContext, *parser.Download_fnContext:
+		return true
+	case *parser.StringAtomContext:
+		return strings.Contains(v.GetText(), interpolationStart)
+	case *parser.RawStringAtomContext:
+		return strings.Contains(v.GetText(), interpolationStart)
+	}
+	for _, child := range tree.GetChildren() {
+		if hasSideEffects(child) {
+			return true
+		}
+	}
+	return false
+}
+
+// leavesLoop is true if tree has a statement which ends the loop, the function or the script,
+// breaks is false inside nested loops as their breaks only end the nested loop
+func leavesLoop(tree antlr.Tree, breaks bool) bool {
+	switch tree.(type) {
+	case *parser.Exit_fnContext, *parser.Return_statContext, *parser.Throw_statContext:
+		return true
+	case *parser.Break_statContext:
+		return breaks
+	case *parser.Func_declContext:
+		return false
+	case *parser.While_statContext, *parser.For_statContext:
+		breaks = false
+	}
+	for _, child := range tree.GetChildren() {
+		if leavesLoop(child, breaks) {
+			return true
+		}
+	}
+	return false
+}
+
+func conditionVars(tree antlr.Tree, vars map[string]bool) {
+	switch v := tree.(type) {
+	case *parser.IdAtomContext:
+		vars[v.GetText()] = true
+	case *parser.PathAtomContext:
+		vars[strings.SplitN(v.GetText(), ".", 2)[0]] = true
+	case *parser.Json_select_fnContext:
+		vars[v.ID().GetText()] = true
+	case *parser.Yaml_select_fnContext:
+		vars[v.ID().GetText()] = true
+	}
+	for _, child := range tree.GetChildren() {
+		conditionVars(child, vars)
+	}
+}
+
+// changedVars collects the variables assigned or edited in tree, it is true if tree calls a function
+// of the script which may assign globals
+func (c *checker) changedVars(tree antlr.Tree, changed map[string]bool) bool {
+	calls := false
+	switch v := tree.(type) {
+	case *parser.AssignmentContext:
+		changed[v.ID().GetText()] = true
+	case *parser.Json_edit_fnContext, *parser.Json_delete_fnContext, *parser.Yaml_edit_fnContext,
+		*parser.Yaml_delete_fnContext, *parser.Kube_json_edit_fnContext, *parser.Kube_json_delete_fnContext,
+		*parser.Kube_yaml_edit_fnContext, *parser.Kube_yaml_delete_fnContext:
+		changed[v.(interface{ ID() antlr.TerminalNode }).ID().GetText()] = true
+	case *parser.Func_callContext:
+		_, calls = c.funcs[v.Func_name().GetText()]
+	}
+	for _, child := range tree.GetChildren() {
+		if c.changedVars(child, changed) {
+			calls = true
+		}
+	}
+	return calls
+}
+
+// endOf names the statement if it always ends the block it is in
+func endOf(ctx *parser.StatContext) string {
+	switch v := ctx.GetChild(0).(type) {
+	case *parser.Exit_fnContext:
+		return "exit"
+	case *parser.Return_statContext:
+		return "return"
+	case *parser.Throw_statContext:
+		return "throw"
+	case *parser.Break_statContext:
+		return "break"
+	case *parser.If_statContext:
+		if v.Stat_block() == nil || len(blockEndOf(v.Stat_block())) == 0 {
+			return ""
+		}
+		for _, cb := range v.AllCondition_block() {
+			if len(blockEndOf(cb.(*parser.Condition_blockContext).Stat_block())) == 0 {
+				return ""
+			}
+		}
+		return "if statement ending in all branches"
+	}
+	return ""
+}
+
+func blockEndOf(ctx parser.IStat_blockContext) string {
+	sb := ctx.(*parser.Stat_blockContext)
+	if sb.Stat() != nil {
+		return endOf(sb.Stat().(*parser.StatContext))
+	}
+	for _, stat := range sb.Block().(*parser.BlockContext).AllStat() {
+		if end := endOf(stat.(*parser.StatContext)); len(end) != 0 {
+			return end
+		}
+	}
+	return ""
+}
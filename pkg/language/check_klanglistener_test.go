@@ -0,0 +1,177 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestKlangListener_Check(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		want   []Diagnostic
+	}{
+		{
+			name: "valid",
+			script: `
+func add(a, b) {
+  let sum = a + b;
+  return sum;
+}
+total = 0;
+for i, v in [1, 2] {
+  total = add(total, v);
+}
+s = "total ${total} of ${len([1, 2])}";
+while total < 10 {
+  total = total + 1;
+}
+try {
+  throw "failed";
+} catch (e) {
+  log e;
+}
+`,
+		},
+		{
+			name:   "undefined variable",
+			script: "x = 1;\nlog y + x;\nlog \"${z}\";",
+			want: []Diagnostic{
+				{Severity: SeverityError, Line: 2, Column: 4, Message: "undefined variable y"},
+				{Severity: SeverityError, Line: 3, Column: 4, Message: "undefined variable z"},
+			},
+		},
+		{
+			name:   "used before it is assigned",
+			script: "log x;\nx = 1;\nif true {\n  let y = 1;\n}\nlog y;",
+			want: []Diagnostic{
+				{Severity: SeverityWarning, Line: 1, Column: 4, Message: "variable x may be used before it is assigned"},
+				{Severity: SeverityWarning, Line: 6, Column: 4, Message: "variable y may be used before it is assigned"},
+			},
+		},
+		{
+			name:   "functions see globals and their own frame",
+			script: "func f(a) {\n  return a + g + b;\n}\ng = 1;\nb = f(2);",
+		},
+		{
+			name:   "member paths and literals of kubectl are not variables",
+			script: "d = {\"status\": {\"ready\": true}};\nlog d.status.ready;\nr = kubectl get -n apps deploy/web;\nlog x.status;\nlog a-1;",
+			want: []Diagnostic{
+				{Severity: SeverityError, Line: 4, Column: 4, Message: "undefined variable x"},
+				{Severity: SeverityError, Line: 5, Column: 4, Message: "undefined variable a-1, operators must be separated by spaces in expressions like a - b"},
+			},
+		},
+		{
+			name:   "wrong argument counts",
+			script: "func f(a, b) {\n  return a;\n}\nf(1);\nwaitFor(true, 1s, 1s, 1s);\nlog upper(\"a\");",
+			want: []Diagnostic{
+				{Severity: SeverityError, Line: 4, Column: 0, Message: "function f expects 2 arguments, found 1"},
+				{Severity: SeverityError, Line: 5, Column: 0, Message: "waitFor expects 1 to 3 arguments, found 4"},
+			},
+		},
+		{
+			name:   "undefined functions and modules",
+			script: "g();\nfunc g() {\n  return h();\n}\nm.f();\nimport \"n.klang\" as n;\nn.f();\nfunc k() {\n  return o.f();\n}\nimport \"o.klang\" as o;",
+			want: []Diagnostic{
+				{Severity: SeverityError, Line: 1, Column: 0, Message: "function g is called before it is declared"},
+				{Severity: SeverityError, Line: 3, Column: 9, Message: "function h is not defined"},
+				{Severity: SeverityError, Line: 5, Column: 0, Message: "module m is not imported"},
+			},
+		},
+		{
+			name:   "variables of modules",
+			script: "log util.prefix;\nimport \"util.klang\" as util;\np = util.prefix;\nlog util;",
+			want: []Diagnostic{
+				{Severity: SeverityWarning, Line: 1, Column: 4, Message: "variable util may be used before it is assigned"},
+			},
+		},
+		{
+			name:   "edits of variables never assigned",
+			script: "jsonEdit(doc, \"a\", 1);\nyamlDelete(other, \"b\");\nx = \"{}\";\njsonEdit(x, \"a\", 1);",
+			want: []Diagnostic{
+				{Severity: SeverityError, Line: 1, Column: 9, Message: "cannot edit doc, it is never assigned"},
+				{Severity: SeverityError, Line: 2, Column: 11, Message: "cannot edit other, it is never assigned"},
+			},
+		},
+		{
+			name:   "unreachable code",
+			script: "func f() {\n  return 1;\n  log 2;\n}\nif true {\n  exit 1;\n} else {\n  throw \"x\";\n}\nlog 3;\nlog 4;",
+			want: []Diagnostic{
+				{Severity: SeverityWarning, Line: 3, Column: 2, Message: "unreachable code after return"},
+				{Severity: SeverityWarning, Line: 10, Column: 0, Message: "unreachable code after if statement ending in all branches"},
+			},
+		},
+		{
+			name:   "while conditions which never change",
+			script: "i = 0;\nn = 3;\nwhile i < n {\n  log i;\n}\nwhile true {\n  log 1;\n}\nwhile i < n {\n  if i == 2 {\n    exit 0;\n  }\n}\ntimeout 1m {\n  while true {\n    sleep 1s;\n  }\n}\nfunc ready() {\n  i = i + 1;\n  return false;\n}\nwhile ready() {\n  sleep 1s;\n}\nwhile i < n {\n  ready();\n}",
+			want: []Diagnostic{
+				{Severity: SeverityWarning, Line: 3, Column: 6, Message: "while condition never changes, i, n not assigned in the loop"},
+				{Severity: SeverityWarning, Line: 6, Column: 6, Message: "while condition is constant, the loop never ends if it runs"},
+			},
+		},
+		{
+			name:   "return outside function and nested function",
+			script: "return 1;\nfunc f() {\n  func g() {\n  }\n}",
+			want: []Diagnostic{
+				{Severity: SeverityError, Line: 1, Column: 0, Message: "return outside function"},
+				{Severity: SeverityError, Line: 3, Column: 2, Message: "function g cannot be declared inside function f"},
+			},
+		},
+		{
+			name:   "break",
+			script: "break;\nwhile true {\n  for i in [1] {\n    break;\n  }\n}\nwhile true {\n  if true {\n    break;\n    log 1;\n  }\n}\nfor i in [1] {\n  func f() {\n    break;\n  }\n}",
+			want: []Diagnostic{
+				{Severity: SeverityError, Line: 1, Column: 0, Message: "break outside loop"},
+				{Severity: SeverityWarning, Line: 2, Column: 0, Message: "unreachable code after break"},
+				{Severity: SeverityWarning, Line: 2, Column: 6, Message: "while condition is constant, the loop never ends if it runs"},
+				{Severity: SeverityWarning, Line: 10, Column: 4, Message: "unreachable code after break"},
+				{Severity: SeverityError, Line: 15, Column: 4, Message: "break outside loop"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, errs := Parse(tt.script)
+			if len(errs) != 0 {
+				t.Fatalf("syntax errors %s", JoinSyntaxErrors(errs))
+			}
+			got := NewKlangListener(nil).Check(tree)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Check() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKlangListener_Check_knownValuesAndFunctions(t *testing.T) {
+	tree, errs := Parse("log ready(release);\nlog greet(release, 1);")
+	if len(errs) != 0 {
+		t.Fatalf("syntax errors %s", JoinSyntaxErrors(errs))
+	}
+	l := NewKlangListener(nil)
+	l.values["release"] = newStringValHolder("web")
+	l.RegisterFunction("greet", func(args ...interface{}) (interface{}, error) {
+		return "hi", nil
+	})
+	l.funcs["ready"] = &klangFunc{name: "ready", params: []string{"name", "timeout"}}
+	want := []Diagnostic{{Severity: SeverityError, Line: 1, Column: 4, Message: "function ready expects 2 arguments, found 1"}}
+	if got := l.Check(tree); !reflect.DeepEqual(got, want) {
+		t.Errorf("Check() = %+v, want %+v", got, want)
+	}
+}
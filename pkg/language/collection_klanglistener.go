@@ -0,0 +1,295 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+	"sigs.k8s.io/yaml"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func newListValHolder(val []valHolder) valHolder {
+	return valHolder{dataType: LIST, value: val}
+}
+
+func newMapValHolder(val map[string]valHolder) valHolder {
+	return valHolder{dataType: MAP, value: val}
+}
+
+func isCollection(val valHolder) bool {
+	return val.dataType == LIST || val.dataType == MAP
+}
+
+func (l *KlangListener) handleList_literal(ctx *parser.List_literalContext) valHolder {
+	list := make([]valHolder, 0, len(ctx.AllExpr()))
+	for _, e := range ctx.AllExpr() {
+		r := l.handleExpr(e)
+		r = l.getValIfID(r)
+		if r.dataType == ERR {
+			return r
+		}
+		r.name = ""
+		list = append(list, r)
+	}
+	return newListValHolder(list)
+}
+
+func (l *KlangListener) handleMap_literal(ctx *parser.Map_literalContext) valHolder {
+	m := make(map[string]valHolder, len(ctx.AllMap_entry()))
+	for _, me := range ctx.AllMap_entry() {
+		entry := me.(*parser.Map_entryContext)
+		key := ""
+		if entry.STRING() != nil {
+			key = StripQuotes(entry.STRING().GetText())
+		} else {
+			key = entry.ID().GetText()
+		}
+		r := l.handleExpr(entry.Expr())
+		r = l.getValIfID(r)
+		if r.dataType == ERR {
+			return r
+		}
+		r.name = ""
+		m[key] = r
+	}
+	return newMapValHolder(m)
+}
+
+func (l *KlangListener) handleIndexExpr(ctx *parser.IndexExprContext) valHolder {
+	base := l.handleExpr(ctx.Expr(0))
+	base = l.getValIfID(base)
+	index := l.handleExpr(ctx.Expr(1))
+	index = l.getValIfID(index)
+	switch index.dataType {
+	case INT:
+		return indexValue(base, index.value.(int64))
+	case STRING:
+		return memberValue(base, index.value.(string))
+	default:
+		return newErrHolder(fmt.Errorf("invalid index type %s", index.dataType))
+	}
+}
+
+func (l *KlangListener) handleMemberExpr(ctx *parser.MemberExprContext) valHolder {
+	base := l.handleExpr(ctx.Expr())
+	base = l.getValIfID(base)
+	member := ""
+	if ctx.ID() != nil {
+		member = ctx.ID().GetText()
+	} else {
+		member = ctx.PATH().GetText()
+	}
+	return memberPath(base, strings.Split(member, "."))
+}
+
+// PATH is lexed greedily so x.metadata.name reaches here as a single token
+func (l *KlangListener) handlePathAtom(ctx *parser.PathAtomContext) valHolder {
+	segments := strings.Split(ctx.PATH().GetText(), ".")
+	base, ok := l.getValue(segments[0])
+	if !ok || len(segments) == 1 {
+		return newErrHolder(fmt.Errorf("unknown variable %s", ctx.PATH().GetText()))
+	}
+	return memberPath(base, segments[1:])
+}
+
+func memberPath(base valHolder, segments []string) valHolder {
+	for _, segment := range segments {
+		if i, err := strconv.ParseInt(segment, 10, 64); err == nil && toCollection(base).dataType == LIST {
+			base = indexValue(base, i)
+		} else {
+			base = memberValue(base, segment)
+		}
+		if base.dataType == ERR {
+			return base
+		}
+	}
+	return base
+}
+
+func indexValue(base valHolder, i int64) valHolder {
+	base = toCollection(base)
+	if base.dataType == ERR {
+		return base
+	}
+	if base.dataType != LIST {
+		return newErrHolder(fmt.Errorf("cannot index %s with a number", base.dataType))
+	}
+	list := base.value.([]valHolder)
+	if i < 0 || i >= int64(len(list)) {
+		return newErrHolder(fmt.Errorf("index %d out of range [0:%d]", i, len(list)))
+	}
+	return list[i]
+}
+
+func memberValue(base valHolder, key string) valHolder {
+	base = toCollection(base)
+	if base.dataType == ERR {
+		return base
+	}
+	if base.dataType != MAP {
+		return newErrHolder(fmt.Errorf("cannot select %s from %s", key, base.dataType))
+	}
+	if v, ok := base.value.(map[string]valHolder)[key]; ok {
+		return v
+	}
+	return newNilValHolder()
+}
+
+// toCollection converts json or yaml strings to LIST or MAP, other values are returned as is
+func toCollection(val valHolder) valHolder {
+	if val.dataType != STRING {
+		return val
+	}
+	r, err := collectionFromString(val.value.(string))
+	if err != nil {
+		return newErrHolder(err)
+	}
+	return r
+}
+
+func collectionFromString(s string) (valHolder, error) {
+	data := []byte(strings.TrimSpace(s))
+	if !json.Valid(data) {
+		var err error
+		data, err = yaml.YAMLToJSON(data)
+		if err != nil {
+			return valHolder{}, err
+		}
+	}
+	var native interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&native); err != nil {
+		return valHolder{}, err
+	}
+	r := fromNative(native)
+	if !isCollection(r) {
+		return valHolder{}, fmt.Errorf("%s is neither a list nor a map", r.dataType)
+	}
+	return r, nil
+}
+
+func fromNative(v interface{}) valHolder {
+	switch t := v.(type) {
+	case nil:
+		return newNilValHolder()
+	case bool:
+		return newBooleanValHolder(t)
+	case string:
+		return newStringValHolder(t)
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return newIntValHolder(i)
+		}
+		f, err := t.Float64()
+		if err != nil {
+			return newErrHolder(err)
+		}
+		return newFloatValHolder(f)
+	case float64:
+		return newFloatValHolder(t)
+	case int64:
+		return newIntValHolder(t)
+	case int:
+		return newIntValHolder(int64(t))
+	case []string:
+		list := make([]valHolder, 0, len(t))
+		for _, item := range t {
+			list = append(list, newStringValHolder(item))
+		}
+		return newListValHolder(list)
+	case []interface{}:
+		list := make([]valHolder, 0, len(t))
+		for _, item := range t {
+			list = append(list, fromNative(item))
+		}
+		return newListValHolder(list)
+	case map[string]interface{}:
+		m := make(map[string]valHolder, len(t))
+		for k, item := range t {
+			m[k] = fromNative(item)
+		}
+		return newMapValHolder(m)
+	default:
+		return newStringValHolder(fmt.Sprintf("%v", t))
+	}
+}
+
+func toNative(val valHolder) interface{} {
+	switch val.dataType {
+	case NIL:
+		return nil
+	case LIST:
+		list := val.value.([]valHolder)
+		out := make([]interface{}, 0, len(list))
+		for _, item := range list {
+			out = append(out, toNative(item))
+		}
+		return out
+	case MAP:
+		m := val.value.(map[string]valHolder)
+		out := make(map[string]interface{}, len(m))
+		for k, item := range m {
+			out[k] = toNative(item)
+		}
+		return out
+	case DURATION:
+		return val.value.(time.Duration).String()
+	default:
+		return val.value
+	}
+}
+
+func toJsonString(val valHolder) (string, error) {
+	data, err := json.Marshal(toNative(val))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// toDocument returns the json of LIST and MAP values so that they can be used in place of json or yaml strings
+func toDocument(val valHolder) valHolder {
+	if !isCollection(val) {
+		return val
+	}
+	s, err := toJsonString(val)
+	if err != nil {
+		return newErrHolder(err)
+	}
+	r := newStringValHolder(s)
+	r.name = val.name
+	return r
+}
+
+// fromDocument converts an edited document back to LIST or MAP when the variable held one
+func fromDocument(val valHolder, collection bool) valHolder {
+	if !collection {
+		return val
+	}
+	r, err := collectionFromString(val.value.(string))
+	if err != nil {
+		return newErrHolder(err)
+	}
+	r.name = val.name
+	return r
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"testing"
+)
+
+func TestKlangListener_handleCollections(t *testing.T) {
+	type fields struct {
+		input  string
+		values map[string]string
+		types  map[string]DataType
+	}
+	tests := []struct {
+		name   string
+		fields fields
+	}{
+		{
+			name: "list and map literals",
+			fields: fields{
+				input: `
+n = 2;
+l = [1, n, "three"];
+m = {"name": "app", replicas: n + 1};
+`,
+				values: map[string]string{
+					"l": `[1,2,"three"]`,
+					"m": `{"name":"app","replicas":3}`,
+				},
+			},
+		},
+		{
+			name: "indexing",
+			fields: fields{
+				input: `
+n = 1;
+l = [10, n, [n, 30]];
+m = {"key": l};
+a = l[0];
+b = l[2][1];
+c = m["key"][1];
+`,
+				values: map[string]string{
+					"a": `10`,
+					"b": `30`,
+					"c": `1`,
+				},
+			},
+		},
+		{
+			name: "member access on maps, json and yaml strings",
+			fields: fields{
+				input: `
+j = {"metadata": {"name": "web", "labels": {"app": "web"}}, "ports": [80, 443]};
+s = ` + "`" + `{"metadata": {"name": "api"}}` + "`" + `;
+y = ` + "`" + `
+spec:
+  replicas: 2
+` + "`" + `;
+name = j.metadata.name;
+sname = s.metadata.name;
+app = j.metadata.labels["app"];
+port = j.ports.1;
+replicas = y.spec.replicas;
+missing = j.metadata.namespace;
+`,
+				values: map[string]string{
+					"name":     `"web"`,
+					"sname":    `"api"`,
+					"app":      `"web"`,
+					"port":     `443`,
+					"replicas": `2`,
+					"missing":  `null`,
+				},
+			},
+		},
+		{
+			name: "member access on expressions",
+			fields: fields{
+				input: `
+n = 1;
+l = [{"name": "a", id: n}, {"name": "b", id: n + 1}];
+x = l[1].name;
+`,
+				values: map[string]string{
+					"x": `"b"`,
+				},
+			},
+		},
+		{
+			name: "conversion in jsonEdit and yamlEdit",
+			fields: fields{
+				input: `
+n = 3;
+m = {"spec": {"replicas": n}};
+jsonEdit(m, "spec.replicas", 5);
+ports = [80, n];
+j = {"name": "app"};
+jsonEdit(j, "ports", ports);
+y = ` + "`" + `
+kind: Service
+` + "`" + `;
+yamlEdit(y, "labels", {"app": "web", tier: n});
+app = y.labels.app;
+`,
+				values: map[string]string{
+					"m":   `{"spec":{"replicas":5}}`,
+					"j":   `{"name":"app","ports":[80,3]}`,
+					"app": `"web"`,
+				},
+			},
+		},
+		{
+			name: "constant literals",
+			fields: fields{
+				input: `
+l = [1, 2, 3];
+m = {"a": 1, "b": [true, "x"]};
+x = 1;
+ll = len(l);
+lm = len(m);
+eq = [1, 2] == [x, 2];
+j = toJson({"a": 1});
+c = contains([1, 2], 1);
+e = [];
+`,
+				values: map[string]string{
+					"l":  `[1,2,3]`,
+					"m":  `{"a":1,"b":[true,"x"]}`,
+					"ll": `3`,
+					"lm": `2`,
+					"eq": `true`,
+					"j":  `"{\"a\":1}"`,
+					"c":  `true`,
+					"e":  `[]`,
+				},
+				types: map[string]DataType{"l": LIST, "m": MAP, "e": LIST},
+			},
+		},
+		{
+			name: "for over list",
+			fields: fields{
+				input: `
+n = 2;
+total = 0;
+for i in [1, n, 3] {
+  total = total + i;
+}
+`,
+				values: map[string]string{
+					"total": `6`,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := setup(tt.fields.input)
+			for k, expected := range tt.fields.values {
+				v, ok := r.values[k]
+				if !ok {
+					t.Errorf("%s not found in %+v\n", k, r.Values())
+					continue
+				}
+				found, err := toJsonString(v)
+				if err != nil {
+					t.Errorf("%s %+v: %v\n", k, v, err)
+					continue
+				}
+				if found != expected {
+					t.Errorf("%s expected %s, found %s\n", k, expected, found)
+				}
+			}
+			for k, expected := range tt.fields.types {
+				if found := r.values[k].dataType; found != expected {
+					t.Errorf("%s expected %s, found %s\n", k, expected, found)
+				}
+			}
+		})
+	}
+}
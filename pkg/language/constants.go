@@ -35,4 +35,11 @@ const (
 	MULT
 	DIV
 	MOD
+	POW
 )
+
+var mathematicalOperatorSymbols = [...]string{"+", "-", "*", "/", "%", "^"}
+
+func (o MathematicalOperator) String() string {
+	return mathematicalOperatorSymbols[o]
+}
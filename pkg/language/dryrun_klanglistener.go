@@ -0,0 +1,50 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"fmt"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+)
+
+// SetDryRun makes kubectl commands print the command instead of sending it to the cluster, commands which
+// read from the cluster like get, wait and rollout status fail, as do shellScript and download whose output is
+// not known. Scripts can be tried without a cluster, the mapper may be nil.
+func (l *KlangListener) SetDryRun(dryRun bool) {
+	l.dryRun = dryRun
+}
+
+// handleDryRunKubectl prints the command as it is written, changes are reported as successful
+func (l *KlangListener) handleDryRunKubectl(ctx parser.IKubectl_commandContext) valHolder {
+	start, stop := ctx.GetStart(), ctx.GetStop()
+	command := start.GetInputStream().GetTextFromInterval(antlr.NewInterval(start.GetStart(), stop.GetStop()))
+	switch v := ctx.(type) {
+	case *parser.GetKubectlCommandContext, *parser.WaitKubectlCommandContext:
+		return notInDryRun(command, start.GetLine())
+	case *parser.RolloutKubectlCommandContext:
+		if v.ID().GetText() == rolloutStatus {
+			return notInDryRun(command, start.GetLine())
+		}
+	}
+	fmt.Fprintf(l.out, "dry run: %s\n", command)
+	return newBooleanValHolder(true)
+}
+
+func notInDryRun(command string, line int) valHolder {
+	return newErrHolder(fmt.Errorf("%s is not available in dry run at line %d", command, line))
+}
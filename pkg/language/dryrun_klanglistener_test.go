@@ -0,0 +1,106 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestKlangListener_handleDryRunKubectl(t *testing.T) {
+	contacted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contacted = true
+	}))
+	defer server.Close()
+	touched := filepath.Join(t.TempDir(), "touched")
+	tests := []struct {
+		name     string
+		input    string
+		err      string
+		commands string
+	}{
+		{
+			name:     "delete is printed",
+			input:    `x = kubectl delete ns/apps;`,
+			commands: "dry run: kubectl delete ns/apps\n",
+		},
+		{
+			name:     "rollout restart is printed",
+			input:    `x = kubectl rollout restart deployment/web -n apps;`,
+			commands: "dry run: kubectl rollout restart deployment/web -n apps\n",
+		},
+		{
+			name:  "get",
+			input: `x = kubectl get ns/apps;`,
+			err:   "kubectl get ns/apps is not available in dry run at line 1",
+		},
+		{
+			name:  "wait",
+			input: `x = kubectl wait deployment/web --for=condition=Available;`,
+			err:   "kubectl wait deployment/web --for=condition=Available is not available in dry run at line 1",
+		},
+		{
+			name:  "rollout status",
+			input: `x = kubectl rollout status deployment/web -n apps;`,
+			err:   "kubectl rollout status deployment/web -n apps is not available in dry run at line 1",
+		},
+		{
+			name:  "shell script",
+			input: "x = shellScript \"touch " + touched + "\";",
+			err:   "shellScript is not available in dry run at line 1",
+		},
+		{
+			name:  "download",
+			input: "x = download(\"" + server.URL + "\", \"" + filepath.Join(t.TempDir(), "downloaded") + "\");",
+			err:   "download is not available in dry run at line 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, errs := Parse(tt.input)
+			if len(errs) != 0 {
+				t.Fatal(JoinSyntaxErrors(errs))
+			}
+			l := NewKlangListener(nil)
+			l.SetDryRun(true)
+			var commands bytes.Buffer
+			l.SetOutput(&commands)
+			l.Run(tree)
+			x := l.Values()["x"]
+			if len(tt.err) != 0 && (x.dataType != ERR || x.value.(error).Error() != tt.err) {
+				t.Errorf("expected error %q, found %+v", tt.err, x)
+			}
+			if len(tt.err) == 0 && (x.dataType != BOOLEAN || x.value != true) {
+				t.Errorf("expected true, found %+v", x)
+			}
+			if commands.String() != tt.commands {
+				t.Errorf("expected commands %q, found %q", tt.commands, commands.String())
+			}
+		})
+	}
+	if contacted {
+		t.Errorf("expected download not to contact the server in dry run")
+	}
+	if _, err := os.Stat(touched); err == nil {
+		t.Errorf("expected shellScript not to run in dry run")
+	}
+}
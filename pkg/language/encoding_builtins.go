@@ -0,0 +1,138 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+func init() {
+	registerDefaultBuiltins(map[string]BuiltinFunc{
+		"base64Encode": builtinBase64Encode,
+		"base64Decode": builtinBase64Decode,
+		"sha256":       builtinSha256,
+		"sha1":         builtinSha1,
+		"hexEncode":    builtinHexEncode,
+		"urlEncode":    builtinUrlEncode,
+		"toJson":       builtinToJson,
+		"fromJson":     builtinFromJson,
+	})
+}
+
+// base64Encode(s) uses standard padded encoding as expected in the data of kubernetes secrets
+func builtinBase64Encode(args ...interface{}) (interface{}, error) {
+	s, err := singleStringArg(args)
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.EncodeToString([]byte(s)), nil
+}
+
+// base64Decode(s)
+func builtinBase64Decode(args ...interface{}) (interface{}, error) {
+	s, err := singleStringArg(args)
+	if err != nil {
+		return nil, err
+	}
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// sha256(s) is the hex encoded sha256 checksum of s
+func builtinSha256(args ...interface{}) (interface{}, error) {
+	s, err := singleStringArg(args)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// sha1(s) is the hex encoded sha1 checksum of s
+func builtinSha1(args ...interface{}) (interface{}, error) {
+	s, err := singleStringArg(args)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha1.Sum([]byte(s))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// hexEncode(s)
+func builtinHexEncode(args ...interface{}) (interface{}, error) {
+	s, err := singleStringArg(args)
+	if err != nil {
+		return nil, err
+	}
+	return hex.EncodeToString([]byte(s)), nil
+}
+
+// urlEncode(s) escapes s for use in a url query
+func builtinUrlEncode(args ...interface{}) (interface{}, error) {
+	s, err := singleStringArg(args)
+	if err != nil {
+		return nil, err
+	}
+	return url.QueryEscape(s), nil
+}
+
+// toJson(x) is the json of any value
+func builtinToJson(args ...interface{}) (interface{}, error) {
+	if err := checkArgCount(args, 1, 1); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// fromJson(s) parses json into the corresponding value
+func builtinFromJson(args ...interface{}) (interface{}, error) {
+	s, err := singleStringArg(args)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	d := json.NewDecoder(bytes.NewReader([]byte(s)))
+	d.UseNumber()
+	if err := d.Decode(&out); err != nil {
+		return nil, err
+	}
+	if d.More() {
+		return nil, fmt.Errorf("unexpected data after json value")
+	}
+	return out, nil
+}
+
+func singleStringArg(args []interface{}) (string, error) {
+	if err := checkArgCount(args, 1, 1); err != nil {
+		return "", err
+	}
+	return stringArg(args, 0)
+}
@@ -0,0 +1,342 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"fmt"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+	"math"
+	"strings"
+)
+
+const formatIndent = "  "
+
+// Format returns script in the canonical layout: one statement per line indented by two spaces per block,
+// braces on the line of their statement, single spaces around binary operators and after commas and at
+// most one blank line between statements. Comments stay on their own line or at the end of the line of
+// their statement, comments written inside a statement are moved above it. Scripts with syntax errors
+// are not formatted.
+func Format(script string) (string, error) {
+	stream, tree, syntaxErrors := parse(script)
+	if len(syntaxErrors) != 0 {
+		return "", fmt.Errorf("syntax errors, %s", JoinSyntaxErrors(syntaxErrors))
+	}
+	f := &formatter{}
+	for _, token := range stream.GetAllTokens() {
+		if token.GetChannel() == antlr.TokenHiddenChannel {
+			f.comments = append(f.comments, token)
+		}
+	}
+	if block := tree.(*parser.ParseContext).Block(); block != nil {
+		f.stats(block.(*parser.BlockContext).AllStat())
+	}
+	f.flush(math.MaxInt32)
+	return f.sb.String(), nil
+}
+
+// IsFormatted is true if script is already in the layout of Format, it can be used to check scripts before they are committed
+func IsFormatted(script string) (bool, error) {
+	formatted, err := Format(script)
+	if err != nil {
+		return false, err
+	}
+	return formatted == script, nil
+}
+
+type formatter struct {
+	sb    strings.Builder
+	depth int
+	//comments are the comments not written yet
+	comments []antlr.Token
+	//lastLine is the last line of the script written, a blank line after it is kept if blank is set
+	lastLine int
+	blank    bool
+}
+
+func (f *formatter) line(s string) {
+	f.sb.WriteString(strings.Repeat(formatIndent, f.depth))
+	f.sb.WriteString(s)
+	f.sb.WriteString("\n")
+}
+
+// gap writes a blank line if the script has blank lines before line, there is none at the start of a block
+func (f *formatter) gap(line int) {
+	if f.blank && line > f.lastLine+1 {
+		f.sb.WriteString("\n")
+	}
+}
+
+// flush writes the comments before the token at index on their own lines
+func (f *formatter) flush(index int) {
+	for len(f.comments) != 0 && f.comments[0].GetTokenIndex() < index {
+		c := f.comments[0]
+		f.comments = f.comments[1:]
+		f.gap(c.GetLine())
+		f.line(strings.TrimRight(c.GetText(), " \t"))
+		f.lastLine, f.blank = c.GetLine(), true
+	}
+}
+
+// trailing moves a comment following token on the same line to the end of the last line written
+func (f *formatter) trailing(token antlr.Token) {
+	if len(f.comments) == 0 {
+		return
+	}
+	c := f.comments[0]
+	if c.GetTokenIndex() != token.GetTokenIndex()+1 || c.GetLine() != endLine(token) {
+		return
+	}
+	f.comments = f.comments[1:]
+	written := strings.TrimSuffix(f.sb.String(), "\n")
+	f.sb.Reset()
+	f.sb.WriteString(written + " " + strings.TrimRight(c.GetText(), " \t") + "\n")
+}
+
+// stats writes statements one per line, blank lines between them are kept but not repeated
+func (f *formatter) stats(stats []parser.IStatContext) {
+	for _, stat := range stats {
+		f.stat(stat.(*parser.StatContext))
+	}
+}
+
+// nested writes the statements of a block one level deeper, blocks of a single statement without braces get them
+func (f *formatter) nested(ctx antlr.Tree) {
+	f.depth++
+	switch v := ctx.(type) {
+	case *parser.Stat_blockContext:
+		if v.Block() != nil {
+			f.stats(v.Block().(*parser.BlockContext).AllStat())
+		} else {
+			f.stat(v.Stat().(*parser.StatContext))
+		}
+	case *parser.BlockContext:
+		f.stats(v.AllStat())
+	}
+	f.depth--
+}
+
+// clause is a part of a compound statement like else or catch, its braces are nil for a single statement
+type clause struct {
+	header string
+	body   antlr.Tree
+	obrace antlr.TerminalNode
+	cbrace antlr.TerminalNode
+}
+
+func statBlockClause(header string, ctx parser.IStat_blockContext) clause {
+	v := ctx.(*parser.Stat_blockContext)
+	return clause{header: header, body: v, obrace: v.OBRACE(), cbrace: v.CBRACE()}
+}
+
+// compound writes the clauses of a statement, comments between two clauses are kept at the end of the first one
+func (f *formatter) compound(ctx *parser.StatContext, clauses []clause) {
+	for i, c := range clauses {
+		first := c.body.(antlr.ParserRuleContext).GetStart()
+		if c.obrace != nil {
+			first = c.obrace.GetSymbol()
+		}
+		if i == 0 {
+			f.flush(first.GetTokenIndex())
+			f.gap(ctx.GetStart().GetLine())
+		} else {
+			f.depth++
+			f.flush(first.GetTokenIndex())
+			f.depth--
+		}
+		f.line(c.header + " {")
+		if c.obrace != nil {
+			f.trailing(c.obrace.GetSymbol())
+			f.lastLine = c.obrace.GetSymbol().GetLine()
+		}
+		f.blank = false
+		f.nested(c.body)
+	}
+	last := clauses[len(clauses)-1]
+	if last.cbrace != nil {
+		f.depth++
+		f.flush(last.cbrace.GetSymbol().GetTokenIndex())
+		f.depth--
+	}
+	f.line("}")
+	if last.cbrace != nil {
+		f.trailing(last.cbrace.GetSymbol())
+	}
+}
+
+func (f *formatter) stat(ctx *parser.StatContext) {
+	switch v := ctx.GetChild(0).(type) {
+	case *parser.If_statContext:
+		var clauses []clause
+		for i, cb := range v.AllCondition_block() {
+			cb := cb.(*parser.Condition_blockContext)
+			header := "} else if " + inline(cb.Expr())
+			if i == 0 {
+				header = "if " + inline(cb.Expr())
+			}
+			clauses = append(clauses, statBlockClause(header, cb.Stat_block()))
+		}
+		if v.Stat_block() != nil {
+			clauses = append(clauses, statBlockClause("} else", v.Stat_block()))
+		}
+		f.compound(ctx, clauses)
+	case *parser.While_statContext:
+		f.compound(ctx, []clause{statBlockClause("while "+inline(v.Expr()), v.Stat_block())})
+	case *parser.For_statContext:
+		var ids []string
+		for _, id := range v.AllID() {
+			ids = append(ids, id.GetText())
+		}
+		f.compound(ctx, []clause{statBlockClause("for "+strings.Join(ids, ", ")+" in "+inline(v.Expr()), v.Stat_block())})
+	case *parser.Func_declContext:
+		var params []string
+		if v.Param_list() != nil {
+			for _, param := range v.Param_list().(*parser.Param_listContext).AllID() {
+				params = append(params, param.GetText())
+			}
+		}
+		header := "func " + v.ID().GetText() + "(" + strings.Join(params, ", ") + ")"
+		f.compound(ctx, []clause{{header: header, body: v.Block(), obrace: v.OBRACE(), cbrace: v.CBRACE()}})
+	case *parser.Try_statContext:
+		clauses := []clause{{header: "try", body: v.Block(), obrace: v.OBRACE(), cbrace: v.CBRACE()}}
+		if v.Catch_clause() != nil {
+			cc := v.Catch_clause().(*parser.Catch_clauseContext)
+			clauses = append(clauses, clause{header: "} catch (" + cc.ID().GetText() + ")", body: cc.Block(), obrace: cc.OBRACE(), cbrace: cc.CBRACE()})
+		}
+		if v.Finally_clause() != nil {
+			fc := v.Finally_clause().(*parser.Finally_clauseContext)
+			clauses = append(clauses, clause{header: "} finally", body: fc.Block(), obrace: fc.OBRACE(), cbrace: fc.CBRACE()})
+		}
+		f.compound(ctx, clauses)
+	case *parser.Timeout_statContext:
+		f.compound(ctx, []clause{{header: "timeout " + inline(v.Expr()), body: v.Block(), obrace: v.OBRACE(), cbrace: v.CBRACE()}})
+	default:
+		f.flush(ctx.GetStop().GetTokenIndex())
+		f.gap(ctx.GetStart().GetLine())
+		f.line(inline(ctx))
+		f.trailing(ctx.GetStop())
+	}
+	f.lastLine, f.blank = endLine(ctx.GetStop()), true
+}
+
+// leaf is a token with the rule it belongs to, the parent of terminal nodes is the embedded base context
+// so it cannot be used to tell rules apart
+type leaf struct {
+	token antlr.Token
+	rule  antlr.Tree
+}
+
+// inline writes the tokens of tree on one line with the canonical spacing between them
+func inline(tree antlr.Tree) string {
+	var sb strings.Builder
+	var prev *leaf
+	var visit func(t antlr.Tree)
+	visit = func(t antlr.Tree) {
+		for _, child := range t.GetChildren() {
+			tn, ok := child.(antlr.TerminalNode)
+			if !ok {
+				visit(child)
+				continue
+			}
+			if tn.GetSymbol().GetTokenType() == antlr.TokenEOF {
+				continue
+			}
+			cur := &leaf{token: tn.GetSymbol(), rule: t}
+			if prev != nil && spaceBetween(prev, cur) {
+				sb.WriteString(" ")
+			}
+			sb.WriteString(tn.GetText())
+			prev = cur
+		}
+	}
+	visit(tree)
+	return sb.String()
+}
+
+// spaceBetween decides the spacing of two adjacent tokens, tokens are separated by a space unless
+// they are punctuation, prefix operators or the opening parenthesis of a call
+func spaceBetween(prev *leaf, cur *leaf) bool {
+	p, c := prev.token, cur.token
+	switch c.GetText() {
+	case ",", ";", ")", "]", ":", ".":
+		return false
+	case "}":
+		return isBlockBrace(cur)
+	case "(":
+		switch prev.rule.(type) {
+		case *parser.Func_nameContext, *parser.Func_declContext, *parser.UnaryMinusExprContext, *parser.NotExprContext:
+			return false
+		}
+		return !isFunctionKeyword(p.GetTokenType())
+	case "[":
+		_, ok := cur.rule.(*parser.IndexExprContext)
+		return !ok
+	}
+	switch p.GetText() {
+	case "(", "[", ".":
+		return false
+	case "{":
+		return isBlockBrace(prev)
+	}
+	//filter="..." is written without spaces, flags like --timeout=5m keep the spacing they were written with
+	assign := cur
+	if p.GetTokenType() == parser.KlangParserASSIGN {
+		assign = prev
+	}
+	if assign.token.GetTokenType() == parser.KlangParserASSIGN {
+		switch assign.rule.(type) {
+		case *parser.AssignmentContext:
+		case *parser.FilterContext, *parser.PatternContext:
+			return false
+		default:
+			return p.GetStop()+1 < c.GetStart()
+		}
+	}
+	switch prev.rule.(type) {
+	case *parser.UnaryMinusExprContext:
+		//- 2 is kept apart from its number, -2 is a negative literal which binds tighter than ^
+		return c.GetText()[0] >= '0' && c.GetText()[0] <= '9'
+	case *parser.NotExprContext:
+		return false
+	}
+	return true
+}
+
+// isBlockBrace is false for the braces of map literals and json, which are written without inner spaces
+func isBlockBrace(l *leaf) bool {
+	switch l.rule.(type) {
+	case *parser.Map_literalContext, *parser.ObjContext:
+		return false
+	}
+	return true
+}
+
+func isFunctionKeyword(tokenType int) bool {
+	switch tokenType {
+	case parser.KlangParserJSONSELECT, parser.KlangParserJSONEDIT, parser.KlangParserJSONDELETE,
+		parser.KlangParserYAMLSELECT, parser.KlangParserYAMLEDIT, parser.KlangParserYAMLDELETE,
+		parser.KlangParserKUBEJSONEDIT, parser.KlangParserKUBEJSONDELETE, parser.KlangParserKUBEYAMLEDIT,
+		parser.KlangParserKUBEYAMLDELETE, parser.KlangParserDOWNLOAD, parser.KlangParserLOAD:
+		return true
+	}
+	return false
+}
+
+// endLine is the last line of token, raw strings may span lines
+func endLine(token antlr.Token) int {
+	return token.GetLine() + strings.Count(token.GetText(), "\n")
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		script  string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "spacing",
+			script: "x=1 ;y = - x+2*(3 - 1)\n;z = [1,2 , 3][0]; m = {a:1, \"b\" : {}};ok=!f( x,y ).a;",
+			want:   "x = 1;\ny = -x + 2 * (3 - 1);\nz = [1, 2, 3][0];\nm = {a: 1, \"b\": {}};\nok = !f(x, y).a;\n",
+		},
+		{
+			name:   "arithmetic",
+			script: "x=2^3 ^-y;z=-(a+1)%2.5;w=- 2^2;d=- 1m*-2;n=!(a);",
+			want:   "x = 2 ^ 3 ^ -y;\nz = -(a + 1) % 2.5;\nw = - 2 ^ 2;\nd = - 1m * -2;\nn = !(a);\n",
+		},
+		{
+			name:   "blocks",
+			script: "if x>1 log x; else if !ok {log  \"a\";}else{\n\n}\nfunc f( a,b ){return a;}\nwhile i<3 { i=i+1; }\nfor k , v in m { log k;}\ntry { throw \"x\"; } catch ( e ) { log e; } finally { log 1; }\ntimeout 5m { sleep 1s; }",
+			want:   "if x > 1 {\n  log x;\n} else if !ok {\n  log \"a\";\n} else {\n}\nfunc f(a, b) {\n  return a;\n}\nwhile i < 3 {\n  i = i + 1;\n}\nfor k, v in m {\n  log k;\n}\ntry {\n  throw \"x\";\n} catch (e) {\n  log e;\n} finally {\n  log 1;\n}\ntimeout 5m {\n  sleep 1s;\n}\n",
+		},
+		{
+			name:   "blank lines are kept once",
+			script: "x = 1;\n\n\n\ny = `a\n\nb`;\nz = 3;\n\nw = 4;",
+			want:   "x = 1;\n\ny = `a\n\nb`;\nz = 3;\n\nw = 4;\n",
+		},
+		{
+			name:   "kubectl and edits",
+			script: "d = kubectl get -n apps deploy/web  -o yaml;\nr = kubectl scale deploy/web --replicas=3;\njsonEdit(d,\"a.b\",1) ;\nkubeJsonDelete(d, filter = \"x\",pattern= \"y\");\nm.f (1);",
+			want:   "d = kubectl get -n apps deploy/web -o yaml;\nr = kubectl scale deploy/web --replicas=3;\njsonEdit(d, \"a.b\", 1);\nkubeJsonDelete(d, filter=\"x\", pattern=\"y\");\nm.f(1);\n",
+		},
+		{
+			name:   "comments",
+			script: "# replicas of web\n\n\nx = 1;   # at least one\ny = {a: 1, # inside\n b: 2};\nwhile x < 3 { # until ready\n  x = x + 1;\n\n  # end of loop\n}\n\n# end of script\n",
+			want:   "# replicas of web\n\nx = 1; # at least one\n# inside\ny = {a: 1, b: 2};\nwhile x < 3 { # until ready\n  x = x + 1;\n\n  # end of loop\n}\n\n# end of script\n",
+		},
+		{
+			name:   "comments between clauses",
+			script: "if ok log 1; # single\n# otherwise\nelse {\n}\ntry { a = 1; } # failed\ncatch (e) { log e; }",
+			want:   "if ok {\n  log 1; # single\n  # otherwise\n} else {\n}\ntry {\n  a = 1;\n  # failed\n} catch (e) {\n  log e;\n}\n",
+		},
+		{
+			name:    "syntax errors",
+			script:  "x = ;",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Format(tt.script)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Format() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+			if tt.wantErr {
+				return
+			}
+			if again, err := Format(got); err != nil || again != got {
+				t.Errorf("Format() is not stable, %q, %v", again, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"fmt"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+)
+
+const maxCallDepth = 1000
+
+type klangFunc struct {
+	name   string
+	params []string
+	body   *parser.BlockContext
+}
+
+// callFrame holds the local values of a function invocation. Variables not found
+// in the frame are looked up in the global values, block scopes and loops of the caller are not visible.
+type callFrame struct {
+	values       map[string]valHolder
+	returned     bool
+	returnVal    valHolder
+	callerScopes []*scope
+	callerLoops  int
+}
+
+// function is registered before the top level statement containing it runs
+func (l *KlangListener) handleFunc_decl(ctx *parser.Func_declContext) {
+	f := &klangFunc{
+		name: ctx.ID().GetText(),
+		body: ctx.Block().(*parser.BlockContext),
+	}
+	if ctx.Param_list() != nil {
+		for _, param := range ctx.Param_list().(*parser.Param_listContext).AllID() {
+			f.params = append(f.params, param.GetText())
+		}
+	}
+	l.funcs[f.name] = f
+}
+
+func (l *KlangListener) handleCall_stat(ctx *parser.Call_statContext) {
+	r := l.handleFunc_call(ctx.Func_call().(*parser.Func_callContext))
+	l.raiseIfErr(r, ctx.GetStart().GetLine())
+}
+
+func (l *KlangListener) handleReturn_stat(ctx *parser.Return_statContext) {
+	if !l.inCall() {
+		newErrHolder(fmt.Errorf("return outside function at line %d", ctx.GetStart().GetLine()))
+		return
+	}
+	r := newNilValHolder()
+	if ctx.Expr() != nil {
+		r = l.handleExpr(ctx.Expr())
+		r = l.getValIfID(r)
+	}
+	frame := l.currentFrame()
+	frame.returnVal = r
+	frame.returned = true
+}
+
+func (l *KlangListener) handleFunc_call(ctx *parser.Func_callContext) valHolder {
+	name := ctx.Func_name().GetText()
+	if ctx.Func_name().(*parser.Func_nameContext).PATH() != nil {
+		return l.handleModule_call(ctx, name)
+	}
+	f, ok := l.funcs[name]
+	if !ok {
+		if name == waitForFunc {
+			return l.handleWaitFor(ctx)
+		}
+		if fn, ok := l.builtins[name]; ok {
+			return l.handleBuiltin_call(ctx, fn)
+		}
+		return newErrHolder(fmt.Errorf("function %s is not defined", name))
+	}
+	return l.callFunc(f, l.funcArgs(ctx))
+}
+
+// arguments are evaluated in the caller's frame
+func (l *KlangListener) funcArgs(ctx *parser.Func_callContext) []valHolder {
+	var args []valHolder
+	for _, arg := range ctx.AllExpr() {
+		r := l.handleExpr(arg)
+		r = l.getValIfID(r)
+		args = append(args, r)
+	}
+	return args
+}
+
+func (l *KlangListener) callFunc(f *klangFunc, args []valHolder) valHolder {
+	if len(args) != len(f.params) {
+		return newErrHolder(fmt.Errorf("function %s expects %d arguments, found %d", f.name, len(f.params), len(args)))
+	}
+	if len(l.frames) >= maxCallDepth {
+		return newErrHolder(fmt.Errorf("maximum call depth %d exceeded calling %s", maxCallDepth, f.name))
+	}
+	frame := &callFrame{values: make(map[string]valHolder, len(f.params))}
+	for i, r := range args {
+		r.name = f.params[i]
+		frame.values[r.name] = r
+	}
+	l.pushFrame(frame)
+	defer l.popFrame()
+	l.handleBlock(f.body)
+	if l.thrown != nil {
+		return valHolder{dataType: ERR, value: l.thrown}
+	}
+	if !frame.returned {
+		return newNilValHolder()
+	}
+	r := frame.returnVal
+	r.name = ""
+	return r
+}
+
+func (l *KlangListener) pushFrame(frame *callFrame) {
+	l.frames = append(l.frames, frame)
+	frame.callerScopes = l.scopes
+	frame.callerLoops = l.loops
+	l.scopes = []*scope{newScope(frame.values)}
+	l.loops = 0
+	l.values = frame.values
+}
+
+func (l *KlangListener) popFrame() {
+	l.scopes = l.currentFrame().callerScopes
+	l.loops = l.currentFrame().callerLoops
+	l.frames = l.frames[:len(l.frames)-1]
+	if len(l.frames) == 0 {
+		l.values = l.globals
+		return
+	}
+	l.values = l.currentFrame().values
+}
+
+func (l *KlangListener) currentFrame() *callFrame {
+	return l.frames[len(l.frames)-1]
+}
+
+func (l *KlangListener) inCall() bool {
+	return len(l.frames) != 0
+}
+
+// returned reports whether a return statement has ended the current function body
+func (l *KlangListener) returned() bool {
+	return l.inCall() && l.currentFrame().returned
+}
+
+// getValue looks up name in the scopes of the current frame and then in the global values
+func (l *KlangListener) getValue(name string) (valHolder, bool) {
+	if s, ok := l.lookupScope(name); ok {
+		return s.values[name], true
+	}
+	if l.inCall() {
+		v, ok := l.globals[name]
+		return v, ok
+	}
+	return valHolder{}, false
+}
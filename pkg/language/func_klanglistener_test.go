@@ -0,0 +1,180 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"testing"
+)
+
+func TestKlangListener_handleFunc_call(t *testing.T) {
+	type fields struct {
+		input  string
+		values map[string]valHolder
+	}
+	tests := []struct {
+		name   string
+		fields fields
+	}{
+		{
+			name: "call with return value",
+			fields: fields{
+				input: `
+func add(a, b) {
+  return a + b;
+}
+x = add(1, 2);
+`,
+				values: map[string]valHolder{
+					"x": {
+						dataType: INT,
+						name:     "x",
+						value:    int64(3),
+					},
+				},
+			},
+		},
+		{
+			name: "recursion",
+			fields: fields{
+				input: `
+func fact(n) {
+  if n <= 1 {
+    return 1;
+  }
+  return n * fact(n - 1);
+}
+x = fact(5);
+`,
+				values: map[string]valHolder{
+					"x": {
+						dataType: INT,
+						name:     "x",
+						value:    int64(120),
+					},
+				},
+			},
+		},
+		{
+			name: "nested calls and globals",
+			fields: fields{
+				input: `
+prefix = "app-";
+func name(n) {
+  return prefix + n;
+}
+func fullName(n, suffix) {
+  return name(n) + suffix;
+}
+x = fullName("web", "-svc");
+`,
+				values: map[string]valHolder{
+					"x": {
+						dataType: STRING,
+						name:     "x",
+						value:    "app-web-svc",
+					},
+				},
+			},
+		},
+		{
+			name: "locals do not leak",
+			fields: fields{
+				input: `
+a = 10;
+func shadow(a) {
+  b = a * 2;
+  return b;
+}
+x = shadow(4);
+`,
+				values: map[string]valHolder{
+					"a": {
+						dataType: INT,
+						name:     "a",
+						value:    int64(10),
+					},
+					"x": {
+						dataType: INT,
+						name:     "x",
+						value:    int64(8),
+					},
+				},
+			},
+		},
+		{
+			name: "return from while",
+			fields: fields{
+				input: `
+func firstAbove(limit) {
+  i = 0;
+  while i < 100 {
+    i = i + 1;
+    if i > limit {
+      return i;
+    }
+  }
+  return -1;
+}
+x = firstAbove(3);
+`,
+				values: map[string]valHolder{
+					"x": {
+						dataType: INT,
+						name:     "x",
+						value:    int64(4),
+					},
+				},
+			},
+		},
+		{
+			name: "call statement without return",
+			fields: fields{
+				input: `
+func noop() {
+  log "noop";
+}
+noop();
+x = noop();
+`,
+				values: map[string]valHolder{
+					"x": {
+						dataType: NIL,
+						name:     "x",
+						value:    "NIL",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := setup(tt.fields.input)
+			m := make(map[string]valHolder, len(tt.fields.values))
+			for k := range tt.fields.values {
+				if d, ok := r.values[k]; ok {
+					m[k] = d
+				}
+			}
+			if _, ok := r.values["b"]; ok {
+				t.Errorf("function local leaked into globals %+v\n", r.Values())
+			}
+			if diff := compare(tt.fields.values, m); !diff {
+				t.Errorf("expected %+v, found %+v\n", tt.fields.values, r.Values())
+			}
+		})
+	}
+}
@@ -20,15 +20,24 @@ stat
  | kube_yaml_edit_fn
  | if_stat
  | while_stat
+ | for_stat
  | sleep_fn
  | exit_fn
  | log
- | OTHER {fmt.Println("unknown char: " + $OTHER.text);}
+ | func_decl
+ | return_stat
+ | break_stat
+ | call_stat
+ | try_stat
+ | throw_stat
+ | import_stat
+ | timeout_stat
+ | stepInfo
  ;
 
 assignment
- : ID ASSIGN expr SCOL
- | ID ASSIGN load_fn SCOL
+ : (LET | CONST)? ID ASSIGN expr SCOL
+ | (LET | CONST)? ID ASSIGN load_fn SCOL
  ;
 
 shell_script
@@ -74,7 +83,7 @@ kube_yaml_delete_fn
  ;
 
 sleep_fn
- : SLEEP NUMBER SCOL
+ : SLEEP expr SCOL
  ;
 
 exit_fn
@@ -97,16 +106,79 @@ stat_block
 while_stat
  : WHILE expr stat_block
  ;
+
+for_stat
+ : FOR ID (COMMA ID)? IN expr stat_block
+ ;
  
 log
  : LOG expr SCOL
  ;
 
+func_decl
+ : FUNC ID OPAR param_list? CPAR OBRACE block CBRACE
+ ;
+
+param_list
+ : ID (COMMA ID)*
+ ;
+
+return_stat
+ : RETURN expr? SCOL
+ ;
+
+break_stat
+ : BREAK SCOL
+ ;
+
+call_stat
+ : func_call SCOL
+ ;
+
+try_stat
+ : TRY OBRACE block CBRACE (catch_clause finally_clause? | finally_clause)
+ ;
+
+catch_clause
+ : CATCH OPAR ID CPAR OBRACE block CBRACE
+ ;
+
+finally_clause
+ : FINALLY OBRACE block CBRACE
+ ;
+
+throw_stat
+ : THROW expr SCOL
+ ;
+
+import_stat
+ : IMPORT string_or_id AS ID SCOL
+ ;
+
+timeout_stat
+ : TIMEOUT expr OBRACE block CBRACE
+ ;
+
+func_call
+ : func_name OPAR (expr (COMMA expr)*)? CPAR
+ ;
+
+func_name
+ : ID
+ | REPLACE
+ | PATH
+ ;
+
 kubectl_command
  : KUBECTL APPLY (NAMESPACE ns | string_or_id | UPDATELOAD kubernetes_object_config)+ #applyKubectlCommand
  | KUBECTL PATCH (NAMESPACE ns | resource | PATCHTYPE patch_type | PATCHLOAD string_or_id)+ #patchKubectlCommand
- | KUBECTL GET (NAMESPACE ns | resource)+ #getKubectlCommand
- | KUBECTL DELETE (NAMESPACE ns | resource)+ #deleteKubectlCommand
+ | KUBECTL GET (NAMESPACE ns | resource | selector | ALLNAMESPACES | output)+ #getKubectlCommand
+ | KUBECTL WAIT (NAMESPACE ns | resource | FORCONDITION | WAITFOR string_or_id | WAITTIMEOUT ASSIGN? expr)+ #waitKubectlCommand
+ | KUBECTL DELETE (NAMESPACE ns | resource | selector | ALL)+ #deleteKubectlCommand
+ | KUBECTL CREATE (NAMESPACE ns | string_or_id | UPDATELOAD kubernetes_object_config)+ #createKubectlCommand
+ | KUBECTL REPLACE (NAMESPACE ns | string_or_id | UPDATELOAD kubernetes_object_config)+ #replaceKubectlCommand
+ | KUBECTL SCALE (NAMESPACE ns | resource | REPLICAS ASSIGN? expr)+ #scaleKubectlCommand
+ | KUBECTL ROLLOUT ID (NAMESPACE ns | resource | WAITTIMEOUT ASSIGN? expr)+ #rolloutKubectlCommand
  ;
 
 download_fn
@@ -135,6 +207,19 @@ ns
  | PATH
  ;
 
+output
+ : OUTPUTFORMAT
+ | OUTPUT ASSIGN? string_or_id
+ | JSONPATH ASSIGN? string_or_id
+ ;
+
+selector
+ : LABELSELECTOR
+ | FIELDSELECTOR
+ | SELECTOR ASSIGN? string_or_id
+ | FIELDSELECTORFLAG ASSIGN? string_or_id
+ ;
+
 asObject
   : '"asObject"'
   ;
@@ -168,7 +253,9 @@ pattern
  ;
 
 expr
- :<assoc=right> expr POW expr           #powExpr
+ : expr '[' expr ']'                    #indexExpr
+ | expr DOT (ID | PATH)                 #memberExpr
+ |<assoc=right> expr POW expr           #powExpr
  | MINUS expr                           #unaryMinusExpr
  | NOT expr                             #notExpr
  | expr op=(MULT | DIV | MOD) expr      #multiplicationExpr
@@ -182,20 +269,37 @@ expr
  | yaml_select_fn                       #yamlSelectFn
  | shell_script                         #shellScript
  | download_fn                          #downloadFn
+ | func_call                            #funcCallExpr
  | atom                                 #atomExpr
  ;
  
 atom
  : OPAR expr CPAR #parExpr
  | NUMBER         #numberAtom
+ | DURATION       #durationAtom
  | (TRUE | FALSE) #booleanAtom
  | RAW_STRING_LIT #rawStringAtom
  | ID             #idAtom
  | STRING         #stringAtom
+ | list_literal   #listAtom
+ | map_literal    #mapAtom
  | json           #jsonAtom
+ | PATH           #pathAtom
  | NIL            #nilAtom
  ;
 
+list_literal
+ : '[' (expr (',' expr)*)? ']'
+ ;
+
+map_literal
+ : '{' (map_entry (',' map_entry)*)? '}'
+ ;
+
+map_entry
+ : (STRING | ID) ':' expr
+ ;
+
 json
    : value
    ;
@@ -247,6 +351,7 @@ CPAR : ')';
 OBRACE : '{';
 CBRACE : '}';
 COMMA : ',';
+DOT : '.';
  
 TRUE : 'true';
 FALSE : 'false';
@@ -254,6 +359,8 @@ NIL : 'nil';
 IF : 'if';
 ELSE : 'else';
 WHILE : 'while';
+FOR : 'for';
+IN : 'in';
 LOG : 'log';
 KUBECTL : 'kubectl';
 APPLY : 'apply';
@@ -261,8 +368,24 @@ PATCH : 'patch';
 GET : 'get';
 REPLACE : 'replace';
 DELETE : 'delete';
+WAIT : 'wait';
+ROLLOUT : 'rollout';
+CREATE : 'create';
+SCALE : 'scale';
 NAMESPACE : '-n';
 PATCHTYPE : '--type';
+FORCONDITION : '--for=' ~[ \t\r\n;]+;
+WAITFOR : '--for';
+WAITTIMEOUT : '--timeout';
+LABELSELECTOR : ('-l' | '--selector') ([ \t]+ | '=') SELECTOR_REQUIREMENT {l.inKubectl()}?;
+FIELDSELECTOR : '--field-selector' ([ \t]+ | '=') SELECTOR_REQUIREMENT;
+SELECTOR : ('-l' | '--selector') {l.endsFlag() && l.inKubectl()}?;
+FIELDSELECTORFLAG : '--field-selector';
+ALLNAMESPACES : ('-A' | '--all-namespaces') {l.endsFlag() && l.inKubectl()}?;
+ALL : '--all';
+OUTPUTFORMAT : ('-o' | '--output') ([ \t]+ | '=') [a-zA-Z_] [a-zA-Z0-9_-]* ('=' OUTPUT_SPEC)? {l.inKubectl()}?;
+OUTPUT : ('-o' | '--output') {l.endsFlag() && l.inKubectl()}?;
+REPLICAS : '--replicas';
 PATCHLOAD: '-p';
 UPDATELOAD: '-u';
 JSONPATH : '-jsonpath';
@@ -284,6 +407,22 @@ SLEEP: 'sleep';
 STEPINFO: 'stepInfo';
 FILTER: 'filter';
 PATTERN: 'pattern';
+FUNC: 'func';
+RETURN: 'return';
+BREAK: 'break';
+TRY: 'try';
+CATCH: 'catch';
+FINALLY: 'finally';
+THROW: 'throw';
+IMPORT: 'import';
+AS: 'as';
+LET: 'let';
+CONST: 'const';
+TIMEOUT: 'timeout';
+
+DURATION
+ : ([0-9]+ ('ms' | 's' | 'm' | 'h'))+
+ ;
 
 ID
  : [a-zA-Z_] [a-zA-Z_0-9]*
@@ -293,6 +432,14 @@ NUMBER
  : '-'? INT ('.' [0-9] +)? EXP?
  ;
 
+fragment SELECTOR_REQUIREMENT
+ : ~[ \t\r\n;'"`=!]+ ('=' '='? | '!=') ~[ \t\r\n;]*
+ ;
+
+fragment OUTPUT_SPEC
+ : ('\'' ~'\''* '\'' | '"' ~'"'* '"' | ~[ \t\r\n;'"])+
+ ;
+
 fragment EXP
  : [Ee] [+\-]? INT
  ;
@@ -315,13 +462,13 @@ STRING
  ;
 
 fragment ESCQUOTE
-   : '\\' (['\\/bfnrt] | UNICODE)
+   : '\\' (['\\/bfnrt$] | UNICODE)
    ;
 fragment SAFECODEPOINTQUOTE
 : ~ ['\\\u0000-\u001F]
 ;
 fragment ESC
-   : '\\' (["\\/bfnrt] | UNICODE)
+   : '\\' (["\\/bfnrt$] | UNICODE)
    ;
 fragment UNICODE
    : 'u' HEX HEX HEX HEX
@@ -334,11 +481,8 @@ fragment SAFECODEPOINT
  ;
 
 COMMENT
- : '#' ~[\r\n]* -> skip
+ : '#' ~[\r\n]* -> channel(HIDDEN)
  ;
 SPACE
  : [ \t\r\n] + -> skip
- ;
-OTHER
- : . 
  ;
\ No newline at end of file
@@ -0,0 +1,175 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"fmt"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	interpolationStart  = "${"
+	interpolationEscape = `\${`
+)
+
+// interpolate replaces every ${expr} in s with the value of expr evaluated against the current values,
+// \${ is kept as a literal ${
+func (l *KlangListener) interpolate(s string) (string, error) {
+	if !strings.Contains(s, interpolationStart) {
+		return s, nil
+	}
+	var sb strings.Builder
+	for len(s) != 0 {
+		start := strings.Index(s, interpolationStart)
+		if start == -1 {
+			sb.WriteString(s)
+			break
+		}
+		if start > 0 && s[start-1] == '\\' {
+			sb.WriteString(s[:start-1])
+			sb.WriteString(interpolationStart)
+			s = s[start+len(interpolationStart):]
+			continue
+		}
+		sb.WriteString(s[:start])
+		end := interpolationEnd(s, start+len(interpolationStart))
+		if end == -1 {
+			return "", fmt.Errorf("unterminated %s in %q", interpolationStart, s[start:])
+		}
+		source := s[start+len(interpolationStart) : end]
+		text, err := l.interpolationText(source)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(text)
+		s = s[end+1:]
+	}
+	return sb.String(), nil
+}
+
+// interpolationEnd returns the index of the } closing the expression starting at from, nested braces of
+// map literals are skipped
+func interpolationEnd(s string, from int) int {
+	depth := 0
+	for i := from; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				return i
+			}
+			depth--
+		}
+	}
+	return -1
+}
+
+// interpolations returns the source of every ${expr} in s, escaped ones are skipped
+func interpolations(s string) ([]string, error) {
+	var sources []string
+	for {
+		start := strings.Index(s, interpolationStart)
+		if start == -1 {
+			return sources, nil
+		}
+		if start > 0 && s[start-1] == '\\' {
+			s = s[start+len(interpolationStart):]
+			continue
+		}
+		end := interpolationEnd(s, start+len(interpolationStart))
+		if end == -1 {
+			return sources, fmt.Errorf("unterminated %s in %q", interpolationStart, s[start:])
+		}
+		sources = append(sources, s[start+len(interpolationStart):end])
+		s = s[end+1:]
+	}
+}
+
+func (l *KlangListener) interpolationText(source string) (string, error) {
+	expr, err := parseInterpolation(source)
+	if err != nil {
+		return "", err
+	}
+	val := l.handleExpr(expr)
+	val = l.getValIfID(val)
+	switch val.dataType {
+	case STRING:
+		return val.value.(string), nil
+	case INT:
+		return strconv.FormatInt(val.value.(int64), 10), nil
+	case FLOAT:
+		return strconv.FormatFloat(val.value.(float64), 'f', -1, 64), nil
+	case BOOLEAN:
+		return strconv.FormatBool(val.value.(bool)), nil
+	case DURATION:
+		return val.value.(time.Duration).String(), nil
+	case NIL:
+		return "", nil
+	case LIST, MAP:
+		return toJsonString(val)
+	case ERR:
+		return "", val.value.(error)
+	default:
+		return "", fmt.Errorf("%s does not have a value", source)
+	}
+}
+
+// parseInterpolation parses the source of ${expr}, which must be a single expression
+func parseInterpolation(source string) (parser.IExprContext, error) {
+	if len(strings.TrimSpace(source)) == 0 {
+		return nil, fmt.Errorf("empty expression in %s}", interpolationStart)
+	}
+	errs := &interpolationErrorListener{}
+	lexer := parser.NewKlangLexer(antlr.NewInputStream(source))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errs)
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	p := parser.NewKlangParser(stream)
+	p.RemoveErrorListeners()
+	p.AddErrorListener(errs)
+	p.BuildParseTrees = true
+	expr := p.Expr()
+	if len(errs.errs) == 0 && stream.LA(1) != antlr.TokenEOF {
+		errs.errs = append(errs.errs, fmt.Sprintf("unexpected %s", stream.LT(1).GetText()))
+	}
+	if len(errs.errs) != 0 {
+		return nil, fmt.Errorf("invalid expression %q: %s", source, strings.Join(errs.errs, ", "))
+	}
+	return expr, nil
+}
+
+type interpolationErrorListener struct {
+	*antlr.DefaultErrorListener
+	errs []string
+}
+
+func (e *interpolationErrorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, ex antlr.RecognitionException) {
+	e.errs = append(e.errs, msg)
+}
+
+func (l *KlangListener) interpolatedString(s string) valHolder {
+	s, err := l.interpolate(s)
+	if err != nil {
+		return newErrHolder(err)
+	}
+	return newStringValHolder(s)
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"testing"
+)
+
+func TestKlangListener_handleInterpolation(t *testing.T) {
+	type fields struct {
+		input  string
+		values map[string]valHolder
+	}
+	tests := []struct {
+		name   string
+		fields fields
+	}{
+		{
+			name: "ids and expressions",
+			fields: fields{
+				input: `
+app = "web";
+replicas = 2;
+x = "${app}-${replicas + 1}";
+y = ` + "`" + `name: ${app}` + "`" + `;
+`,
+				values: map[string]valHolder{
+					"x": {
+						dataType: STRING,
+						name:     "x",
+						value:    "web-3",
+					},
+					"y": {
+						dataType: STRING,
+						name:     "y",
+						value:    "name: web",
+					},
+				},
+			},
+		},
+		{
+			name: "escaped",
+			fields: fields{
+				input: `
+app = "web";
+x = "\${app} is ${app}";
+`,
+				values: map[string]valHolder{
+					"x": {
+						dataType: STRING,
+						name:     "x",
+						value:    "${app} is web",
+					},
+				},
+			},
+		},
+		{
+			name: "collections and function calls",
+			fields: fields{
+				input: `
+func suffix(s) {
+  return "-" + s;
+}
+n = 1;
+m = {"name": "web", ports: [80, n]};
+x = ` + "`" + `${m.name}${suffix("svc")} ${m["ports"]} ${ {"a": n}.a }` + "`" + `;
+`,
+				values: map[string]valHolder{
+					"x": {
+						dataType: STRING,
+						name:     "x",
+						value:    `web-svc [80,1] 1`,
+					},
+				},
+			},
+		},
+		{
+			name: "evaluated at evaluation time",
+			fields: fields{
+				input: `
+x = "";
+i = 0;
+while i < 3 {
+  x = x + "${i}";
+  i = i + 1;
+}
+`,
+				values: map[string]valHolder{
+					"x": {
+						dataType: STRING,
+						name:     "x",
+						value:    "012",
+					},
+				},
+			},
+		},
+		{
+			name: "patterns",
+			fields: fields{
+				input: `
+field = "name";
+j = ` + "`" + `{"metadata": {"name": "web"}}` + "`" + `;
+x = jsonSelect(j, "metadata.${field}");
+`,
+				values: map[string]valHolder{
+					"x": {
+						dataType: STRING,
+						name:     "x",
+						value:    "web",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := setup(tt.fields.input)
+			m := make(map[string]valHolder, len(tt.fields.values))
+			for k := range tt.fields.values {
+				if d, ok := r.values[k]; ok {
+					m[k] = d
+				}
+			}
+			if diff := compare(tt.fields.values, m); !diff {
+				t.Errorf("expected %+v, found %+v\n", tt.fields.values, r.Values())
+			}
+		})
+	}
+}
+
+func TestKlangListener_interpolate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "no interpolation", input: "plain $text {}", want: "plain $text {}"},
+		{name: "boolean and float", input: "${true}:${1.5}", want: "true:1.5"},
+		{name: "nil", input: "[${nil}]", want: "[]"},
+		{name: "unterminated", input: "${app", wantErr: true},
+		{name: "empty", input: "${ }", wantErr: true},
+		{name: "invalid expression", input: "${1 +}", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewKlangListener(nil)
+			got, err := l.interpolate(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("interpolate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("interpolate() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package language
 
 import (
+	"fmt"
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
 )
@@ -43,6 +44,30 @@ func JsonDelete(json, pattern string) valHolder {
 	return newStringValHolder(val)
 }
 
+// JsonItems returns the elements of a json array or the items of a kubernetes List
+func JsonItems(json string) ([]valHolder, error) {
+	arr, err := jsonArray(json)
+	if err != nil {
+		return nil, err
+	}
+	var items []valHolder
+	for _, item := range arr.Array() {
+		items = append(items, toValHolder(item))
+	}
+	return items, nil
+}
+
+func jsonArray(json string) (gjson.Result, error) {
+	r := gjson.Parse(json)
+	if r.IsObject() {
+		r = r.Get("items")
+	}
+	if !r.IsArray() {
+		return r, fmt.Errorf("json is neither an array nor a list with items")
+	}
+	return r, nil
+}
+
 func equalResult(v1, v2 gjson.Result) bool {
 	if v1.Type != v2.Type {
 		return false
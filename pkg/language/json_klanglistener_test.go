@@ -87,6 +87,24 @@ func TestKlangListener_handleJsonEdit(t *testing.T) {
 				input: `
 x = {"name":{"first":"abc","last":"def"}};
 jsonEdit(x, "name.first", "xyz");
+`,
+				//object literals are maps, they stay maps when they are edited
+				values: map[string]valHolder{
+					"x": {
+						dataType: "MAP",
+						name:     "x",
+						value:    "{\"name\":{\"first\":\"xyz\",\"last\":\"def\"}}",
+					},
+				},
+			},
+			args: args{},
+		},
+		{
+			name: "Json Edit of a json string",
+			fields: fields{
+				input: `
+x = ` + "`" + `{"name":{"first":"abc","last":"def"}}` + "`" + `;
+jsonEdit(x, "name.first", "xyz");
 `,
 				values: map[string]valHolder{
 					"x": {
@@ -103,8 +121,11 @@ jsonEdit(x, "name.first", "xyz");
 		t.Run(tt.name, func(t *testing.T) {
 			r := setup(tt.fields.input)
 			if d, ok := r.values["x"]; ok {
+				//maps are compared by their json
+				doc := toDocument(d)
+				doc.dataType = d.dataType
 				m := map[string]valHolder{
-					"x": d,
+					"x": doc,
 				}
 				if diff := compare(tt.fields.values, m); !diff {
 					t.Errorf("expected %+v, found %+v\n", tt.fields.values, r.Values())
@@ -26,17 +26,11 @@ import (
 	"strings"
 )
 
-// ExitJson_delete_fn is called when production json_delete_fn is exited.
-func (l *KlangListener) ExitKube_json_delete_fn(ctx *parser.Kube_json_delete_fnContext) {
-	if l.ifWhileCount != 0 {
-		return
-	}
-	l.handleKube_json_delete_fn(ctx)
-}
-
 func (l *KlangListener) handleKube_json_delete_fn(ctx *parser.Kube_json_delete_fnContext) valHolder {
-	json := l.values[ctx.ID().GetText()]
+	json, _ := l.getValue(ctx.ID().GetText())
 	json = l.getValIfID(json)
+	collection := isCollection(json)
+	json = toDocument(json)
 	if json.dataType != STRING || len(json.value.(string)) == 0 {
 		return newErrHolder(fmt.Errorf("json should be string of non zero length %+v\n", json))
 	}
@@ -52,7 +46,9 @@ func (l *KlangListener) handleKube_json_delete_fn(ctx *parser.Kube_json_delete_f
 	}
 	res := handleKubeJsonDelete(data, filter, pattern)
 	json.value = res.value
-	l.values[json.name] = json
+	if err := l.assign(fromDocument(json, collection)); err != nil {
+		return newErrHolder(err)
+	}
 	return res
 }
 
@@ -118,17 +114,11 @@ func handleKubeJsonDelete(data string, filter string, pattern string) valHolder
 	}
 }
 
-// ExitKube_json_edit_fn is called when production kube_yaml_edit_fn is exited.
-func (l *KlangListener) ExitKube_json_edit_fn(ctx *parser.Kube_json_edit_fnContext) {
-	if l.ifWhileCount != 0 {
-		return
-	}
-	l.handleKube_json_edit_fn(ctx)
-}
-
 func (l *KlangListener) handleKube_json_edit_fn(ctx *parser.Kube_json_edit_fnContext) valHolder {
-	json := l.values[ctx.ID().GetText()]
+	json, _ := l.getValue(ctx.ID().GetText())
 	json = l.getValIfID(json)
+	collection := isCollection(json)
+	json = toDocument(json)
 	if json.dataType != STRING || len(json.value.(string)) == 0 {
 		return newErrHolder(fmt.Errorf("json should be string of non zero length %+v\n", json))
 	}
@@ -139,6 +129,9 @@ func (l *KlangListener) handleKube_json_edit_fn(ctx *parser.Kube_json_edit_fnCon
 		return newErrHolder(fmt.Errorf("value cannot be nil"))
 	}
 	val := valVh.value
+	if isCollection(valVh) {
+		val = toNative(valVh)
+	}
 	patternLabel := ctx.String_or_id(0).(*parser.String_or_idContext)
 	pattern := l.GetTextFromStringOrId(patternLabel)
 	filter := ""
@@ -151,7 +144,9 @@ func (l *KlangListener) handleKube_json_edit_fn(ctx *parser.Kube_json_edit_fnCon
 	}
 	res := handleKubeJsonEdit(data, filter, pattern, val)
 	json.value = res.value
-	l.values[json.name] = json
+	if err := l.assign(fromDocument(json, collection)); err != nil {
+		return newErrHolder(err)
+	}
 	return res
 }
 
@@ -208,16 +203,11 @@ func handleKubeJsonEdit(data string, filter string, pattern string, value interf
 	}
 }
 
-func (l *KlangListener) ExitKube_yaml_delete_fn(ctx *parser.Kube_yaml_delete_fnContext) {
-	if l.ifWhileCount != 0 {
-		return
-	}
-	l.handleKube_yaml_delete_fn(ctx)
-}
-
 func (l *KlangListener) handleKube_yaml_delete_fn(ctx *parser.Kube_yaml_delete_fnContext) valHolder {
-	yml := l.values[ctx.ID().GetText()]
+	yml, _ := l.getValue(ctx.ID().GetText())
 	yml = l.getValIfID(yml)
+	collection := isCollection(yml)
+	yml = toDocument(yml)
 	if yml.dataType != STRING || len(yml.value.(string)) == 0 {
 		return newErrHolder(fmt.Errorf("yml should be string of non zero length %+v\n", yml))
 	}
@@ -233,7 +223,9 @@ func (l *KlangListener) handleKube_yaml_delete_fn(ctx *parser.Kube_yaml_delete_f
 
 	res := handleKubeYamlDelete(data, filter, pattern)
 	yml.value = res.value
-	l.values[yml.name] = yml
+	if err := l.assign(fromDocument(yml, collection)); err != nil {
+		return newErrHolder(err)
+	}
 	return yml
 }
 
@@ -258,17 +250,11 @@ func handleKubeYamlDelete(data string, filter string, pattern string) valHolder
 	return newStringValHolder(strings.Join(outYmls, yamlSeperator))
 }
 
-// ExitKube_yaml_edit_fn is called when production kube_yaml_edit_fn is exited.
-func (l *KlangListener) ExitKube_yaml_edit_fn(ctx *parser.Kube_yaml_edit_fnContext) {
-	if l.ifWhileCount != 0 {
-		return
-	}
-	l.handleKube_yaml_edit_fn(ctx)
-}
-
 func (l *KlangListener) handleKube_yaml_edit_fn(ctx *parser.Kube_yaml_edit_fnContext) valHolder {
-	yml := l.values[ctx.ID().GetText()]
+	yml, _ := l.getValue(ctx.ID().GetText())
 	yml = l.getValIfID(yml)
+	collection := isCollection(yml)
+	yml = toDocument(yml)
 	if yml.dataType != STRING || len(yml.value.(string)) == 0 {
 		return newErrHolder(fmt.Errorf("yml should be string of non zero length %+v\n", yml))
 	}
@@ -279,6 +265,9 @@ func (l *KlangListener) handleKube_yaml_edit_fn(ctx *parser.Kube_yaml_edit_fnCon
 		return newErrHolder(fmt.Errorf("value cannot be nil"))
 	}
 	val := valVh.value
+	if isCollection(valVh) {
+		val = toNative(valVh)
+	}
 	patternLabel := ctx.String_or_id(0).(*parser.String_or_idContext)
 	pattern := l.GetTextFromStringOrId(patternLabel)
 	filter := ""
@@ -291,7 +280,9 @@ func (l *KlangListener) handleKube_yaml_edit_fn(ctx *parser.Kube_yaml_edit_fnCon
 	}
 	res := handleKubeYamlEdit(data, filter, pattern, val)
 	yml.value = res.value
-	l.values[yml.name] = yml
+	if err := l.assign(fromDocument(yml, collection)); err != nil {
+		return newErrHolder(err)
+	}
 	return res
 }
 
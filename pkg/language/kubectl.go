@@ -26,6 +26,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -37,6 +38,7 @@ import (
 	"k8s.io/kubectl/pkg/cmd/util"
 	"k8s.io/utils/pointer"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"strings"
 	"time"
 )
 
@@ -129,30 +131,10 @@ func (k *kubectl) DeleteResource(ctx context.Context, r *DeleteRequest) (*Manife
 }
 
 func (k *kubectl) ApplyResource(ctx context.Context, r *ApplyRequest) ([]ApplyResponse, error) {
-	objs, err := kube.SplitYAML([]byte(r.Manifest))
-	var manifests []unstructured.Unstructured
+	manifests, err := splitManifests(r.Manifest)
 	if err != nil {
 		return make([]ApplyResponse, 0), err
 	}
-	for _, obj := range objs {
-		if obj.IsList() {
-			err = obj.EachListItem(func(object runtime.Object) error {
-				unstructuredObj, ok := object.(*unstructured.Unstructured)
-				if ok {
-					manifests = append(manifests, *unstructuredObj)
-					return nil
-				}
-				return fmt.Errorf("resource list item has unexpected type")
-			})
-			if err != nil {
-				return make([]ApplyResponse, 0), err
-			}
-		} else if isNullList(obj) {
-			// noop
-		} else {
-			manifests = append(manifests, *obj)
-		}
-	}
 	var force, validate bool
 	if r.Force != nil {
 		force = *r.Force
@@ -182,6 +164,111 @@ func (k *kubectl) ApplyResource(ctx context.Context, r *ApplyRequest) ([]ApplyRe
 	return responses, nil
 }
 
+// CreateResource creates the resources in the manifest, it fails for resources which already exist
+func (k *kubectl) CreateResource(ctx context.Context, r *CreateRequest) ([]ApplyResponse, error) {
+	manifests, err := splitManifests(r.Manifest)
+	if err != nil {
+		return make([]ApplyResponse, 0), err
+	}
+	manifests = SortManifestsByKind(manifests, InstallOrder)
+	responses := make([]ApplyResponse, 0)
+	for _, manifest := range manifests {
+		namespace := manifest.GetNamespace()
+		if len(namespace) == 0 {
+			namespace = r.Namespace
+		}
+		res := ApplyResponse{
+			GroupVersionKind: manifest.GroupVersionKind(),
+			Name:             manifest.GetName(),
+			Namespace:        namespace,
+		}
+		_, err := k.kubectl.CreateResource(ctx, k.restConfig, &manifest, namespace, util.DryRunNone)
+		if err != nil {
+			res.Err = err.Error()
+		} else {
+			res.Message = fmt.Sprintf("%s/%s created", strings.ToLower(manifest.GetKind()), manifest.GetName())
+		}
+		responses = append(responses, res)
+		if err == nil && kube.IsCRD(&manifest) {
+			k.ensureCRDReady(manifest.GetName())
+		}
+	}
+	return responses, nil
+}
+
+// ReplaceResource replaces the resources in the manifest, which must already exist. If a manifest has
+// no resourceVersion the current one is used, otherwise the update fails if the resource has changed since.
+func (k *kubectl) ReplaceResource(ctx context.Context, r *ReplaceRequest) ([]ApplyResponse, error) {
+	manifests, err := splitManifests(r.Manifest)
+	if err != nil {
+		return make([]ApplyResponse, 0), err
+	}
+	responses := make([]ApplyResponse, 0)
+	for _, manifest := range manifests {
+		namespace := manifest.GetNamespace()
+		if len(namespace) == 0 {
+			namespace = r.Namespace
+		}
+		res := ApplyResponse{
+			GroupVersionKind: manifest.GroupVersionKind(),
+			Name:             manifest.GetName(),
+			Namespace:        namespace,
+		}
+		err := k.replace(ctx, &manifest, namespace)
+		if err != nil {
+			res.Err = err.Error()
+		} else {
+			res.Message = fmt.Sprintf("%s/%s replaced", strings.ToLower(manifest.GetKind()), manifest.GetName())
+		}
+		responses = append(responses, res)
+	}
+	return responses, nil
+}
+
+func (k *kubectl) replace(ctx context.Context, manifest *unstructured.Unstructured, namespace string) error {
+	if len(manifest.GetResourceVersion()) == 0 {
+		current, err := k.kubectl.GetResource(ctx, k.restConfig, manifest.GroupVersionKind(), manifest.GetName(), namespace)
+		if err != nil {
+			return err
+		}
+		manifest.SetResourceVersion(current.GetResourceVersion())
+	}
+	_, err := k.kubectl.UpdateResource(ctx, k.restConfig, manifest, namespace, util.DryRunNone)
+	return err
+}
+
+// ScaleResource sets the replicas of a resource through its scale subresource
+func (k *kubectl) ScaleResource(ctx context.Context, r *ScaleRequest) (*ManifestResponse, error) {
+	patch := fmt.Sprintf(`{"spec":{"replicas":%d}}`, r.Replicas)
+	manifest, err := k.kubectl.PatchResource(ctx, k.restConfig, r.GroupVersionKind, r.Name, r.Namespace, types.MergePatchType, []byte(patch), "scale")
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(manifest.Object)
+	if err != nil {
+		return nil, err
+	}
+	return &ManifestResponse{
+		Manifest: string(data),
+	}, nil
+}
+
+// WaitForResource polls the resource until met returns true or the timeout passes. met gets a nil
+// object once the resource is not found, other errors are retried until the timeout.
+func (k *kubectl) WaitForResource(ctx context.Context, r *WaitRequest, met func(obj *unstructured.Unstructured) bool) error {
+	return wait.PollImmediate(r.Interval, r.Timeout, func() (bool, error) {
+		obj, err := k.kubectl.GetResource(ctx, k.restConfig, r.GroupVersionKind, r.Name, r.Namespace)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return met(nil), nil
+			}
+			log.WithField("gkv", r.GroupVersionKind).WithField("name", r.Name).Infof("wait %v", err)
+			return false, nil
+		}
+		return met(obj), nil
+	})
+}
+
 func (k *kubectl) ListResources(ctx context.Context, r *ListRequest) (*ListResponse, error) {
 	dynamicIf, err := dynamic.NewForConfig(k.restConfig)
 	if err != nil {
@@ -224,6 +311,35 @@ func (k *kubectl) ensureCRDReady(name string) {
 	})
 }
 
+// splitManifests splits a multi document yaml or json into objects, items of lists are returned as separate objects
+func splitManifests(manifest string) ([]unstructured.Unstructured, error) {
+	objs, err := kube.SplitYAML([]byte(manifest))
+	if err != nil {
+		return nil, err
+	}
+	var manifests []unstructured.Unstructured
+	for _, obj := range objs {
+		if obj.IsList() {
+			err = obj.EachListItem(func(object runtime.Object) error {
+				unstructuredObj, ok := object.(*unstructured.Unstructured)
+				if ok {
+					manifests = append(manifests, *unstructuredObj)
+					return nil
+				}
+				return fmt.Errorf("resource list item has unexpected type")
+			})
+			if err != nil {
+				return nil, err
+			}
+		} else if isNullList(obj) {
+			// noop
+		} else {
+			manifests = append(manifests, *obj)
+		}
+	}
+	return manifests, nil
+}
+
 // https://github.com/argoproj/gitops-engine/blob/master/pkg/sync/sync_context.go
 // isNullList checks if the object is a "List" type where items is null instead of an empty list.
 // Handles a corner case where obj.IsList() returns false when a manifest is like:
@@ -0,0 +1,177 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"context"
+	"fmt"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+	"math"
+	"strings"
+)
+
+// handleManifestCommand runs kubectl create or replace for every manifest and records the resources,
+// like apply it continues with the remaining manifests on failure and returns false if any of them failed
+func (l *KlangListener) handleManifestCommand(operation string, nss []parser.INsContext, fNames []parser.IString_or_idContext, configs []parser.IKubernetes_object_configContext) valHolder {
+	patterns := []string{"apiVersion", "kind", "metadata.name"}
+	namespace := "default"
+	if len(nss) != 0 {
+		namespace = l.resolveNs(nss[len(nss)-1])
+	}
+	updateConfig := ""
+	if len(configs) != 0 {
+		updateConfigObj := configs[len(configs)-1].(*parser.Kubernetes_object_configContext)
+		updateConfig = l.GetTextFromStringOrId(updateConfigObj.String_or_id().(*parser.String_or_idContext))
+	}
+	k := NewKubectl()
+	returnVal := true
+	for _, fName := range fNames {
+		initialManifest := l.GetTextFromStringOrId(fName.(*parser.String_or_idContext))
+		finalManifest := updateMultipleKubernetesObjectsYaml(updateConfig, initialManifest, patterns)
+		var responses []ApplyResponse
+		var err error
+		if operation == CREATE {
+			responses, err = k.CreateResource(context.Background(), &CreateRequest{Manifest: finalManifest, Namespace: namespace})
+		} else {
+			responses, err = k.ReplaceResource(context.Background(), &ReplaceRequest{Manifest: finalManifest, Namespace: namespace})
+		}
+		if err != nil {
+			fmt.Printf("{\"err\": \"%s\"}\n", err.Error())
+			returnVal = false
+			continue
+		}
+		for _, response := range responses {
+			resource := Resource{
+				Operation: operation,
+				Group:     response.GroupVersionKind.Group,
+				Version:   response.GroupVersionKind.Version,
+				Kind:      response.GroupVersionKind.Kind,
+				Namespace: response.Namespace,
+				Name:      response.Name,
+				Message:   response.Message,
+				Status:    ResourceSyncStatusCodeSynced,
+			}
+			if len(response.Err) > 0 {
+				fmt.Printf("{\"err\": \"%s\"}\n", response.Err)
+				resource.Message = response.Err
+				resource.Status = ResourceSyncStatusCodeOutOfSync
+				returnVal = false
+			}
+			l.recordResource(resource)
+		}
+	}
+	return newBooleanValHolder(returnVal)
+}
+
+func (l *KlangListener) handleKubectlScale(ctx *parser.ScaleKubectlCommandContext) valHolder {
+	if len(ctx.AllExpr()) == 0 {
+		return newErrHolder(fmt.Errorf("scale expects --replicas"))
+	}
+	replicas, err := toReplicas(l.getValIfID(l.handleExpr(ctx.Expr(len(ctx.AllExpr()) - 1))))
+	if err != nil {
+		return newErrHolder(fmt.Errorf("scale --replicas: %v", err))
+	}
+	namespace := "default"
+	if len(ctx.AllNs()) > 0 {
+		namespace = l.resolveNs(ctx.Ns(len(ctx.AllNs()) - 1))
+	}
+	a := NewFactory(l.mapper)
+	var args []string
+	for _, resource := range ctx.AllResource() {
+		args = append(args, l.resolveResource(resource))
+	}
+	a.ResourceTypeOrNameArgs(args...)
+	if len(a.errs) != 0 {
+		var errs []string
+		for _, err := range a.errs {
+			errs = append(errs, err.Error())
+		}
+		return newErrHolder(fmt.Errorf(strings.Join(errs, "\n")))
+	}
+	if len(a.ResourceTuples()) == 0 {
+		return newErrHolder(fmt.Errorf("unable to identify resources"))
+	}
+	k := NewKubectl()
+	returnVal := true
+	for _, rt := range a.ResourceTuples() {
+		resource, err := a.mappingFor(rt.Resource)
+		if err != nil {
+			return newErrHolder(err)
+		}
+		if len(rt.Name) == 0 {
+			return newErrHolder(fmt.Errorf("name is mandatory for scale operation"))
+		}
+		sr := ScaleRequest{
+			Name:             rt.Name,
+			Namespace:        namespace,
+			GroupVersionKind: resource.GroupVersionKind,
+			Replicas:         replicas,
+		}
+		_, err = k.ScaleResource(context.Background(), &sr)
+		res := Resource{
+			Operation: SCALE,
+			Group:     sr.GroupVersionKind.Group,
+			Version:   sr.GroupVersionKind.Version,
+			Kind:      sr.GroupVersionKind.Kind,
+			Namespace: sr.Namespace,
+			Name:      sr.Name,
+			Message:   fmt.Sprintf("scaled to %d replicas", replicas),
+			Status:    ResourceSyncStatusCodeSynced,
+		}
+		if err != nil {
+			fmt.Printf("{\"err\": \"%s\"}\n", err.Error())
+			res.Message = err.Error()
+			res.Status = ResourceSyncStatusCodeOutOfSync
+			returnVal = false
+		}
+		l.recordResource(res)
+	}
+	return newBooleanValHolder(returnVal)
+}
+
+// toReplicas accepts non negative whole numbers, list literals and json hold numbers as FLOAT
+func toReplicas(val valHolder) (int64, error) {
+	var replicas int64
+	switch val.dataType {
+	case INT:
+		replicas = val.value.(int64)
+	case FLOAT:
+		f := val.value.(float64)
+		if f != math.Trunc(f) {
+			return 0, fmt.Errorf("%v is not a whole number", f)
+		}
+		replicas = int64(f)
+	case ERR:
+		return 0, val.value.(error)
+	default:
+		return 0, fmt.Errorf("%s is not a number", val.dataType)
+	}
+	if replicas < 0 {
+		return 0, fmt.Errorf("%d is negative", replicas)
+	}
+	return replicas, nil
+}
+
+// recordResource adds the result of a kubectl operation to the resources of the script and notifies the receivers
+func (l *KlangListener) recordResource(res Resource) {
+	resourceKey := kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)
+	l.kubernetesResources[resourceKey.String()] = append(l.kubernetesResources[resourceKey.String()], res)
+	for _, r := range l.resourceReceivers {
+		r.ReceiveResource(res)
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestKlangListener_handleKubectlScale_invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "missing replicas",
+			input: `x = kubectl scale deployment/web -n apps;`,
+			err:   "scale expects --replicas",
+		},
+		{
+			name:  "negative replicas",
+			input: `x = kubectl scale deployment/web --replicas=-1;`,
+			err:   "-1 is negative",
+		},
+		{
+			name:  "fractional replicas",
+			input: `x = kubectl scale deployment/web --replicas 1.5;`,
+			err:   "1.5 is not a whole number",
+		},
+		{
+			name:  "string replicas",
+			input: `n = "3"; x = kubectl scale deployment/web --replicas n;`,
+			err:   "STRING is not a number",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := setup(tt.input)
+			x := r.values["x"]
+			if x.dataType != ERR || !strings.Contains(x.value.(error).Error(), tt.err) {
+				t.Errorf("expected error containing %q, found %+v", tt.err, x)
+			}
+		})
+	}
+}
+
+func Test_toReplicas(t *testing.T) {
+	tests := []struct {
+		name    string
+		val     valHolder
+		want    int64
+		wantErr bool
+	}{
+		{name: "int", val: valHolder{dataType: INT, value: int64(3)}, want: 3},
+		{name: "zero", val: valHolder{dataType: INT, value: int64(0)}, want: 0},
+		{name: "whole float", val: valHolder{dataType: FLOAT, value: 2.0}, want: 2},
+		{name: "fraction", val: valHolder{dataType: FLOAT, value: 2.5}, wantErr: true},
+		{name: "negative", val: valHolder{dataType: INT, value: int64(-2)}, wantErr: true},
+		{name: "boolean", val: valHolder{dataType: BOOLEAN, value: true}, wantErr: true},
+		{name: "error", val: valHolder{dataType: ERR, value: errors.New("failed")}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toReplicas(tt.val)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("toReplicas() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("toReplicas() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_splitManifests(t *testing.T) {
+	manifest := `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: first
+---
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Secret
+  metadata:
+    name: second
+- apiVersion: v1
+  kind: Service
+  metadata:
+    name: third
+---
+apiVersion: v1
+kind: ConfigMapList
+items: null
+`
+	manifests, err := splitManifests(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, m := range manifests {
+		names = append(names, m.GetKind()+"/"+m.GetName())
+	}
+	if got := strings.Join(names, ","); got != "ConfigMap/first,Secret/second,Service/third" {
+		t.Errorf("splitManifests() = %s", got)
+	}
+}
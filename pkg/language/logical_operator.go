@@ -16,6 +16,11 @@ limitations under the License.
 
 package language
 
+import (
+	"reflect"
+	"time"
+)
+
 func logicalIntIntOperation(lhs, rhs valHolder, operator LogicalOperator) bool {
 	lv := lhs.value.(int64)
 	rv := rhs.value.(int64)
@@ -120,3 +125,37 @@ func logicalStringStringOperation(lhs, rhs valHolder, operator LogicalOperator)
 		return false
 	}
 }
+
+func logicalDurationDurationOperation(lhs, rhs valHolder, operator LogicalOperator) bool {
+	lv := lhs.value.(time.Duration)
+	rv := rhs.value.(time.Duration)
+	switch operator {
+	case EQ:
+		return lv == rv
+	case NEQ:
+		return lv != rv
+	case LTEQ:
+		return lv <= rv
+	case LT:
+		return lv < rv
+	case GTEQ:
+		return lv >= rv
+	case GT:
+		return lv > rv
+	default:
+		return false
+	}
+}
+
+// lists and maps are equal if they have the same items, they cannot be ordered
+func logicalCollectionCollectionOperation(lhs, rhs valHolder, operator LogicalOperator) bool {
+	equal := reflect.DeepEqual(toNative(lhs), toNative(rhs))
+	switch operator {
+	case EQ:
+		return equal
+	case NEQ:
+		return !equal
+	default:
+		return false
+	}
+}
@@ -18,26 +18,126 @@ package language
 
 import (
 	"fmt"
+	"math"
 	"strconv"
+	"time"
 )
 
+// mathematicalOperation applies operator to the values of an arithmetic expression. Integers stay integers
+// except for division, which is always done in floats, and powers with negative exponents, an integer and a
+// float give a float. Strings are concatenated with strings and numbers, durations are added to and
+// subtracted from durations and multiplied or divided by numbers. Operands which are errors are returned,
+// other invalid operations, divisions by zero and overflows give ERR values.
+func mathematicalOperation(lhs, rhs valHolder, operator MathematicalOperator) valHolder {
+	if lhs.dataType == ERR {
+		return lhs
+	}
+	if rhs.dataType == ERR {
+		return rhs
+	}
+	switch {
+	case lhs.dataType == INT && rhs.dataType == INT:
+		return mathematicalIntIntOperation(lhs, rhs, operator)
+	case lhs.dataType == FLOAT && rhs.dataType == FLOAT:
+		return mathematicalFloatFloatOperation(lhs, rhs, operator)
+	case lhs.dataType == INT && rhs.dataType == FLOAT:
+		return mathematicalIntFloatOperation(lhs, rhs, operator)
+	case lhs.dataType == FLOAT && rhs.dataType == INT:
+		return mathematicalFloatIntOperation(lhs, rhs, operator)
+	case lhs.dataType == STRING && rhs.dataType == STRING:
+		return mathematicalStringStringOperation(lhs, rhs, operator)
+	case lhs.dataType == STRING && rhs.dataType == INT:
+		return mathematicalStringIntOperation(lhs, rhs, operator)
+	case lhs.dataType == STRING && rhs.dataType == FLOAT:
+		return mathematicalStringFloatOperation(lhs, rhs, operator)
+	case lhs.dataType == INT && rhs.dataType == STRING:
+		return mathematicalIntStringOperation(lhs, rhs, operator)
+	case lhs.dataType == FLOAT && rhs.dataType == STRING:
+		return mathematicalFloatStringOperation(lhs, rhs, operator)
+	case lhs.dataType == DURATION && rhs.dataType == DURATION:
+		return mathematicalDurationDurationOperation(lhs, rhs, operator)
+	case lhs.dataType == DURATION && (rhs.dataType == INT || rhs.dataType == FLOAT):
+		return mathematicalDurationNumberOperation(lhs, rhs, operator)
+	case (lhs.dataType == INT || lhs.dataType == FLOAT) && rhs.dataType == DURATION && operator == MULT:
+		return mathematicalDurationNumberOperation(rhs, lhs, operator)
+	}
+	return invalidOperation(lhs, rhs, operator)
+}
+
+// negate is the unary minus of numbers and durations
+func negate(val valHolder) valHolder {
+	switch val.dataType {
+	case ERR:
+		return val
+	case INT:
+		if val.value.(int64) == math.MinInt64 {
+			return newErrHolder(fmt.Errorf("integer overflow in -(%d)", val.value))
+		}
+		return newIntValHolder(-val.value.(int64))
+	case FLOAT:
+		return newFloatValHolder(-val.value.(float64))
+	case DURATION:
+		if val.value.(time.Duration) == math.MinInt64 {
+			return newErrHolder(fmt.Errorf("duration overflow in -(%s)", val.value))
+		}
+		return newDurationValHolder(-val.value.(time.Duration))
+	}
+	return newErrHolder(fmt.Errorf("cannot apply - to %s", dataTypeName(val)))
+}
+
+func invalidOperation(lhs, rhs valHolder, operator MathematicalOperator) valHolder {
+	return newErrHolder(fmt.Errorf("cannot apply %s to %s and %s", operator, dataTypeName(lhs), dataTypeName(rhs)))
+}
+
+func operationErr(reason string, lhs, rhs valHolder, operator MathematicalOperator) valHolder {
+	return newErrHolder(fmt.Errorf("%s in %v %s %v", reason, lhs.value, operator, rhs.value))
+}
+
+// dataTypeName names the type of val in errors, undefined variables have no type
+func dataTypeName(val valHolder) string {
+	if len(val.dataType) == 0 {
+		return "no value"
+	}
+	return string(val.dataType)
+}
+
 func mathematicalIntIntOperation(lhs, rhs valHolder, operator MathematicalOperator) valHolder {
 	lv := lhs.value.(int64)
 	rv := rhs.value.(int64)
+	var r int64
+	ok := true
 	switch operator {
 	case PLUS:
-		return newIntValHolder(lv + rv)
+		r, ok = addInt(lv, rv)
 	case MINUS:
-		return newIntValHolder(lv - rv)
+		r, ok = subtractInt(lv, rv)
 	case MULT:
-		return newIntValHolder(lv * rv)
+		r, ok = multiplyInt(lv, rv)
 	case DIV:
+		if rv == 0 {
+			return operationErr("division by zero", lhs, rhs, operator)
+		}
 		return newFloatValHolder(float64(lv) / float64(rv))
 	case MOD:
-		return newIntValHolder(lv % rv)
+		if rv == 0 {
+			return operationErr("division by zero", lhs, rhs, operator)
+		}
+		r = lv % rv
+	case POW:
+		if rv < 0 {
+			if lv == 0 {
+				return operationErr("division by zero", lhs, rhs, operator)
+			}
+			return floatResult(math.Pow(float64(lv), float64(rv)), lhs, rhs, operator)
+		}
+		r, ok = powInt(lv, rv)
 	default:
-		return valHolder{}
+		return invalidOperation(lhs, rhs, operator)
 	}
+	if !ok {
+		return operationErr("integer overflow", lhs, rhs, operator)
+	}
+	return newIntValHolder(r)
 }
 
 func mathematicalFloatFloatOperation(lhs, rhs valHolder, operator MathematicalOperator) valHolder {
@@ -45,50 +145,48 @@ func mathematicalFloatFloatOperation(lhs, rhs valHolder, operator MathematicalOp
 	rv := rhs.value.(float64)
 	switch operator {
 	case PLUS:
-		return newFloatValHolder(lv + rv)
+		return floatResult(lv+rv, lhs, rhs, operator)
 	case MINUS:
-		return newFloatValHolder(lv - rv)
+		return floatResult(lv-rv, lhs, rhs, operator)
 	case MULT:
-		return newFloatValHolder(lv * rv)
+		return floatResult(lv*rv, lhs, rhs, operator)
 	case DIV:
-		return newFloatValHolder(lv / rv)
+		if rv == 0 {
+			return operationErr("division by zero", lhs, rhs, operator)
+		}
+		return floatResult(lv/rv, lhs, rhs, operator)
+	case MOD:
+		if rv == 0 {
+			return operationErr("division by zero", lhs, rhs, operator)
+		}
+		return floatResult(math.Mod(lv, rv), lhs, rhs, operator)
+	case POW:
+		if lv == 0 && rv < 0 {
+			return operationErr("division by zero", lhs, rhs, operator)
+		}
+		return floatResult(math.Pow(lv, rv), lhs, rhs, operator)
 	default:
-		return valHolder{}
+		return invalidOperation(lhs, rhs, operator)
 	}
 }
 
 func mathematicalIntFloatOperation(lhs, rhs valHolder, operator MathematicalOperator) valHolder {
-	lv := float64(lhs.value.(int64))
-	rv := rhs.value.(float64)
-	switch operator {
-	case PLUS:
-		return newFloatValHolder(lv + rv)
-	case MINUS:
-		return newFloatValHolder(lv - rv)
-	case MULT:
-		return newFloatValHolder(lv * rv)
-	case DIV:
-		return newFloatValHolder(lv / rv)
-	default:
-		return valHolder{}
-	}
+	return mathematicalFloatFloatOperation(newFloatValHolder(float64(lhs.value.(int64))), rhs, operator)
 }
 
 func mathematicalFloatIntOperation(lhs, rhs valHolder, operator MathematicalOperator) valHolder {
-	lv := lhs.value.(float64)
-	rv := float64(rhs.value.(int64))
-	switch operator {
-	case PLUS:
-		return newFloatValHolder(lv + rv)
-	case MINUS:
-		return newFloatValHolder(lv - rv)
-	case MULT:
-		return newFloatValHolder(lv * rv)
-	case DIV:
-		return newFloatValHolder(lv / rv)
-	default:
-		return valHolder{}
+	return mathematicalFloatFloatOperation(lhs, newFloatValHolder(float64(rhs.value.(int64))), operator)
+}
+
+// floatResult rejects infinite results of overflows and results which are not numbers, like (-8) ^ 0.5
+func floatResult(r float64, lhs, rhs valHolder, operator MathematicalOperator) valHolder {
+	if math.IsInf(r, 0) {
+		return operationErr("float overflow", lhs, rhs, operator)
+	}
+	if math.IsNaN(r) {
+		return operationErr("result is not a number", lhs, rhs, operator)
 	}
+	return newFloatValHolder(r)
 }
 
 func mathematicalStringStringOperation(lhs, rhs valHolder, operator MathematicalOperator) valHolder {
@@ -98,19 +196,18 @@ func mathematicalStringStringOperation(lhs, rhs valHolder, operator Mathematical
 	case PLUS:
 		return newStringValHolder(lv + rv)
 	default:
-		return valHolder{}
+		return invalidOperation(lhs, rhs, operator)
 	}
 }
 
 func mathematicalStringIntOperation(lhs, rhs valHolder, operator MathematicalOperator) valHolder {
 	lv := lhs.value.(string)
-	rv := rhs.value.(int)
-	rvs := strconv.Itoa(rv)
+	rvs := strconv.FormatInt(rhs.value.(int64), 10)
 	switch operator {
 	case PLUS:
 		return newStringValHolder(lv + rvs)
 	default:
-		return valHolder{}
+		return invalidOperation(lhs, rhs, operator)
 	}
 }
 
@@ -125,19 +222,18 @@ func mathematicalStringFloatOperation(lhs, rhs valHolder, operator MathematicalO
 	case PLUS:
 		return newStringValHolder(lv + rvs)
 	default:
-		return valHolder{}
+		return invalidOperation(lhs, rhs, operator)
 	}
 }
 
 func mathematicalIntStringOperation(lhs, rhs valHolder, operator MathematicalOperator) valHolder {
 	rv := rhs.value.(string)
-	lv := lhs.value.(int)
-	lvs := strconv.Itoa(lv)
+	lvs := strconv.FormatInt(lhs.value.(int64), 10)
 	switch operator {
 	case PLUS:
 		return newStringValHolder(lvs + rv)
 	default:
-		return valHolder{}
+		return invalidOperation(lhs, rhs, operator)
 	}
 }
 
@@ -152,6 +248,105 @@ func mathematicalFloatStringOperation(lhs, rhs valHolder, operator MathematicalO
 	case PLUS:
 		return newStringValHolder(lvs + rv)
 	default:
-		return valHolder{}
+		return invalidOperation(lhs, rhs, operator)
+	}
+}
+
+// durations divided by durations give their ratio as a float, like 90s / 1m is 1.5
+func mathematicalDurationDurationOperation(lhs, rhs valHolder, operator MathematicalOperator) valHolder {
+	lv := int64(lhs.value.(time.Duration))
+	rv := int64(rhs.value.(time.Duration))
+	var r int64
+	ok := true
+	switch operator {
+	case PLUS:
+		r, ok = addInt(lv, rv)
+	case MINUS:
+		r, ok = subtractInt(lv, rv)
+	case DIV:
+		if rv == 0 {
+			return operationErr("division by zero", lhs, rhs, operator)
+		}
+		return newFloatValHolder(float64(lv) / float64(rv))
+	case MOD:
+		if rv == 0 {
+			return operationErr("division by zero", lhs, rhs, operator)
+		}
+		r = lv % rv
+	default:
+		return invalidOperation(lhs, rhs, operator)
+	}
+	if !ok {
+		return operationErr("duration overflow", lhs, rhs, operator)
+	}
+	return newDurationValHolder(time.Duration(r))
+}
+
+// durations multiplied or divided by numbers are rounded to nanoseconds
+func mathematicalDurationNumberOperation(lhs, rhs valHolder, operator MathematicalOperator) valHolder {
+	lv := float64(lhs.value.(time.Duration))
+	var rv float64
+	if rhs.dataType == INT {
+		rv = float64(rhs.value.(int64))
+	} else {
+		rv = rhs.value.(float64)
+	}
+	var r float64
+	switch operator {
+	case MULT:
+		r = lv * rv
+	case DIV:
+		if rv == 0 {
+			return operationErr("division by zero", lhs, rhs, operator)
+		}
+		r = lv / rv
+	default:
+		return invalidOperation(lhs, rhs, operator)
+	}
+	r = math.Round(r)
+	if r >= math.MaxInt64 || r < math.MinInt64 {
+		return operationErr("duration overflow", lhs, rhs, operator)
+	}
+	return newDurationValHolder(time.Duration(r))
+}
+
+func addInt(a, b int64) (int64, bool) {
+	r := a + b
+	return r, (b >= 0) == (r >= a)
+}
+
+func subtractInt(a, b int64) (int64, bool) {
+	r := a - b
+	return r, (b >= 0) == (r <= a)
+}
+
+func multiplyInt(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	r := a * b
+	if (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) || r/b != a {
+		return r, false
+	}
+	return r, true
+}
+
+// powInt raises base to a non negative exponent by squaring
+func powInt(base, exponent int64) (int64, bool) {
+	r := int64(1)
+	ok := true
+	for exponent > 0 {
+		if exponent&1 == 1 {
+			if r, ok = multiplyInt(r, base); !ok {
+				return r, false
+			}
+		}
+		exponent >>= 1
+		if exponent > 0 {
+			if base, ok = multiplyInt(base, base); !ok {
+				return base, false
+			}
+		}
 	}
+	return r, true
 }
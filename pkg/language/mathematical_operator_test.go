@@ -0,0 +1,207 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestMathematicalOperation(t *testing.T) {
+	i := newIntValHolder
+	f := newFloatValHolder
+	s := newStringValHolder
+	d := newDurationValHolder
+	tests := []struct {
+		lhs      valHolder
+		operator MathematicalOperator
+		rhs      valHolder
+		want     valHolder
+		wantErr  string
+	}{
+		{lhs: i(7), operator: PLUS, rhs: i(2), want: i(9)},
+		{lhs: i(7), operator: MINUS, rhs: i(2), want: i(5)},
+		{lhs: i(7), operator: MULT, rhs: i(-2), want: i(-14)},
+		{lhs: i(7), operator: DIV, rhs: i(2), want: f(3.5)},
+		{lhs: i(-7), operator: MOD, rhs: i(2), want: i(-1)},
+		{lhs: i(3), operator: POW, rhs: i(4), want: i(81)},
+		{lhs: i(-2), operator: POW, rhs: i(3), want: i(-8)},
+		{lhs: i(5), operator: POW, rhs: i(0), want: i(1)},
+		{lhs: i(2), operator: POW, rhs: i(-2), want: f(0.25)},
+		{lhs: i(2), operator: POW, rhs: i(62), want: i(1 << 62)},
+		{lhs: i(7), operator: DIV, rhs: i(0), wantErr: "division by zero in 7 / 0"},
+		{lhs: i(7), operator: MOD, rhs: i(0), wantErr: "division by zero in 7 % 0"},
+		{lhs: i(0), operator: POW, rhs: i(-1), wantErr: "division by zero in 0 ^ -1"},
+		{lhs: i(math.MaxInt64), operator: PLUS, rhs: i(1), wantErr: "integer overflow in 9223372036854775807 + 1"},
+		{lhs: i(math.MinInt64), operator: MINUS, rhs: i(1), wantErr: "integer overflow in -9223372036854775808 - 1"},
+		{lhs: i(math.MaxInt64), operator: MULT, rhs: i(2), wantErr: "integer overflow in 9223372036854775807 * 2"},
+		{lhs: i(math.MinInt64), operator: MULT, rhs: i(-1), wantErr: "integer overflow in -9223372036854775808 * -1"},
+		{lhs: i(2), operator: POW, rhs: i(63), wantErr: "integer overflow in 2 ^ 63"},
+		{lhs: i(math.MinInt64), operator: MOD, rhs: i(-1), want: i(0)},
+
+		{lhs: f(1.5), operator: PLUS, rhs: f(2.25), want: f(3.75)},
+		{lhs: f(1.5), operator: MINUS, rhs: f(2.25), want: f(-0.75)},
+		{lhs: f(1.5), operator: MULT, rhs: f(2), want: f(3)},
+		{lhs: f(3), operator: DIV, rhs: f(2), want: f(1.5)},
+		{lhs: f(7.5), operator: MOD, rhs: f(2), want: f(1.5)},
+		{lhs: f(2.25), operator: POW, rhs: f(0.5), want: f(1.5)},
+		{lhs: f(1), operator: DIV, rhs: f(0), wantErr: "division by zero in 1 / 0"},
+		{lhs: f(1), operator: MOD, rhs: f(0), wantErr: "division by zero in 1 % 0"},
+		{lhs: f(math.MaxFloat64), operator: MULT, rhs: f(2), wantErr: "float overflow in 1.7976931348623157e+308 * 2"},
+		{lhs: f(0), operator: POW, rhs: f(-0.5), wantErr: "division by zero in 0 ^ -0.5"},
+		{lhs: f(-8), operator: POW, rhs: f(0.5), wantErr: "result is not a number in -8 ^ 0.5"},
+
+		{lhs: i(1), operator: PLUS, rhs: f(0.5), want: f(1.5)},
+		{lhs: i(1), operator: MINUS, rhs: f(0.5), want: f(0.5)},
+		{lhs: i(3), operator: MULT, rhs: f(0.5), want: f(1.5)},
+		{lhs: i(3), operator: DIV, rhs: f(0.5), want: f(6)},
+		{lhs: i(7), operator: MOD, rhs: f(2.5), want: f(2)},
+		{lhs: i(4), operator: POW, rhs: f(0.5), want: f(2)},
+		{lhs: i(1), operator: DIV, rhs: f(0), wantErr: "division by zero in 1 / 0"},
+		{lhs: f(0.5), operator: PLUS, rhs: i(1), want: f(1.5)},
+		{lhs: f(0.5), operator: MINUS, rhs: i(1), want: f(-0.5)},
+		{lhs: f(0.5), operator: MULT, rhs: i(3), want: f(1.5)},
+		{lhs: f(1.5), operator: DIV, rhs: i(3), want: f(0.5)},
+		{lhs: f(5.5), operator: MOD, rhs: i(2), want: f(1.5)},
+		{lhs: f(1.5), operator: POW, rhs: i(2), want: f(2.25)},
+		{lhs: f(1.5), operator: MOD, rhs: i(0), wantErr: "division by zero in 1.5 % 0"},
+
+		{lhs: s("a"), operator: PLUS, rhs: s("b"), want: s("ab")},
+		{lhs: s("replicas-"), operator: PLUS, rhs: i(3), want: s("replicas-3")},
+		{lhs: i(3), operator: PLUS, rhs: s("-replicas"), want: s("3-replicas")},
+		{lhs: s("v"), operator: PLUS, rhs: f(1.5), want: s("v1.500000")},
+		{lhs: f(2), operator: PLUS, rhs: s("x"), want: s("2x")},
+		{lhs: s("a"), operator: MINUS, rhs: s("b"), wantErr: "cannot apply - to STRING and STRING"},
+		{lhs: s("a"), operator: MULT, rhs: i(2), wantErr: "cannot apply * to STRING and INT"},
+		{lhs: i(2), operator: POW, rhs: s("a"), wantErr: "cannot apply ^ to INT and STRING"},
+		{lhs: f(2), operator: DIV, rhs: s("a"), wantErr: "cannot apply / to FLOAT and STRING"},
+		{lhs: s("a"), operator: MOD, rhs: f(2), wantErr: "cannot apply % to STRING and FLOAT"},
+
+		{lhs: d(time.Minute), operator: PLUS, rhs: d(30 * time.Second), want: d(90 * time.Second)},
+		{lhs: d(time.Minute), operator: MINUS, rhs: d(90 * time.Second), want: d(-30 * time.Second)},
+		{lhs: d(90 * time.Second), operator: DIV, rhs: d(time.Minute), want: f(1.5)},
+		{lhs: d(90 * time.Second), operator: MOD, rhs: d(time.Minute), want: d(30 * time.Second)},
+		{lhs: d(time.Minute), operator: MULT, rhs: d(time.Minute), wantErr: "cannot apply * to DURATION and DURATION"},
+		{lhs: d(time.Minute), operator: DIV, rhs: d(0), wantErr: "division by zero in 1m0s / 0s"},
+		{lhs: d(math.MaxInt64), operator: PLUS, rhs: d(1), wantErr: "duration overflow in 2562047h47m16.854775807s + 1ns"},
+		{lhs: d(time.Minute), operator: MULT, rhs: i(3), want: d(3 * time.Minute)},
+		{lhs: d(time.Minute), operator: MULT, rhs: f(1.5), want: d(90 * time.Second)},
+		{lhs: i(3), operator: MULT, rhs: d(time.Minute), want: d(3 * time.Minute)},
+		{lhs: f(0.5), operator: MULT, rhs: d(time.Minute), want: d(30 * time.Second)},
+		{lhs: d(time.Minute), operator: DIV, rhs: i(4), want: d(15 * time.Second)},
+		{lhs: d(time.Minute), operator: DIV, rhs: f(0.5), want: d(2 * time.Minute)},
+		{lhs: d(time.Minute), operator: DIV, rhs: i(0), wantErr: "division by zero in 1m0s / 0"},
+		{lhs: d(time.Hour), operator: MULT, rhs: i(math.MaxInt64), wantErr: "duration overflow in 1h0m0s * 9223372036854775807"},
+		{lhs: d(time.Minute), operator: PLUS, rhs: i(1), wantErr: "cannot apply + to DURATION and INT"},
+		{lhs: i(1), operator: DIV, rhs: d(time.Minute), wantErr: "cannot apply / to INT and DURATION"},
+		{lhs: d(time.Minute), operator: POW, rhs: i(2), wantErr: "cannot apply ^ to DURATION and INT"},
+		{lhs: s("a"), operator: PLUS, rhs: d(time.Minute), wantErr: "cannot apply + to STRING and DURATION"},
+
+		{lhs: newBooleanValHolder(true), operator: PLUS, rhs: i(1), wantErr: "cannot apply + to BOOLEAN and INT"},
+		{lhs: i(1), operator: PLUS, rhs: newNilValHolder(), wantErr: "cannot apply + to INT and NIL"},
+		{lhs: newListValHolder(nil), operator: PLUS, rhs: newListValHolder(nil), wantErr: "cannot apply + to LIST and LIST"},
+		{lhs: newEmptyHolder(), operator: MINUS, rhs: i(1), wantErr: "cannot apply - to no value and INT"},
+		{lhs: i(1), operator: DIV, rhs: newErrHolder(errors.New("failed")), wantErr: "failed"},
+		{lhs: newErrHolder(errors.New("failed")), operator: PLUS, rhs: i(1), wantErr: "failed"},
+	}
+	for _, tt := range tests {
+		name := dataTypeName(tt.lhs) + " " + tt.operator.String() + " " + dataTypeName(tt.rhs)
+		t.Run(name, func(t *testing.T) {
+			got := mathematicalOperation(tt.lhs, tt.rhs, tt.operator)
+			checkArithmetic(t, got, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func TestNegate(t *testing.T) {
+	tests := []struct {
+		val     valHolder
+		want    valHolder
+		wantErr string
+	}{
+		{val: newIntValHolder(3), want: newIntValHolder(-3)},
+		{val: newIntValHolder(math.MinInt64), wantErr: "integer overflow in -(-9223372036854775808)"},
+		{val: newFloatValHolder(-1.5), want: newFloatValHolder(1.5)},
+		{val: newDurationValHolder(time.Second), want: newDurationValHolder(-time.Second)},
+		{val: newStringValHolder("a"), wantErr: "cannot apply - to STRING"},
+		{val: newBooleanValHolder(true), wantErr: "cannot apply - to BOOLEAN"},
+		{val: newEmptyHolder(), wantErr: "cannot apply - to no value"},
+		{val: newErrHolder(errors.New("failed")), wantErr: "failed"},
+	}
+	for _, tt := range tests {
+		t.Run(dataTypeName(tt.val), func(t *testing.T) {
+			checkArithmetic(t, negate(tt.val), tt.want, tt.wantErr)
+		})
+	}
+}
+
+func TestKlangListener_arithmetic(t *testing.T) {
+	input := "x = 5;\n" +
+		"a = -x ^ 2;\n" +
+		"b = 2 ^ 3 ^ 2;\n" +
+		"c = 1 + 2 * 3 ^ 2;\n" +
+		"d = -(1 + 2) * 2;\n" +
+		"e = -x % 3;\n" +
+		"f = x ^ -1;\n" +
+		"g = 7.5 % 2;\n" +
+		"t = 1m30s;\n" +
+		"h = -t * 2;\n" +
+		"i = 10 % (x - 5);\n" +
+		"j = 3 ^ 40;\n" +
+		"k = \"n\" + 2 ^ 3;\n"
+	want := map[string]valHolder{
+		"a": {dataType: INT, name: "a", value: int64(-25)},
+		"b": {dataType: INT, name: "b", value: int64(512)},
+		"c": {dataType: INT, name: "c", value: int64(19)},
+		"d": {dataType: INT, name: "d", value: int64(-6)},
+		"x": {dataType: INT, name: "x", value: int64(5)},
+		"e": {dataType: INT, name: "e", value: int64(-2)},
+		"f": {dataType: FLOAT, name: "f", value: 0.2},
+		"g": {dataType: FLOAT, name: "g", value: 1.5},
+		"h": {dataType: DURATION, name: "h", value: -3 * time.Minute},
+		"t": {dataType: DURATION, name: "t", value: 90 * time.Second},
+		"k": {dataType: STRING, name: "k", value: "n8"},
+	}
+	r := setup(input)
+	values := r.Values()
+	for _, name := range []string{"i", "j"} {
+		if v := values[name]; v.dataType != ERR {
+			t.Errorf("expected %s to be an error, found %+v", name, v)
+		}
+		delete(values, name)
+	}
+	if !compare(want, values) {
+		t.Errorf("expected %+v, found %+v", want, values)
+	}
+}
+
+func checkArithmetic(t *testing.T, got, want valHolder, wantErr string) {
+	if len(wantErr) != 0 {
+		if got.dataType != ERR {
+			t.Fatalf("expected error %q, found %+v", wantErr, got)
+		}
+		if err := got.value.(error).Error(); err != wantErr {
+			t.Errorf("expected error %q, found %q", wantErr, err)
+		}
+		return
+	}
+	if got.dataType != want.dataType || got.value != want.value {
+		t.Errorf("expected %+v, found %+v", want, got)
+	}
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"fmt"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"strings"
+)
+
+// klangModule is an imported script. Its functions run on its own listener so that they see
+// the module's globals and functions rather than the importer's.
+type klangModule struct {
+	location string
+	listener *KlangListener
+}
+
+// moduleLoader is shared by a script and all the modules it imports, directly or not
+type moduleLoader struct {
+	cache   map[string]*klangModule
+	loading []string
+}
+
+func newModuleLoader() *moduleLoader {
+	return &moduleLoader{cache: make(map[string]*klangModule, 0)}
+}
+
+// SetLocation sets the url or path the script was read from, relative imports are resolved against it
+func (l *KlangListener) SetLocation(location string) {
+	l.location = location
+}
+
+func (l *KlangListener) handleImport_stat(ctx *parser.Import_statContext) {
+	location := l.GetTextFromStringOrId(ctx.String_or_id().(*parser.String_or_idContext))
+	name := ctx.ID().GetText()
+	m, err := l.loadModule(resolveLocation(l.location, location))
+	if err != nil {
+		//a script cannot run without its modules so failed imports are raised even outside try blocks
+		l.raise(&klangError{message: fmt.Sprintf("import %s: %v", location, err), line: ctx.GetStart().GetLine()})
+		return
+	}
+	l.modules[name] = m
+	l.exitWith(m.listener)
+	r := newMapValHolder(m.listener.Values())
+	r.name = name
+	if err := l.assign(r); err != nil {
+		l.raise(&klangError{message: err.Error(), line: ctx.GetStart().GetLine()})
+	}
+}
+
+// loadModule runs the script at location once per run, later imports get the cached module
+func (l *KlangListener) loadModule(location string) (*klangModule, error) {
+	if m, ok := l.loader.cache[location]; ok {
+		return m, nil
+	}
+	for i, loading := range l.loader.loading {
+		if loading == location {
+			cycle := append(append([]string{}, l.loader.loading[i:]...), location)
+			return nil, fmt.Errorf("import cycle %s", strings.Join(cycle, " -> "))
+		}
+	}
+	data, err := readModule(location)
+	if err != nil {
+		return nil, err
+	}
+	l.loader.loading = append(l.loader.loading, location)
+	defer func() { l.loader.loading = l.loader.loading[:len(l.loader.loading)-1] }()
+
+	tree, syntaxErrors := Parse(data)
+	if len(syntaxErrors) != 0 {
+		return nil, fmt.Errorf("syntax errors in module %s: %s", location, JoinSyntaxErrors(syntaxErrors))
+	}
+	ml := l.newModuleListener(location)
+	//the module is loaded within the timeout blocks around the import
+	ml.deadlines = l.deadlines
+	ml.Run(tree)
+	ml.deadlines = nil
+	if ml.Err() != nil {
+		return nil, ml.Err()
+	}
+	m := &klangModule{location: location, listener: ml}
+	l.loader.cache[location] = m
+	return m, nil
+}
+
+// newModuleListener shares resources, receivers, built-ins, dry run, the output and the module loader with l
+func (l *KlangListener) newModuleListener(location string) *KlangListener {
+	ml := NewKlangListener(l.mapper)
+	ml.kubernetesResources = l.kubernetesResources
+	ml.stepReceivers = l.stepReceivers
+	ml.resourceReceivers = l.resourceReceivers
+	ml.builtins = l.builtins
+	ml.loader = l.loader
+	ml.location = location
+	ml.dryRun = l.dryRun
+	ml.out = l.out
+	return ml
+}
+
+// handleModule_call calls name, written as module.function, on the module's listener
+func (l *KlangListener) handleModule_call(ctx *parser.Func_callContext, name string) valHolder {
+	i := strings.Index(name, ".")
+	alias, fName := name[:i], name[i+1:]
+	m, ok := l.modules[alias]
+	if !ok {
+		return newErrHolder(fmt.Errorf("module %s is not imported", alias))
+	}
+	f, ok := m.listener.funcs[fName]
+	if !ok {
+		return newErrHolder(fmt.Errorf("function %s is not defined in module %s", fName, alias))
+	}
+	args := l.funcArgs(ctx)
+	//error values inside the module function are raised if the call is inside a try block,
+	//the timeout blocks around the call also apply to the module function
+	ml := m.listener
+	ml.tryDepth += l.tryDepth
+	deadlines := ml.deadlines
+	ml.deadlines = append(append([]deadline{}, l.deadlines...), deadlines...)
+	r := ml.callFunc(f, args)
+	ml.deadlines = deadlines
+	ml.tryDepth -= l.tryDepth
+	if ml.thrown != nil {
+		l.raise(ml.thrown)
+		ml.thrown = nil
+	}
+	l.exitWith(ml)
+	return r
+}
+
+// exitWith ends the script when an exit statement was executed in the module
+func (l *KlangListener) exitWith(ml *KlangListener) {
+	if ml.exit == nil {
+		return
+	}
+	l.exit = ml.exit
+	l.shouldExit = true
+}
+
+func isUrl(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
+
+// resolveLocation resolves relative imports against the location of the importing module
+func resolveLocation(base, location string) string {
+	if isUrl(location) || len(base) == 0 {
+		return location
+	}
+	if isUrl(base) {
+		u, err := url.Parse(base)
+		if err != nil {
+			return location
+		}
+		ref, err := url.Parse(location)
+		if err != nil {
+			return location
+		}
+		return u.ResolveReference(ref).String()
+	}
+	if filepath.IsAbs(location) {
+		return location
+	}
+	return filepath.Join(filepath.Dir(base), location)
+}
+
+// urls are downloaded like download_fn does, paths are read like load_fn does
+func readModule(location string) (string, error) {
+	if isUrl(location) {
+		return downloadFile(location, "")
+	}
+	data, err := ioutil.ReadFile(location)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,300 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestKlangListener_handleImport_stat(t *testing.T) {
+	dir, err := ioutil.TempDir("", "klang-modules")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	modules := map[string]string{
+		"util.klang": `
+prefix = "app-";
+func name(n) {
+  return prefix + suffix(n);
+}
+func suffix(n) {
+  return n + "-svc";
+}
+`,
+		"lib/main.klang": `
+import "helper.klang" as helper;
+func twice(n) {
+  return helper.double(n) * 2;
+}
+`,
+		"lib/helper.klang": `
+func double(n) {
+  return n + n;
+}
+`,
+		"a.klang": `
+import "b.klang" as b;
+`,
+		"b.klang": `
+import "a.klang" as a;
+`,
+		"fail.klang": `
+func fail() {
+  throw "module failure";
+}
+`,
+		"spin.klang": `
+func spin() {
+  while true {
+    sleep 10ms;
+  }
+}
+`,
+		"slow.klang": `
+sleep 10ms;
+while true {
+  sleep 10ms;
+}
+`,
+		"cleanup.klang": `
+old = kubectl delete ns/old;
+func remove() {
+  return kubectl delete ns/apps;
+}
+`,
+		"broken.klang": `
+func broken() {
+  return 1 +;
+}
+`,
+	}
+	for name, content := range modules {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Run("functions and variables", func(t *testing.T) {
+		r := setup(`
+import "` + filepath.Join(dir, "util.klang") + `" as util;
+x = util.name("web");
+p = util.prefix;
+`)
+		expected := map[string]valHolder{
+			"x": {dataType: STRING, name: "x", value: "app-web-svc"},
+			"p": {dataType: STRING, name: "p", value: "app-"},
+		}
+		for k, v := range expected {
+			if !compare(map[string]valHolder{k: v}, map[string]valHolder{k: r.values[k]}) {
+				t.Errorf("expected %+v, found %+v\n", v, r.values[k])
+			}
+		}
+	})
+
+	t.Run("relative import from the location of the script", func(t *testing.T) {
+		tree, errs := Parse(`
+import "lib/main.klang" as m;
+import "../` + filepath.Base(dir) + `/util.klang" as util;
+x = m.twice(2);
+p = util.prefix;
+`)
+		if len(errs) != 0 {
+			t.Fatal(JoinSyntaxErrors(errs))
+		}
+		l := NewKlangListener(nil)
+		l.SetLocation(filepath.Join(dir, "main.klang"))
+		l.Run(tree)
+		if l.Err() != nil {
+			t.Fatal(l.Err())
+		}
+		if x := l.values["x"]; x.dataType != INT || x.value != int64(8) {
+			t.Errorf("expected 8, found %+v\n", x)
+		}
+		if p := l.values["p"]; p.dataType != STRING || p.value != "app-" {
+			t.Errorf("expected app-, found %+v\n", p)
+		}
+	})
+
+	t.Run("relative import and cache", func(t *testing.T) {
+		r := setup(`
+import "` + filepath.Join(dir, "lib", "main.klang") + `" as m;
+import "` + filepath.Join(dir, "lib", "main.klang") + `" as n;
+x = m.twice(3);
+`)
+		x := r.values["x"]
+		if x.dataType != INT || x.value != int64(12) {
+			t.Errorf("expected 12, found %+v\n", x)
+		}
+		if r.modules["m"] != r.modules["n"] {
+			t.Errorf("expected module to be loaded once\n")
+		}
+		if len(r.loader.cache) != 2 {
+			t.Errorf("expected 2 cached modules, found %d\n", len(r.loader.cache))
+		}
+	})
+
+	t.Run("import cycle", func(t *testing.T) {
+		r := setup(`
+try {
+  import "` + filepath.Join(dir, "a.klang") + `" as a;
+} catch (e) {
+  msg = e.message;
+}
+`)
+		msg, ok := r.values["msg"]
+		if !ok || !strings.Contains(msg.value.(string), "import cycle") {
+			t.Errorf("expected import cycle error, found %+v\n", r.Values())
+		}
+		if _, ok := r.modules["a"]; ok {
+			t.Errorf("module with cycle should not be imported\n")
+		}
+	})
+
+	t.Run("error thrown in module function", func(t *testing.T) {
+		r := setup(`
+import "` + filepath.Join(dir, "fail.klang") + `" as f;
+try {
+  f.fail();
+} catch (e) {
+  msg = e.message;
+}
+`)
+		msg := r.values["msg"]
+		if msg.dataType != STRING || msg.value != "module failure" {
+			t.Errorf("expected module failure, found %+v\n", r.Values())
+		}
+	})
+
+	t.Run("timeout around module function", func(t *testing.T) {
+		r := setup(`
+import "` + filepath.Join(dir, "spin.klang") + `" as s;
+try {
+  timeout 50ms {
+    s.spin();
+  }
+} catch (e) {
+  msg = e.message;
+}
+`)
+		msg := r.values["msg"]
+		if msg.dataType != STRING || msg.value != "timeout 50ms exceeded" {
+			t.Errorf("expected timeout error, found %+v\n", r.Values())
+		}
+	})
+
+	t.Run("timeout around import", func(t *testing.T) {
+		r := setup(`
+try {
+  timeout 50ms {
+    import "` + filepath.Join(dir, "slow.klang") + `" as slow;
+  }
+} catch (e) {
+  msg = e.message;
+}
+`)
+		msg, ok := r.values["msg"]
+		if !ok || !strings.Contains(msg.value.(string), "timeout 50ms exceeded") {
+			t.Errorf("expected timeout error, found %+v\n", r.Values())
+		}
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		tree, errs := Parse(`
+import "` + filepath.Join(dir, "cleanup.klang") + `" as c;
+r = c.remove();
+`)
+		if len(errs) != 0 {
+			t.Fatal(JoinSyntaxErrors(errs))
+		}
+		l := NewKlangListener(nil)
+		l.SetDryRun(true)
+		var out bytes.Buffer
+		l.SetOutput(&out)
+		l.Run(tree)
+		if l.Err() != nil {
+			t.Fatal(l.Err())
+		}
+		if r := l.values["r"]; r.dataType != BOOLEAN || r.value != true {
+			t.Errorf("expected true, found %+v\n", r)
+		}
+		if expected := "dry run: kubectl delete ns/old\ndry run: kubectl delete ns/apps\n"; out.String() != expected {
+			t.Errorf("expected %q, found %q\n", expected, out.String())
+		}
+	})
+
+	t.Run("module with syntax errors", func(t *testing.T) {
+		r := setup(`
+try {
+  import "` + filepath.Join(dir, "broken.klang") + `" as broken;
+} catch (e) {
+  msg = e.message;
+}
+`)
+		msg, ok := r.values["msg"]
+		if !ok || !strings.Contains(msg.value.(string), "syntax errors in module") || !strings.Contains(msg.value.(string), "line 3:") {
+			t.Errorf("expected syntax error, found %+v\n", r.Values())
+		}
+		if _, ok := r.modules["broken"]; ok {
+			t.Errorf("module with syntax errors should not be imported\n")
+		}
+	})
+
+	t.Run("missing module aborts", func(t *testing.T) {
+		r := setup(`
+import "` + filepath.Join(dir, "missing.klang") + `" as missing;
+x = 1;
+`)
+		if _, ok := r.modules["missing"]; ok {
+			t.Errorf("missing module should not be imported\n")
+		}
+		if r.Err() == nil {
+			t.Errorf("expected script to be aborted, found %+v\n", r.Values())
+		}
+		if _, ok := r.values["x"]; ok {
+			t.Errorf("statement after failed import was executed\n")
+		}
+	})
+}
+
+func Test_resolveLocation(t *testing.T) {
+	tests := []struct {
+		base     string
+		location string
+		want     string
+	}{
+		{"", "lib.klang", "lib.klang"},
+		{"/scripts/main.klang", "lib.klang", "/scripts/lib.klang"},
+		{"/scripts/main.klang", "/other/lib.klang", "/other/lib.klang"},
+		{"https://example.com/bom/main.klang", "lib.klang", "https://example.com/bom/lib.klang"},
+		{"/scripts/main.klang", "https://example.com/lib.klang", "https://example.com/lib.klang"},
+	}
+	for _, tt := range tests {
+		if got := resolveLocation(tt.base, tt.location); got != tt.want {
+			t.Errorf("resolveLocation(%q, %q) = %q, want %q", tt.base, tt.location, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+	"io"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/util/jsonpath"
+	"regexp"
+	"strings"
+)
+
+const (
+	outputJson          = "json"
+	outputYaml          = "yaml"
+	outputName          = "name"
+	outputJsonPath      = "jsonpath"
+	outputCustomColumns = "custom-columns"
+)
+
+var relaxedJsonPath = regexp.MustCompile(`^\{\.?([^{}]+)\}$|^\.?([^{}]+)$`)
+
+// outputFormatOf returns the last output format of kubectl get. A format written without quotes
+// which is not known, like -o format, is read from the variable of that name.
+func (l *KlangListener) outputFormatOf(outputs []parser.IOutputContext) string {
+	if len(outputs) == 0 {
+		return outputJson
+	}
+	oc := outputs[len(outputs)-1].(*parser.OutputContext)
+	switch {
+	case oc.OUTPUTFORMAT() != nil:
+		format := flagValue(oc.OUTPUTFORMAT().GetText())
+		if !strings.Contains(format, "=") && !isOutputFormat(format) {
+			if val, ok := l.getValue(format); ok && val.dataType == STRING {
+				return val.value.(string)
+			}
+		}
+		return format
+	case oc.OUTPUT() != nil:
+		return l.GetTextFromStringOrId(oc.String_or_id().(*parser.String_or_idContext))
+	case oc.JSONPATH() != nil:
+		return outputJsonPath + "=" + l.GetTextFromStringOrId(oc.String_or_id().(*parser.String_or_idContext))
+	}
+	return outputJson
+}
+
+func isOutputFormat(format string) bool {
+	switch format {
+	case outputJson, outputYaml, outputName, outputJsonPath, outputCustomColumns:
+		return true
+	}
+	return false
+}
+
+// formatOutput converts the json returned by kubectl get to the output format the same way kubectl get -o does
+func formatOutput(manifest string, format string) (string, error) {
+	name, spec := format, ""
+	if i := strings.Index(format, "="); i >= 0 {
+		name, spec = format[:i], format[i+1:]
+		if len(spec) != 0 {
+			spec = StripQuotes(spec)
+		}
+	}
+	if name == outputJson && len(spec) == 0 {
+		return manifest, nil
+	}
+	obj, _, err := unstructured.UnstructuredJSONScheme.Decode([]byte(manifest), nil, nil)
+	if err != nil {
+		return "", err
+	}
+	var printer printers.ResourcePrinter
+	switch name {
+	case outputYaml:
+		printer = &printers.YAMLPrinter{}
+	case outputName:
+		printer = &printers.NamePrinter{}
+	case outputJsonPath:
+		if len(spec) == 0 {
+			return "", fmt.Errorf("template format specified but no template given")
+		}
+		p, err := printers.NewJSONPathPrinter(spec)
+		if err != nil {
+			return "", fmt.Errorf("error parsing jsonpath %s, %v", spec, err)
+		}
+		p.AllowMissingKeys(true)
+		printer = p
+	case outputCustomColumns:
+		p, err := newCustomColumnsPrinter(spec)
+		if err != nil {
+			return "", err
+		}
+		printer = p
+	default:
+		return "", fmt.Errorf("unsupported output format %q, expected one of json, yaml, name, jsonpath=<template> or custom-columns=<spec>", format)
+	}
+	buf := &bytes.Buffer{}
+	if err := printer.PrintObj(obj, buf); err != nil {
+		return "", err
+	}
+	out := buf.String()
+	if name == outputName || name == outputCustomColumns {
+		out = strings.TrimSuffix(out, "\n")
+	}
+	return out, nil
+}
+
+// newCustomColumnsPrinter prints a column for each <header>:<json-path-expr> of spec, values not found are printed as <none>
+func newCustomColumnsPrinter(spec string) (printers.ResourcePrinter, error) {
+	if len(spec) == 0 {
+		return nil, fmt.Errorf("custom-columns format specified but no custom columns given")
+	}
+	var headers []string
+	var columns []*jsonpath.JSONPath
+	for _, column := range strings.Split(spec, ",") {
+		parts := strings.SplitN(column, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("unexpected custom-columns spec: %s, expected <header>:<json-path-expr>", column)
+		}
+		submatches := relaxedJsonPath.FindStringSubmatch(parts[1])
+		if submatches == nil {
+			return nil, fmt.Errorf("unexpected path string %s, expected a 'name1.name2' or '.name1.name2' or '{name1.name2}' or '{.name1.name2}'", parts[1])
+		}
+		fieldSpec := submatches[1]
+		if len(fieldSpec) == 0 {
+			fieldSpec = submatches[2]
+		}
+		j := jsonpath.New(parts[0]).AllowMissingKeys(true)
+		if err := j.Parse(fmt.Sprintf("{.%s}", fieldSpec)); err != nil {
+			return nil, err
+		}
+		headers = append(headers, parts[0])
+		columns = append(columns, j)
+	}
+	return printers.ResourcePrinterFunc(func(obj runtime.Object, w io.Writer) error {
+		items := []runtime.Object{obj}
+		if meta.IsListType(obj) {
+			var err error
+			if items, err = meta.ExtractList(obj); err != nil {
+				return err
+			}
+		}
+		tw := printers.GetNewTabWriter(w)
+		fmt.Fprintln(tw, strings.Join(headers, "\t"))
+		for _, item := range items {
+			content := item.(runtime.Unstructured).UnstructuredContent()
+			var row []string
+			for _, column := range columns {
+				results, err := column.FindResults(content)
+				if err != nil {
+					return err
+				}
+				var values []string
+				for _, result := range results {
+					for _, value := range result {
+						values = append(values, fmt.Sprintf("%v", value.Interface()))
+					}
+				}
+				if len(values) == 0 {
+					values = append(values, "<none>")
+				}
+				row = append(row, strings.Join(values, ","))
+			}
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		return tw.Flush()
+	}), nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	parser2 "github.com/devtron-labs/inception/pkg/language/parser"
+	"testing"
+)
+
+func Test_formatOutput(t *testing.T) {
+	deployment := `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"web","namespace":"apps","labels":{"app":"web"}},"spec":{"replicas":2},"status":{"readyReplicas":2}}`
+	service := `{"apiVersion":"v1","kind":"Service","metadata":{"name":"web","namespace":"apps"},"spec":{"clusterIP":"10.0.0.1"}}`
+	list := `{"apiVersion": "v1",    "items": [` + deployment + `,` + service + `], "kind": "List", "metadata": { "resourceVersion": "", "selfLink": "" }}`
+	tests := []struct {
+		name     string
+		manifest string
+		format   string
+		want     string
+		wantErr  bool
+	}{
+		{name: "json", manifest: deployment, format: "json", want: deployment},
+		{name: "yaml", manifest: service, format: "yaml", want: "apiVersion: v1\nkind: Service\nmetadata:\n  name: web\n  namespace: apps\nspec:\n  clusterIP: 10.0.0.1\n"},
+		{name: "name", manifest: deployment, format: "name", want: "deployment.apps/web"},
+		{name: "name of list", manifest: list, format: "name", want: "deployment.apps/web\nservice/web"},
+		{name: "jsonpath", manifest: deployment, format: "jsonpath={.status.readyReplicas}", want: "2"},
+		{name: "quoted jsonpath", manifest: deployment, format: "jsonpath='{.metadata.labels.app}'", want: "web"},
+		{name: "jsonpath of list", manifest: list, format: `jsonpath={range .items[*]}{.kind}:{.metadata.name} {end}`, want: "Deployment:web Service:web "},
+		{name: "jsonpath filter", manifest: list, format: `jsonpath={.items[?(@.kind=="Service")].spec.clusterIP}`, want: "10.0.0.1"},
+		{name: "jsonpath missing key", manifest: deployment, format: "jsonpath={.status.availableReplicas}", want: ""},
+		{name: "custom columns", manifest: list, format: "custom-columns=KIND:.kind,NAME:{.metadata.name},READY:status.readyReplicas", want: "KIND         NAME   READY\nDeployment   web    2\nService      web    <none>"},
+		{name: "invalid jsonpath", manifest: deployment, format: "jsonpath={.status", wantErr: true},
+		{name: "invalid custom columns", manifest: deployment, format: "custom-columns=NAME", wantErr: true},
+		{name: "unsupported", manifest: deployment, format: "wide", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := formatOutput(tt.manifest, tt.format)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("formatOutput() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("formatOutput() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKlangListener_outputFormatOf(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: `kubectl get deploy/web`, want: "json"},
+		{input: `kubectl get deploy/web -o yaml`, want: "yaml"},
+		{input: `kubectl get deploy -o=name -n apps`, want: "name"},
+		{input: `kubectl get deploy/web -o jsonpath='{.spec.template.spec.containers[0].image}'`, want: "jsonpath='{.spec.template.spec.containers[0].image}'"},
+		{input: `kubectl get deploy/web -o jsonpath="{range .items[*]}{.metadata.name} {end}"`, want: `jsonpath="{range .items[*]}{.metadata.name} {end}"`},
+		{input: `kubectl get pods --output custom-columns=NAME:.metadata.name,NODE:.spec.nodeName`, want: "custom-columns=NAME:.metadata.name,NODE:.spec.nodeName"},
+		{input: `kubectl get deploy/web -o "jsonpath={.status.replicas}"`, want: "jsonpath={.status.replicas}"},
+		{input: `kubectl get deploy/web -o format`, want: "name"},
+		{input: `kubectl get deploy/web -jsonpath '{.status.replicas}'`, want: "jsonpath={.status.replicas}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			p := parser2.NewKlangParser(antlr.NewCommonTokenStream(parser2.NewKlangLexer(antlr.NewInputStream(tt.input)), antlr.TokenDefaultChannel))
+			l := NewKlangListener(nil)
+			l.values["format"] = newStringValHolder("name")
+			v, ok := p.Kubectl_command().(*parser2.GetKubectlCommandContext)
+			if !ok {
+				t.Fatalf("expected a kubectl get command")
+			}
+			if got := l.outputFormatOf(v.AllOutput()); got != tt.want {
+				t.Errorf("outputFormatOf() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKlangListener_subtractionIsNotOutput(t *testing.T) {
+	r := setup(`
+total = 10;
+offset = 3;
+o2 = 4;
+x = total -offset;
+y = -offset -o2;
+o = 2;
+a = 10 -o;
+b = total -o -o;
+`)
+	expected := map[string]int64{"x": 7, "y": -7, "a": 8, "b": 6}
+	for k, v := range expected {
+		if found := r.values[k]; found.dataType != INT || found.value != v {
+			t.Errorf("expected %s = %d, found %+v", k, v, found)
+		}
+	}
+}
@@ -134,12 +134,96 @@ func (s *BaseKlangListener) EnterWhile_stat(ctx *While_statContext) {}
 // ExitWhile_stat is called when production while_stat is exited.
 func (s *BaseKlangListener) ExitWhile_stat(ctx *While_statContext) {}
 
+// EnterFor_stat is called when production for_stat is entered.
+func (s *BaseKlangListener) EnterFor_stat(ctx *For_statContext) {}
+
+// ExitFor_stat is called when production for_stat is exited.
+func (s *BaseKlangListener) ExitFor_stat(ctx *For_statContext) {}
+
 // EnterLog is called when production log is entered.
 func (s *BaseKlangListener) EnterLog(ctx *LogContext) {}
 
 // ExitLog is called when production log is exited.
 func (s *BaseKlangListener) ExitLog(ctx *LogContext) {}
 
+// EnterFunc_decl is called when production func_decl is entered.
+func (s *BaseKlangListener) EnterFunc_decl(ctx *Func_declContext) {}
+
+// ExitFunc_decl is called when production func_decl is exited.
+func (s *BaseKlangListener) ExitFunc_decl(ctx *Func_declContext) {}
+
+// EnterParam_list is called when production param_list is entered.
+func (s *BaseKlangListener) EnterParam_list(ctx *Param_listContext) {}
+
+// ExitParam_list is called when production param_list is exited.
+func (s *BaseKlangListener) ExitParam_list(ctx *Param_listContext) {}
+
+// EnterReturn_stat is called when production return_stat is entered.
+func (s *BaseKlangListener) EnterReturn_stat(ctx *Return_statContext) {}
+
+// ExitReturn_stat is called when production return_stat is exited.
+func (s *BaseKlangListener) ExitReturn_stat(ctx *Return_statContext) {}
+
+// EnterBreak_stat is called when production break_stat is entered.
+func (s *BaseKlangListener) EnterBreak_stat(ctx *Break_statContext) {}
+
+// ExitBreak_stat is called when production break_stat is exited.
+func (s *BaseKlangListener) ExitBreak_stat(ctx *Break_statContext) {}
+
+// EnterCall_stat is called when production call_stat is entered.
+func (s *BaseKlangListener) EnterCall_stat(ctx *Call_statContext) {}
+
+// ExitCall_stat is called when production call_stat is exited.
+func (s *BaseKlangListener) ExitCall_stat(ctx *Call_statContext) {}
+
+// EnterTry_stat is called when production try_stat is entered.
+func (s *BaseKlangListener) EnterTry_stat(ctx *Try_statContext) {}
+
+// ExitTry_stat is called when production try_stat is exited.
+func (s *BaseKlangListener) ExitTry_stat(ctx *Try_statContext) {}
+
+// EnterCatch_clause is called when production catch_clause is entered.
+func (s *BaseKlangListener) EnterCatch_clause(ctx *Catch_clauseContext) {}
+
+// ExitCatch_clause is called when production catch_clause is exited.
+func (s *BaseKlangListener) ExitCatch_clause(ctx *Catch_clauseContext) {}
+
+// EnterFinally_clause is called when production finally_clause is entered.
+func (s *BaseKlangListener) EnterFinally_clause(ctx *Finally_clauseContext) {}
+
+// ExitFinally_clause is called when production finally_clause is exited.
+func (s *BaseKlangListener) ExitFinally_clause(ctx *Finally_clauseContext) {}
+
+// EnterThrow_stat is called when production throw_stat is entered.
+func (s *BaseKlangListener) EnterThrow_stat(ctx *Throw_statContext) {}
+
+// ExitThrow_stat is called when production throw_stat is exited.
+func (s *BaseKlangListener) ExitThrow_stat(ctx *Throw_statContext) {}
+
+// EnterImport_stat is called when production import_stat is entered.
+func (s *BaseKlangListener) EnterImport_stat(ctx *Import_statContext) {}
+
+// ExitImport_stat is called when production import_stat is exited.
+func (s *BaseKlangListener) ExitImport_stat(ctx *Import_statContext) {}
+
+// EnterTimeout_stat is called when production timeout_stat is entered.
+func (s *BaseKlangListener) EnterTimeout_stat(ctx *Timeout_statContext) {}
+
+// ExitTimeout_stat is called when production timeout_stat is exited.
+func (s *BaseKlangListener) ExitTimeout_stat(ctx *Timeout_statContext) {}
+
+// EnterFunc_call is called when production func_call is entered.
+func (s *BaseKlangListener) EnterFunc_call(ctx *Func_callContext) {}
+
+// ExitFunc_call is called when production func_call is exited.
+func (s *BaseKlangListener) ExitFunc_call(ctx *Func_callContext) {}
+
+// EnterFunc_name is called when production func_name is entered.
+func (s *BaseKlangListener) EnterFunc_name(ctx *Func_nameContext) {}
+
+// ExitFunc_name is called when production func_name is exited.
+func (s *BaseKlangListener) ExitFunc_name(ctx *Func_nameContext) {}
+
 // EnterApplyKubectlCommand is called when production applyKubectlCommand is entered.
 func (s *BaseKlangListener) EnterApplyKubectlCommand(ctx *ApplyKubectlCommandContext) {}
 
@@ -158,12 +242,42 @@ func (s *BaseKlangListener) EnterGetKubectlCommand(ctx *GetKubectlCommandContext
 // ExitGetKubectlCommand is called when production getKubectlCommand is exited.
 func (s *BaseKlangListener) ExitGetKubectlCommand(ctx *GetKubectlCommandContext) {}
 
+// EnterWaitKubectlCommand is called when production waitKubectlCommand is entered.
+func (s *BaseKlangListener) EnterWaitKubectlCommand(ctx *WaitKubectlCommandContext) {}
+
+// ExitWaitKubectlCommand is called when production waitKubectlCommand is exited.
+func (s *BaseKlangListener) ExitWaitKubectlCommand(ctx *WaitKubectlCommandContext) {}
+
 // EnterDeleteKubectlCommand is called when production deleteKubectlCommand is entered.
 func (s *BaseKlangListener) EnterDeleteKubectlCommand(ctx *DeleteKubectlCommandContext) {}
 
 // ExitDeleteKubectlCommand is called when production deleteKubectlCommand is exited.
 func (s *BaseKlangListener) ExitDeleteKubectlCommand(ctx *DeleteKubectlCommandContext) {}
 
+// EnterCreateKubectlCommand is called when production createKubectlCommand is entered.
+func (s *BaseKlangListener) EnterCreateKubectlCommand(ctx *CreateKubectlCommandContext) {}
+
+// ExitCreateKubectlCommand is called when production createKubectlCommand is exited.
+func (s *BaseKlangListener) ExitCreateKubectlCommand(ctx *CreateKubectlCommandContext) {}
+
+// EnterReplaceKubectlCommand is called when production replaceKubectlCommand is entered.
+func (s *BaseKlangListener) EnterReplaceKubectlCommand(ctx *ReplaceKubectlCommandContext) {}
+
+// ExitReplaceKubectlCommand is called when production replaceKubectlCommand is exited.
+func (s *BaseKlangListener) ExitReplaceKubectlCommand(ctx *ReplaceKubectlCommandContext) {}
+
+// EnterScaleKubectlCommand is called when production scaleKubectlCommand is entered.
+func (s *BaseKlangListener) EnterScaleKubectlCommand(ctx *ScaleKubectlCommandContext) {}
+
+// ExitScaleKubectlCommand is called when production scaleKubectlCommand is exited.
+func (s *BaseKlangListener) ExitScaleKubectlCommand(ctx *ScaleKubectlCommandContext) {}
+
+// EnterRolloutKubectlCommand is called when production rolloutKubectlCommand is entered.
+func (s *BaseKlangListener) EnterRolloutKubectlCommand(ctx *RolloutKubectlCommandContext) {}
+
+// ExitRolloutKubectlCommand is called when production rolloutKubectlCommand is exited.
+func (s *BaseKlangListener) ExitRolloutKubectlCommand(ctx *RolloutKubectlCommandContext) {}
+
 // EnterDownload_fn is called when production download_fn is entered.
 func (s *BaseKlangListener) EnterDownload_fn(ctx *Download_fnContext) {}
 
@@ -200,6 +314,18 @@ func (s *BaseKlangListener) EnterNs(ctx *NsContext) {}
 // ExitNs is called when production ns is exited.
 func (s *BaseKlangListener) ExitNs(ctx *NsContext) {}
 
+// EnterOutput is called when production output is entered.
+func (s *BaseKlangListener) EnterOutput(ctx *OutputContext) {}
+
+// ExitOutput is called when production output is exited.
+func (s *BaseKlangListener) ExitOutput(ctx *OutputContext) {}
+
+// EnterSelector is called when production selector is entered.
+func (s *BaseKlangListener) EnterSelector(ctx *SelectorContext) {}
+
+// ExitSelector is called when production selector is exited.
+func (s *BaseKlangListener) ExitSelector(ctx *SelectorContext) {}
+
 // EnterAsObject is called when production asObject is entered.
 func (s *BaseKlangListener) EnterAsObject(ctx *AsObjectContext) {}
 
@@ -254,6 +380,12 @@ func (s *BaseKlangListener) EnterShellScript(ctx *ShellScriptContext) {}
 // ExitShellScript is called when production shellScript is exited.
 func (s *BaseKlangListener) ExitShellScript(ctx *ShellScriptContext) {}
 
+// EnterMemberExpr is called when production memberExpr is entered.
+func (s *BaseKlangListener) EnterMemberExpr(ctx *MemberExprContext) {}
+
+// ExitMemberExpr is called when production memberExpr is exited.
+func (s *BaseKlangListener) ExitMemberExpr(ctx *MemberExprContext) {}
+
 // EnterYamlSelectFn is called when production yamlSelectFn is entered.
 func (s *BaseKlangListener) EnterYamlSelectFn(ctx *YamlSelectFnContext) {}
 
@@ -272,6 +404,12 @@ func (s *BaseKlangListener) EnterOrExpr(ctx *OrExprContext) {}
 // ExitOrExpr is called when production orExpr is exited.
 func (s *BaseKlangListener) ExitOrExpr(ctx *OrExprContext) {}
 
+// EnterFuncCallExpr is called when production funcCallExpr is entered.
+func (s *BaseKlangListener) EnterFuncCallExpr(ctx *FuncCallExprContext) {}
+
+// ExitFuncCallExpr is called when production funcCallExpr is exited.
+func (s *BaseKlangListener) ExitFuncCallExpr(ctx *FuncCallExprContext) {}
+
 // EnterAdditiveExpr is called when production additiveExpr is entered.
 func (s *BaseKlangListener) EnterAdditiveExpr(ctx *AdditiveExprContext) {}
 
@@ -284,6 +422,12 @@ func (s *BaseKlangListener) EnterRelationalExpr(ctx *RelationalExprContext) {}
 // ExitRelationalExpr is called when production relationalExpr is exited.
 func (s *BaseKlangListener) ExitRelationalExpr(ctx *RelationalExprContext) {}
 
+// EnterIndexExpr is called when production indexExpr is entered.
+func (s *BaseKlangListener) EnterIndexExpr(ctx *IndexExprContext) {}
+
+// ExitIndexExpr is called when production indexExpr is exited.
+func (s *BaseKlangListener) ExitIndexExpr(ctx *IndexExprContext) {}
+
 // EnterNotExpr is called when production notExpr is entered.
 func (s *BaseKlangListener) EnterNotExpr(ctx *NotExprContext) {}
 
@@ -344,6 +488,12 @@ func (s *BaseKlangListener) EnterNumberAtom(ctx *NumberAtomContext) {}
 // ExitNumberAtom is called when production numberAtom is exited.
 func (s *BaseKlangListener) ExitNumberAtom(ctx *NumberAtomContext) {}
 
+// EnterDurationAtom is called when production durationAtom is entered.
+func (s *BaseKlangListener) EnterDurationAtom(ctx *DurationAtomContext) {}
+
+// ExitDurationAtom is called when production durationAtom is exited.
+func (s *BaseKlangListener) ExitDurationAtom(ctx *DurationAtomContext) {}
+
 // EnterBooleanAtom is called when production booleanAtom is entered.
 func (s *BaseKlangListener) EnterBooleanAtom(ctx *BooleanAtomContext) {}
 
@@ -368,18 +518,54 @@ func (s *BaseKlangListener) EnterStringAtom(ctx *StringAtomContext) {}
 // ExitStringAtom is called when production stringAtom is exited.
 func (s *BaseKlangListener) ExitStringAtom(ctx *StringAtomContext) {}
 
+// EnterListAtom is called when production listAtom is entered.
+func (s *BaseKlangListener) EnterListAtom(ctx *ListAtomContext) {}
+
+// ExitListAtom is called when production listAtom is exited.
+func (s *BaseKlangListener) ExitListAtom(ctx *ListAtomContext) {}
+
+// EnterMapAtom is called when production mapAtom is entered.
+func (s *BaseKlangListener) EnterMapAtom(ctx *MapAtomContext) {}
+
+// ExitMapAtom is called when production mapAtom is exited.
+func (s *BaseKlangListener) ExitMapAtom(ctx *MapAtomContext) {}
+
 // EnterJsonAtom is called when production jsonAtom is entered.
 func (s *BaseKlangListener) EnterJsonAtom(ctx *JsonAtomContext) {}
 
 // ExitJsonAtom is called when production jsonAtom is exited.
 func (s *BaseKlangListener) ExitJsonAtom(ctx *JsonAtomContext) {}
 
+// EnterPathAtom is called when production pathAtom is entered.
+func (s *BaseKlangListener) EnterPathAtom(ctx *PathAtomContext) {}
+
+// ExitPathAtom is called when production pathAtom is exited.
+func (s *BaseKlangListener) ExitPathAtom(ctx *PathAtomContext) {}
+
 // EnterNilAtom is called when production nilAtom is entered.
 func (s *BaseKlangListener) EnterNilAtom(ctx *NilAtomContext) {}
 
 // ExitNilAtom is called when production nilAtom is exited.
 func (s *BaseKlangListener) ExitNilAtom(ctx *NilAtomContext) {}
 
+// EnterList_literal is called when production list_literal is entered.
+func (s *BaseKlangListener) EnterList_literal(ctx *List_literalContext) {}
+
+// ExitList_literal is called when production list_literal is exited.
+func (s *BaseKlangListener) ExitList_literal(ctx *List_literalContext) {}
+
+// EnterMap_literal is called when production map_literal is entered.
+func (s *BaseKlangListener) EnterMap_literal(ctx *Map_literalContext) {}
+
+// ExitMap_literal is called when production map_literal is exited.
+func (s *BaseKlangListener) ExitMap_literal(ctx *Map_literalContext) {}
+
+// EnterMap_entry is called when production map_entry is entered.
+func (s *BaseKlangListener) EnterMap_entry(ctx *Map_entryContext) {}
+
+// ExitMap_entry is called when production map_entry is exited.
+func (s *BaseKlangListener) ExitMap_entry(ctx *Map_entryContext) {}
+
 // EnterJson is called when production json is entered.
 func (s *BaseKlangListener) EnterJson(ctx *JsonContext) {}
 
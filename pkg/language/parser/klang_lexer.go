@@ -14,7 +14,7 @@ var _ = fmt.Printf
 var _ = unicode.IsLetter
 
 var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 73, 624,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 104, 1046,
 	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
 	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
 	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
@@ -29,268 +29,466 @@ var serializedLexerAtn = []uint16{
 	60, 9, 60, 4, 61, 9, 61, 4, 62, 9, 62, 4, 63, 9, 63, 4, 64, 9, 64, 4, 65,
 	9, 65, 4, 66, 9, 66, 4, 67, 9, 67, 4, 68, 9, 68, 4, 69, 9, 69, 4, 70, 9,
 	70, 4, 71, 9, 71, 4, 72, 9, 72, 4, 73, 9, 73, 4, 74, 9, 74, 4, 75, 9, 75,
-	4, 76, 9, 76, 4, 77, 9, 77, 4, 78, 9, 78, 4, 79, 9, 79, 4, 80, 9, 80, 3,
-	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 3, 3,
-	3, 3, 4, 3, 4, 3, 5, 3, 5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3,
-	7, 3, 8, 3, 8, 3, 8, 3, 9, 3, 9, 3, 9, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11,
-	3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3, 14, 3, 15, 3, 15, 3,
-	16, 3, 16, 3, 17, 3, 17, 3, 18, 3, 18, 3, 19, 3, 19, 3, 20, 3, 20, 3, 21,
-	3, 21, 3, 22, 3, 22, 3, 23, 3, 23, 3, 24, 3, 24, 3, 25, 3, 25, 3, 26, 3,
-	26, 3, 27, 3, 27, 3, 28, 3, 28, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 30,
-	3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 31, 3, 31, 3, 31, 3, 31, 3, 32, 3,
-	32, 3, 32, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 34, 3, 34, 3, 34, 3, 34,
-	3, 34, 3, 34, 3, 35, 3, 35, 3, 35, 3, 35, 3, 36, 3, 36, 3, 36, 3, 36, 3,
-	36, 3, 36, 3, 36, 3, 36, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 38,
-	3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 39, 3, 39, 3, 39, 3, 39, 3, 40, 3,
-	40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 41, 3, 41, 3, 41, 3, 41,
-	3, 41, 3, 41, 3, 41, 3, 42, 3, 42, 3, 42, 3, 43, 3, 43, 3, 43, 3, 43, 3,
-	43, 3, 43, 3, 43, 3, 44, 3, 44, 3, 44, 3, 45, 3, 45, 3, 45, 3, 46, 3, 46,
-	3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 47, 3, 47, 3,
-	47, 3, 47, 3, 47, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 49, 3, 49, 3, 49,
-	3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 50, 3, 50, 3,
-	50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 51, 3, 51, 3, 51, 3, 51,
-	3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3, 52, 3, 52, 3, 52, 3,
-	52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 53, 3, 53, 3, 53,
-	3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 54, 3, 54, 3, 54, 3, 54, 3,
-	54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 55, 3, 55, 3, 55, 3, 55,
-	3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 56, 3,
-	56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56,
-	3, 56, 3, 56, 3, 56, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3,
-	57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58,
+	4, 76, 9, 76, 4, 77, 9, 77, 4, 78, 9, 78, 4, 79, 9, 79, 4, 80, 9, 80, 4,
+	81, 9, 81, 4, 82, 9, 82, 4, 83, 9, 83, 4, 84, 9, 84, 4, 85, 9, 85, 4, 86,
+	9, 86, 4, 87, 9, 87, 4, 88, 9, 88, 4, 89, 9, 89, 4, 90, 9, 90, 4, 91, 9,
+	91, 4, 92, 9, 92, 4, 93, 9, 93, 4, 94, 9, 94, 4, 95, 9, 95, 4, 96, 9, 96,
+	4, 97, 9, 97, 4, 98, 9, 98, 4, 99, 9, 99, 4, 100, 9, 100, 4, 101, 9, 101,
+	4, 102, 9, 102, 4, 103, 9, 103, 4, 104, 9, 104, 4, 105, 9, 105, 4, 106,
+	9, 106, 4, 107, 9, 107, 4, 108, 9, 108, 4, 109, 9, 109, 4, 110, 9, 110,
+	4, 111, 9, 111, 4, 112, 9, 112, 4, 113, 9, 113, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3, 5,
+	3, 5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8,
+	3, 9, 3, 9, 3, 9, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11, 3, 12, 3, 12, 3, 13,
+	3, 13, 3, 13, 3, 14, 3, 14, 3, 14, 3, 15, 3, 15, 3, 16, 3, 16, 3, 17, 3,
+	17, 3, 18, 3, 18, 3, 19, 3, 19, 3, 20, 3, 20, 3, 21, 3, 21, 3, 22, 3, 22,
+	3, 23, 3, 23, 3, 24, 3, 24, 3, 25, 3, 25, 3, 26, 3, 26, 3, 27, 3, 27, 3,
+	28, 3, 28, 3, 29, 3, 29, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 31, 3, 31,
+	3, 31, 3, 31, 3, 31, 3, 31, 3, 32, 3, 32, 3, 32, 3, 32, 3, 33, 3, 33, 3,
+	33, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35,
+	3, 35, 3, 36, 3, 36, 3, 36, 3, 36, 3, 37, 3, 37, 3, 37, 3, 38, 3, 38, 3,
+	38, 3, 38, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 40,
+	3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3,
+	41, 3, 42, 3, 42, 3, 42, 3, 42, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43,
+	3, 43, 3, 43, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 45, 3,
+	45, 3, 45, 3, 45, 3, 45, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46,
+	3, 46, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 48, 3, 48, 3,
+	48, 3, 48, 3, 48, 3, 48, 3, 49, 3, 49, 3, 49, 3, 50, 3, 50, 3, 50, 3, 50,
+	3, 50, 3, 50, 3, 50, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3,
+	51, 6, 51, 425, 10, 51, 13, 51, 14, 51, 426, 3, 52, 3, 52, 3, 52, 3, 52,
+	3, 52, 3, 52, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3,
+	53, 3, 53, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54,
+	3, 54, 3, 54, 3, 54, 5, 54, 457, 10, 54, 3, 54, 6, 54, 460, 10, 54, 13,
+	54, 14, 54, 461, 3, 54, 5, 54, 465, 10, 54, 3, 54, 3, 54, 3, 54, 3, 55,
+	3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3,
+	55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 6, 55, 488, 10, 55, 13, 55,
+	14, 55, 489, 3, 55, 5, 55, 493, 10, 55, 3, 55, 3, 55, 3, 56, 3, 56, 3,
+	56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 5, 56,
+	509, 10, 56, 3, 56, 3, 56, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3,
+	57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57,
 	3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3,
-	59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59,
-	3, 59, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3,
-	61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62,
-	3, 62, 3, 62, 3, 62, 3, 62, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3,
-	63, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 65, 3, 65,
-	7, 65, 513, 10, 65, 12, 65, 14, 65, 516, 11, 65, 3, 66, 5, 66, 519, 10,
-	66, 3, 66, 3, 66, 3, 66, 6, 66, 524, 10, 66, 13, 66, 14, 66, 525, 5, 66,
-	528, 10, 66, 3, 66, 5, 66, 531, 10, 66, 3, 67, 3, 67, 5, 67, 535, 10, 67,
-	3, 67, 3, 67, 3, 68, 3, 68, 3, 68, 7, 68, 542, 10, 68, 12, 68, 14, 68,
-	545, 11, 68, 5, 68, 547, 10, 68, 3, 69, 3, 69, 7, 69, 551, 10, 69, 12,
-	69, 14, 69, 554, 11, 69, 3, 70, 3, 70, 7, 70, 558, 10, 70, 12, 70, 14,
-	70, 561, 11, 70, 3, 70, 3, 70, 3, 71, 3, 71, 3, 71, 7, 71, 568, 10, 71,
-	12, 71, 14, 71, 571, 11, 71, 3, 71, 3, 71, 3, 71, 3, 71, 7, 71, 577, 10,
-	71, 12, 71, 14, 71, 580, 11, 71, 3, 71, 5, 71, 583, 10, 71, 3, 72, 3, 72,
-	3, 72, 5, 72, 588, 10, 72, 3, 73, 3, 73, 3, 74, 3, 74, 3, 74, 5, 74, 595,
-	10, 74, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 76, 3, 76, 3, 77,
-	3, 77, 3, 78, 3, 78, 7, 78, 609, 10, 78, 12, 78, 14, 78, 612, 11, 78, 3,
-	78, 3, 78, 3, 79, 6, 79, 617, 10, 79, 13, 79, 14, 79, 618, 3, 79, 3, 79,
-	3, 80, 3, 80, 2, 2, 81, 3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17,
-	10, 19, 11, 21, 12, 23, 13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35,
-	19, 37, 20, 39, 21, 41, 22, 43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 53,
-	28, 55, 29, 57, 30, 59, 31, 61, 32, 63, 33, 65, 34, 67, 35, 69, 36, 71,
-	37, 73, 38, 75, 39, 77, 40, 79, 41, 81, 42, 83, 43, 85, 44, 87, 45, 89,
-	46, 91, 47, 93, 48, 95, 49, 97, 50, 99, 51, 101, 52, 103, 53, 105, 54,
-	107, 55, 109, 56, 111, 57, 113, 58, 115, 59, 117, 60, 119, 61, 121, 62,
-	123, 63, 125, 64, 127, 65, 129, 66, 131, 67, 133, 2, 135, 2, 137, 68, 139,
-	69, 141, 70, 143, 2, 145, 2, 147, 2, 149, 2, 151, 2, 153, 2, 155, 71, 157,
-	72, 159, 73, 3, 2, 18, 5, 2, 67, 92, 97, 97, 99, 124, 6, 2, 50, 59, 67,
-	92, 97, 97, 99, 124, 3, 2, 50, 59, 4, 2, 71, 71, 103, 103, 4, 2, 45, 45,
-	47, 47, 3, 2, 51, 59, 4, 2, 67, 92, 99, 124, 7, 2, 47, 59, 67, 92, 94,
-	94, 97, 97, 99, 124, 3, 2, 98, 98, 10, 2, 41, 41, 49, 49, 94, 94, 100,
-	100, 104, 104, 112, 112, 116, 116, 118, 118, 5, 2, 2, 33, 41, 41, 94, 94,
-	10, 2, 36, 36, 49, 49, 94, 94, 100, 100, 104, 104, 112, 112, 116, 116,
-	118, 118, 5, 2, 50, 59, 67, 72, 99, 104, 5, 2, 2, 33, 36, 36, 94, 94, 4,
-	2, 12, 12, 15, 15, 5, 2, 11, 12, 15, 15, 34, 34, 2, 634, 2, 3, 3, 2, 2,
-	2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2,
-	2, 2, 13, 3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2,
-	2, 2, 2, 21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3,
-	2, 2, 2, 2, 29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35,
-	3, 2, 2, 2, 2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2,
-	43, 3, 2, 2, 2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2,
-	2, 51, 3, 2, 2, 2, 2, 53, 3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2,
-	2, 2, 59, 3, 2, 2, 2, 2, 61, 3, 2, 2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3, 2,
-	2, 2, 2, 67, 3, 2, 2, 2, 2, 69, 3, 2, 2, 2, 2, 71, 3, 2, 2, 2, 2, 73, 3,
-	2, 2, 2, 2, 75, 3, 2, 2, 2, 2, 77, 3, 2, 2, 2, 2, 79, 3, 2, 2, 2, 2, 81,
-	3, 2, 2, 2, 2, 83, 3, 2, 2, 2, 2, 85, 3, 2, 2, 2, 2, 87, 3, 2, 2, 2, 2,
-	89, 3, 2, 2, 2, 2, 91, 3, 2, 2, 2, 2, 93, 3, 2, 2, 2, 2, 95, 3, 2, 2, 2,
-	2, 97, 3, 2, 2, 2, 2, 99, 3, 2, 2, 2, 2, 101, 3, 2, 2, 2, 2, 103, 3, 2,
-	2, 2, 2, 105, 3, 2, 2, 2, 2, 107, 3, 2, 2, 2, 2, 109, 3, 2, 2, 2, 2, 111,
-	3, 2, 2, 2, 2, 113, 3, 2, 2, 2, 2, 115, 3, 2, 2, 2, 2, 117, 3, 2, 2, 2,
-	2, 119, 3, 2, 2, 2, 2, 121, 3, 2, 2, 2, 2, 123, 3, 2, 2, 2, 2, 125, 3,
-	2, 2, 2, 2, 127, 3, 2, 2, 2, 2, 129, 3, 2, 2, 2, 2, 131, 3, 2, 2, 2, 2,
-	137, 3, 2, 2, 2, 2, 139, 3, 2, 2, 2, 2, 141, 3, 2, 2, 2, 2, 155, 3, 2,
-	2, 2, 2, 157, 3, 2, 2, 2, 2, 159, 3, 2, 2, 2, 3, 161, 3, 2, 2, 2, 5, 172,
-	3, 2, 2, 2, 7, 174, 3, 2, 2, 2, 9, 176, 3, 2, 2, 2, 11, 178, 3, 2, 2, 2,
-	13, 183, 3, 2, 2, 2, 15, 186, 3, 2, 2, 2, 17, 189, 3, 2, 2, 2, 19, 192,
-	3, 2, 2, 2, 21, 195, 3, 2, 2, 2, 23, 197, 3, 2, 2, 2, 25, 199, 3, 2, 2,
-	2, 27, 202, 3, 2, 2, 2, 29, 205, 3, 2, 2, 2, 31, 207, 3, 2, 2, 2, 33, 209,
-	3, 2, 2, 2, 35, 211, 3, 2, 2, 2, 37, 213, 3, 2, 2, 2, 39, 215, 3, 2, 2,
-	2, 41, 217, 3, 2, 2, 2, 43, 219, 3, 2, 2, 2, 45, 221, 3, 2, 2, 2, 47, 223,
-	3, 2, 2, 2, 49, 225, 3, 2, 2, 2, 51, 227, 3, 2, 2, 2, 53, 229, 3, 2, 2,
-	2, 55, 231, 3, 2, 2, 2, 57, 233, 3, 2, 2, 2, 59, 238, 3, 2, 2, 2, 61, 244,
-	3, 2, 2, 2, 63, 248, 3, 2, 2, 2, 65, 251, 3, 2, 2, 2, 67, 256, 3, 2, 2,
-	2, 69, 262, 3, 2, 2, 2, 71, 266, 3, 2, 2, 2, 73, 274, 3, 2, 2, 2, 75, 280,
-	3, 2, 2, 2, 77, 286, 3, 2, 2, 2, 79, 290, 3, 2, 2, 2, 81, 298, 3, 2, 2,
-	2, 83, 305, 3, 2, 2, 2, 85, 308, 3, 2, 2, 2, 87, 315, 3, 2, 2, 2, 89, 318,
-	3, 2, 2, 2, 91, 321, 3, 2, 2, 2, 93, 331, 3, 2, 2, 2, 95, 336, 3, 2, 2,
-	2, 97, 341, 3, 2, 2, 2, 99, 352, 3, 2, 2, 2, 101, 361, 3, 2, 2, 2, 103,
-	372, 3, 2, 2, 2, 105, 383, 3, 2, 2, 2, 107, 392, 3, 2, 2, 2, 109, 403,
-	3, 2, 2, 2, 111, 416, 3, 2, 2, 2, 113, 431, 3, 2, 2, 2, 115, 444, 3, 2,
-	2, 2, 117, 459, 3, 2, 2, 2, 119, 471, 3, 2, 2, 2, 121, 480, 3, 2, 2, 2,
-	123, 486, 3, 2, 2, 2, 125, 495, 3, 2, 2, 2, 127, 502, 3, 2, 2, 2, 129,
-	510, 3, 2, 2, 2, 131, 518, 3, 2, 2, 2, 133, 532, 3, 2, 2, 2, 135, 546,
-	3, 2, 2, 2, 137, 548, 3, 2, 2, 2, 139, 555, 3, 2, 2, 2, 141, 582, 3, 2,
-	2, 2, 143, 584, 3, 2, 2, 2, 145, 589, 3, 2, 2, 2, 147, 591, 3, 2, 2, 2,
-	149, 596, 3, 2, 2, 2, 151, 602, 3, 2, 2, 2, 153, 604, 3, 2, 2, 2, 155,
-	606, 3, 2, 2, 2, 157, 616, 3, 2, 2, 2, 159, 622, 3, 2, 2, 2, 161, 162,
-	7, 36, 2, 2, 162, 163, 7, 99, 2, 2, 163, 164, 7, 117, 2, 2, 164, 165, 7,
-	81, 2, 2, 165, 166, 7, 100, 2, 2, 166, 167, 7, 108, 2, 2, 167, 168, 7,
-	103, 2, 2, 168, 169, 7, 101, 2, 2, 169, 170, 7, 118, 2, 2, 170, 171, 7,
-	36, 2, 2, 171, 4, 3, 2, 2, 2, 172, 173, 7, 60, 2, 2, 173, 6, 3, 2, 2, 2,
-	174, 175, 7, 93, 2, 2, 175, 8, 3, 2, 2, 2, 176, 177, 7, 95, 2, 2, 177,
-	10, 3, 2, 2, 2, 178, 179, 7, 112, 2, 2, 179, 180, 7, 119, 2, 2, 180, 181,
-	7, 110, 2, 2, 181, 182, 7, 110, 2, 2, 182, 12, 3, 2, 2, 2, 183, 184, 7,
-	126, 2, 2, 184, 185, 7, 126, 2, 2, 185, 14, 3, 2, 2, 2, 186, 187, 7, 40,
-	2, 2, 187, 188, 7, 40, 2, 2, 188, 16, 3, 2, 2, 2, 189, 190, 7, 63, 2, 2,
-	190, 191, 7, 63, 2, 2, 191, 18, 3, 2, 2, 2, 192, 193, 7, 35, 2, 2, 193,
-	194, 7, 63, 2, 2, 194, 20, 3, 2, 2, 2, 195, 196, 7, 64, 2, 2, 196, 22,
-	3, 2, 2, 2, 197, 198, 7, 62, 2, 2, 198, 24, 3, 2, 2, 2, 199, 200, 7, 64,
-	2, 2, 200, 201, 7, 63, 2, 2, 201, 26, 3, 2, 2, 2, 202, 203, 7, 62, 2, 2,
-	203, 204, 7, 63, 2, 2, 204, 28, 3, 2, 2, 2, 205, 206, 7, 45, 2, 2, 206,
-	30, 3, 2, 2, 2, 207, 208, 7, 47, 2, 2, 208, 32, 3, 2, 2, 2, 209, 210, 7,
-	44, 2, 2, 210, 34, 3, 2, 2, 2, 211, 212, 7, 49, 2, 2, 212, 36, 3, 2, 2,
-	2, 213, 214, 7, 39, 2, 2, 214, 38, 3, 2, 2, 2, 215, 216, 7, 96, 2, 2, 216,
-	40, 3, 2, 2, 2, 217, 218, 7, 35, 2, 2, 218, 42, 3, 2, 2, 2, 219, 220, 7,
-	61, 2, 2, 220, 44, 3, 2, 2, 2, 221, 222, 7, 63, 2, 2, 222, 46, 3, 2, 2,
-	2, 223, 224, 7, 42, 2, 2, 224, 48, 3, 2, 2, 2, 225, 226, 7, 43, 2, 2, 226,
-	50, 3, 2, 2, 2, 227, 228, 7, 125, 2, 2, 228, 52, 3, 2, 2, 2, 229, 230,
-	7, 127, 2, 2, 230, 54, 3, 2, 2, 2, 231, 232, 7, 46, 2, 2, 232, 56, 3, 2,
-	2, 2, 233, 234, 7, 118, 2, 2, 234, 235, 7, 116, 2, 2, 235, 236, 7, 119,
-	2, 2, 236, 237, 7, 103, 2, 2, 237, 58, 3, 2, 2, 2, 238, 239, 7, 104, 2,
-	2, 239, 240, 7, 99, 2, 2, 240, 241, 7, 110, 2, 2, 241, 242, 7, 117, 2,
-	2, 242, 243, 7, 103, 2, 2, 243, 60, 3, 2, 2, 2, 244, 245, 7, 112, 2, 2,
-	245, 246, 7, 107, 2, 2, 246, 247, 7, 110, 2, 2, 247, 62, 3, 2, 2, 2, 248,
-	249, 7, 107, 2, 2, 249, 250, 7, 104, 2, 2, 250, 64, 3, 2, 2, 2, 251, 252,
-	7, 103, 2, 2, 252, 253, 7, 110, 2, 2, 253, 254, 7, 117, 2, 2, 254, 255,
-	7, 103, 2, 2, 255, 66, 3, 2, 2, 2, 256, 257, 7, 121, 2, 2, 257, 258, 7,
-	106, 2, 2, 258, 259, 7, 107, 2, 2, 259, 260, 7, 110, 2, 2, 260, 261, 7,
-	103, 2, 2, 261, 68, 3, 2, 2, 2, 262, 263, 7, 110, 2, 2, 263, 264, 7, 113,
-	2, 2, 264, 265, 7, 105, 2, 2, 265, 70, 3, 2, 2, 2, 266, 267, 7, 109, 2,
-	2, 267, 268, 7, 119, 2, 2, 268, 269, 7, 100, 2, 2, 269, 270, 7, 103, 2,
-	2, 270, 271, 7, 101, 2, 2, 271, 272, 7, 118, 2, 2, 272, 273, 7, 110, 2,
-	2, 273, 72, 3, 2, 2, 2, 274, 275, 7, 99, 2, 2, 275, 276, 7, 114, 2, 2,
-	276, 277, 7, 114, 2, 2, 277, 278, 7, 110, 2, 2, 278, 279, 7, 123, 2, 2,
-	279, 74, 3, 2, 2, 2, 280, 281, 7, 114, 2, 2, 281, 282, 7, 99, 2, 2, 282,
-	283, 7, 118, 2, 2, 283, 284, 7, 101, 2, 2, 284, 285, 7, 106, 2, 2, 285,
-	76, 3, 2, 2, 2, 286, 287, 7, 105, 2, 2, 287, 288, 7, 103, 2, 2, 288, 289,
-	7, 118, 2, 2, 289, 78, 3, 2, 2, 2, 290, 291, 7, 116, 2, 2, 291, 292, 7,
-	103, 2, 2, 292, 293, 7, 114, 2, 2, 293, 294, 7, 110, 2, 2, 294, 295, 7,
-	99, 2, 2, 295, 296, 7, 101, 2, 2, 296, 297, 7, 103, 2, 2, 297, 80, 3, 2,
-	2, 2, 298, 299, 7, 102, 2, 2, 299, 300, 7, 103, 2, 2, 300, 301, 7, 110,
-	2, 2, 301, 302, 7, 103, 2, 2, 302, 303, 7, 118, 2, 2, 303, 304, 7, 103,
-	2, 2, 304, 82, 3, 2, 2, 2, 305, 306, 7, 47, 2, 2, 306, 307, 7, 112, 2,
-	2, 307, 84, 3, 2, 2, 2, 308, 309, 7, 47, 2, 2, 309, 310, 7, 47, 2, 2, 310,
-	311, 7, 118, 2, 2, 311, 312, 7, 123, 2, 2, 312, 313, 7, 114, 2, 2, 313,
-	314, 7, 103, 2, 2, 314, 86, 3, 2, 2, 2, 315, 316, 7, 47, 2, 2, 316, 317,
-	7, 114, 2, 2, 317, 88, 3, 2, 2, 2, 318, 319, 7, 47, 2, 2, 319, 320, 7,
-	119, 2, 2, 320, 90, 3, 2, 2, 2, 321, 322, 7, 47, 2, 2, 322, 323, 7, 108,
-	2, 2, 323, 324, 7, 117, 2, 2, 324, 325, 7, 113, 2, 2, 325, 326, 7, 112,
-	2, 2, 326, 327, 7, 114, 2, 2, 327, 328, 7, 99, 2, 2, 328, 329, 7, 118,
-	2, 2, 329, 330, 7, 106, 2, 2, 330, 92, 3, 2, 2, 2, 331, 332, 7, 110, 2,
-	2, 332, 333, 7, 113, 2, 2, 333, 334, 7, 99, 2, 2, 334, 335, 7, 102, 2,
-	2, 335, 94, 3, 2, 2, 2, 336, 337, 7, 103, 2, 2, 337, 338, 7, 122, 2, 2,
-	338, 339, 7, 107, 2, 2, 339, 340, 7, 118, 2, 2, 340, 96, 3, 2, 2, 2, 341,
-	342, 7, 108, 2, 2, 342, 343, 7, 117, 2, 2, 343, 344, 7, 113, 2, 2, 344,
-	345, 7, 112, 2, 2, 345, 346, 7, 85, 2, 2, 346, 347, 7, 103, 2, 2, 347,
-	348, 7, 110, 2, 2, 348, 349, 7, 103, 2, 2, 349, 350, 7, 101, 2, 2, 350,
-	351, 7, 118, 2, 2, 351, 98, 3, 2, 2, 2, 352, 353, 7, 108, 2, 2, 353, 354,
-	7, 117, 2, 2, 354, 355, 7, 113, 2, 2, 355, 356, 7, 112, 2, 2, 356, 357,
-	7, 71, 2, 2, 357, 358, 7, 102, 2, 2, 358, 359, 7, 107, 2, 2, 359, 360,
-	7, 118, 2, 2, 360, 100, 3, 2, 2, 2, 361, 362, 7, 108, 2, 2, 362, 363, 7,
-	117, 2, 2, 363, 364, 7, 113, 2, 2, 364, 365, 7, 112, 2, 2, 365, 366, 7,
-	70, 2, 2, 366, 367, 7, 103, 2, 2, 367, 368, 7, 110, 2, 2, 368, 369, 7,
-	103, 2, 2, 369, 370, 7, 118, 2, 2, 370, 371, 7, 103, 2, 2, 371, 102, 3,
-	2, 2, 2, 372, 373, 7, 123, 2, 2, 373, 374, 7, 99, 2, 2, 374, 375, 7, 111,
-	2, 2, 375, 376, 7, 110, 2, 2, 376, 377, 7, 85, 2, 2, 377, 378, 7, 103,
-	2, 2, 378, 379, 7, 110, 2, 2, 379, 380, 7, 103, 2, 2, 380, 381, 7, 101,
-	2, 2, 381, 382, 7, 118, 2, 2, 382, 104, 3, 2, 2, 2, 383, 384, 7, 123, 2,
-	2, 384, 385, 7, 99, 2, 2, 385, 386, 7, 111, 2, 2, 386, 387, 7, 110, 2,
-	2, 387, 388, 7, 71, 2, 2, 388, 389, 7, 102, 2, 2, 389, 390, 7, 107, 2,
-	2, 390, 391, 7, 118, 2, 2, 391, 106, 3, 2, 2, 2, 392, 393, 7, 123, 2, 2,
-	393, 394, 7, 99, 2, 2, 394, 395, 7, 111, 2, 2, 395, 396, 7, 110, 2, 2,
-	396, 397, 7, 70, 2, 2, 397, 398, 7, 103, 2, 2, 398, 399, 7, 110, 2, 2,
-	399, 400, 7, 103, 2, 2, 400, 401, 7, 118, 2, 2, 401, 402, 7, 103, 2, 2,
-	402, 108, 3, 2, 2, 2, 403, 404, 7, 109, 2, 2, 404, 405, 7, 119, 2, 2, 405,
-	406, 7, 100, 2, 2, 406, 407, 7, 103, 2, 2, 407, 408, 7, 76, 2, 2, 408,
-	409, 7, 117, 2, 2, 409, 410, 7, 113, 2, 2, 410, 411, 7, 112, 2, 2, 411,
-	412, 7, 71, 2, 2, 412, 413, 7, 102, 2, 2, 413, 414, 7, 107, 2, 2, 414,
-	415, 7, 118, 2, 2, 415, 110, 3, 2, 2, 2, 416, 417, 7, 109, 2, 2, 417, 418,
-	7, 119, 2, 2, 418, 419, 7, 100, 2, 2, 419, 420, 7, 103, 2, 2, 420, 421,
-	7, 76, 2, 2, 421, 422, 7, 117, 2, 2, 422, 423, 7, 113, 2, 2, 423, 424,
-	7, 112, 2, 2, 424, 425, 7, 70, 2, 2, 425, 426, 7, 103, 2, 2, 426, 427,
-	7, 110, 2, 2, 427, 428, 7, 103, 2, 2, 428, 429, 7, 118, 2, 2, 429, 430,
-	7, 103, 2, 2, 430, 112, 3, 2, 2, 2, 431, 432, 7, 109, 2, 2, 432, 433, 7,
-	119, 2, 2, 433, 434, 7, 100, 2, 2, 434, 435, 7, 103, 2, 2, 435, 436, 7,
-	91, 2, 2, 436, 437, 7, 99, 2, 2, 437, 438, 7, 111, 2, 2, 438, 439, 7, 110,
-	2, 2, 439, 440, 7, 71, 2, 2, 440, 441, 7, 102, 2, 2, 441, 442, 7, 107,
-	2, 2, 442, 443, 7, 118, 2, 2, 443, 114, 3, 2, 2, 2, 444, 445, 7, 109, 2,
-	2, 445, 446, 7, 119, 2, 2, 446, 447, 7, 100, 2, 2, 447, 448, 7, 103, 2,
-	2, 448, 449, 7, 91, 2, 2, 449, 450, 7, 99, 2, 2, 450, 451, 7, 111, 2, 2,
-	451, 452, 7, 110, 2, 2, 452, 453, 7, 70, 2, 2, 453, 454, 7, 103, 2, 2,
-	454, 455, 7, 110, 2, 2, 455, 456, 7, 103, 2, 2, 456, 457, 7, 118, 2, 2,
-	457, 458, 7, 103, 2, 2, 458, 116, 3, 2, 2, 2, 459, 460, 7, 117, 2, 2, 460,
-	461, 7, 106, 2, 2, 461, 462, 7, 103, 2, 2, 462, 463, 7, 110, 2, 2, 463,
-	464, 7, 110, 2, 2, 464, 465, 7, 85, 2, 2, 465, 466, 7, 101, 2, 2, 466,
-	467, 7, 116, 2, 2, 467, 468, 7, 107, 2, 2, 468, 469, 7, 114, 2, 2, 469,
-	470, 7, 118, 2, 2, 470, 118, 3, 2, 2, 2, 471, 472, 7, 102, 2, 2, 472, 473,
-	7, 113, 2, 2, 473, 474, 7, 121, 2, 2, 474, 475, 7, 112, 2, 2, 475, 476,
-	7, 110, 2, 2, 476, 477, 7, 113, 2, 2, 477, 478, 7, 99, 2, 2, 478, 479,
-	7, 102, 2, 2, 479, 120, 3, 2, 2, 2, 480, 481, 7, 117, 2, 2, 481, 482, 7,
-	110, 2, 2, 482, 483, 7, 103, 2, 2, 483, 484, 7, 103, 2, 2, 484, 485, 7,
-	114, 2, 2, 485, 122, 3, 2, 2, 2, 486, 487, 7, 117, 2, 2, 487, 488, 7, 118,
-	2, 2, 488, 489, 7, 103, 2, 2, 489, 490, 7, 114, 2, 2, 490, 491, 7, 75,
-	2, 2, 491, 492, 7, 112, 2, 2, 492, 493, 7, 104, 2, 2, 493, 494, 7, 113,
-	2, 2, 494, 124, 3, 2, 2, 2, 495, 496, 7, 104, 2, 2, 496, 497, 7, 107, 2,
-	2, 497, 498, 7, 110, 2, 2, 498, 499, 7, 118, 2, 2, 499, 500, 7, 103, 2,
-	2, 500, 501, 7, 116, 2, 2, 501, 126, 3, 2, 2, 2, 502, 503, 7, 114, 2, 2,
-	503, 504, 7, 99, 2, 2, 504, 505, 7, 118, 2, 2, 505, 506, 7, 118, 2, 2,
-	506, 507, 7, 103, 2, 2, 507, 508, 7, 116, 2, 2, 508, 509, 7, 112, 2, 2,
-	509, 128, 3, 2, 2, 2, 510, 514, 9, 2, 2, 2, 511, 513, 9, 3, 2, 2, 512,
-	511, 3, 2, 2, 2, 513, 516, 3, 2, 2, 2, 514, 512, 3, 2, 2, 2, 514, 515,
-	3, 2, 2, 2, 515, 130, 3, 2, 2, 2, 516, 514, 3, 2, 2, 2, 517, 519, 7, 47,
-	2, 2, 518, 517, 3, 2, 2, 2, 518, 519, 3, 2, 2, 2, 519, 520, 3, 2, 2, 2,
-	520, 527, 5, 135, 68, 2, 521, 523, 7, 48, 2, 2, 522, 524, 9, 4, 2, 2, 523,
-	522, 3, 2, 2, 2, 524, 525, 3, 2, 2, 2, 525, 523, 3, 2, 2, 2, 525, 526,
-	3, 2, 2, 2, 526, 528, 3, 2, 2, 2, 527, 521, 3, 2, 2, 2, 527, 528, 3, 2,
-	2, 2, 528, 530, 3, 2, 2, 2, 529, 531, 5, 133, 67, 2, 530, 529, 3, 2, 2,
-	2, 530, 531, 3, 2, 2, 2, 531, 132, 3, 2, 2, 2, 532, 534, 9, 5, 2, 2, 533,
-	535, 9, 6, 2, 2, 534, 533, 3, 2, 2, 2, 534, 535, 3, 2, 2, 2, 535, 536,
-	3, 2, 2, 2, 536, 537, 5, 135, 68, 2, 537, 134, 3, 2, 2, 2, 538, 547, 7,
-	50, 2, 2, 539, 543, 9, 7, 2, 2, 540, 542, 9, 4, 2, 2, 541, 540, 3, 2, 2,
-	2, 542, 545, 3, 2, 2, 2, 543, 541, 3, 2, 2, 2, 543, 544, 3, 2, 2, 2, 544,
-	547, 3, 2, 2, 2, 545, 543, 3, 2, 2, 2, 546, 538, 3, 2, 2, 2, 546, 539,
-	3, 2, 2, 2, 547, 136, 3, 2, 2, 2, 548, 552, 9, 8, 2, 2, 549, 551, 9, 9,
-	2, 2, 550, 549, 3, 2, 2, 2, 551, 554, 3, 2, 2, 2, 552, 550, 3, 2, 2, 2,
-	552, 553, 3, 2, 2, 2, 553, 138, 3, 2, 2, 2, 554, 552, 3, 2, 2, 2, 555,
-	559, 7, 98, 2, 2, 556, 558, 10, 10, 2, 2, 557, 556, 3, 2, 2, 2, 558, 561,
-	3, 2, 2, 2, 559, 557, 3, 2, 2, 2, 559, 560, 3, 2, 2, 2, 560, 562, 3, 2,
-	2, 2, 561, 559, 3, 2, 2, 2, 562, 563, 7, 98, 2, 2, 563, 140, 3, 2, 2, 2,
-	564, 569, 7, 36, 2, 2, 565, 568, 5, 147, 74, 2, 566, 568, 5, 153, 77, 2,
-	567, 565, 3, 2, 2, 2, 567, 566, 3, 2, 2, 2, 568, 571, 3, 2, 2, 2, 569,
-	567, 3, 2, 2, 2, 569, 570, 3, 2, 2, 2, 570, 572, 3, 2, 2, 2, 571, 569,
-	3, 2, 2, 2, 572, 583, 7, 36, 2, 2, 573, 578, 7, 41, 2, 2, 574, 577, 5,
-	143, 72, 2, 575, 577, 5, 145, 73, 2, 576, 574, 3, 2, 2, 2, 576, 575, 3,
-	2, 2, 2, 577, 580, 3, 2, 2, 2, 578, 576, 3, 2, 2, 2, 578, 579, 3, 2, 2,
-	2, 579, 581, 3, 2, 2, 2, 580, 578, 3, 2, 2, 2, 581, 583, 7, 41, 2, 2, 582,
-	564, 3, 2, 2, 2, 582, 573, 3, 2, 2, 2, 583, 142, 3, 2, 2, 2, 584, 587,
-	7, 94, 2, 2, 585, 588, 9, 11, 2, 2, 586, 588, 5, 149, 75, 2, 587, 585,
-	3, 2, 2, 2, 587, 586, 3, 2, 2, 2, 588, 144, 3, 2, 2, 2, 589, 590, 10, 12,
-	2, 2, 590, 146, 3, 2, 2, 2, 591, 594, 7, 94, 2, 2, 592, 595, 9, 13, 2,
-	2, 593, 595, 5, 149, 75, 2, 594, 592, 3, 2, 2, 2, 594, 593, 3, 2, 2, 2,
-	595, 148, 3, 2, 2, 2, 596, 597, 7, 119, 2, 2, 597, 598, 5, 151, 76, 2,
-	598, 599, 5, 151, 76, 2, 599, 600, 5, 151, 76, 2, 600, 601, 5, 151, 76,
-	2, 601, 150, 3, 2, 2, 2, 602, 603, 9, 14, 2, 2, 603, 152, 3, 2, 2, 2, 604,
-	605, 10, 15, 2, 2, 605, 154, 3, 2, 2, 2, 606, 610, 7, 37, 2, 2, 607, 609,
-	10, 16, 2, 2, 608, 607, 3, 2, 2, 2, 609, 612, 3, 2, 2, 2, 610, 608, 3,
-	2, 2, 2, 610, 611, 3, 2, 2, 2, 611, 613, 3, 2, 2, 2, 612, 610, 3, 2, 2,
-	2, 613, 614, 8, 78, 2, 2, 614, 156, 3, 2, 2, 2, 615, 617, 9, 17, 2, 2,
-	616, 615, 3, 2, 2, 2, 617, 618, 3, 2, 2, 2, 618, 616, 3, 2, 2, 2, 618,
-	619, 3, 2, 2, 2, 619, 620, 3, 2, 2, 2, 620, 621, 8, 79, 2, 2, 621, 158,
-	3, 2, 2, 2, 622, 623, 11, 2, 2, 2, 623, 160, 3, 2, 2, 2, 22, 2, 514, 518,
-	525, 527, 530, 534, 543, 546, 552, 559, 567, 569, 576, 578, 582, 587, 594,
-	610, 618, 3, 8, 2, 2,
+	58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 5, 58, 548, 10, 58,
+	3, 58, 3, 58, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 60, 3, 60, 3,
+	60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 5, 60, 568, 10, 60,
+	3, 60, 6, 60, 571, 10, 60, 13, 60, 14, 60, 572, 3, 60, 5, 60, 576, 10,
+	60, 3, 60, 3, 60, 7, 60, 580, 10, 60, 12, 60, 14, 60, 583, 11, 60, 3, 60,
+	3, 60, 5, 60, 587, 10, 60, 3, 60, 3, 60, 3, 61, 3, 61, 3, 61, 3, 61, 3,
+	61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 5, 61, 601, 10, 61, 3, 61, 3, 61,
+	3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3,
+	62, 3, 63, 3, 63, 3, 63, 3, 64, 3, 64, 3, 64, 3, 65, 3, 65, 3, 65, 3, 65,
+	3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 66, 3, 66, 3, 66, 3, 66, 3,
+	66, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 68, 3, 68, 3, 68, 3, 68, 3, 68,
+	3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 3, 69, 3, 69, 3, 69, 3, 69, 3,
+	69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70,
+	3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3,
+	71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 72, 3, 72, 3, 72, 3, 72, 3, 72,
+	3, 72, 3, 72, 3, 72, 3, 72, 3, 73, 3, 73, 3, 73, 3, 73, 3, 73, 3, 73, 3,
+	73, 3, 73, 3, 73, 3, 73, 3, 73, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74,
+	3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 75, 3, 75, 3, 75, 3,
+	75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75,
+	3, 75, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3,
+	76, 3, 76, 3, 76, 3, 76, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77,
+	3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 78, 3, 78, 3,
+	78, 3, 78, 3, 78, 3, 78, 3, 78, 3, 78, 3, 78, 3, 78, 3, 78, 3, 78, 3, 79,
+	3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 80, 3, 80, 3,
+	80, 3, 80, 3, 80, 3, 80, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81,
+	3, 81, 3, 81, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 83, 3,
+	83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 84, 3, 84, 3, 84, 3, 84,
+	3, 84, 3, 85, 3, 85, 3, 85, 3, 85, 3, 85, 3, 85, 3, 85, 3, 86, 3, 86, 3,
+	86, 3, 86, 3, 86, 3, 86, 3, 87, 3, 87, 3, 87, 3, 87, 3, 88, 3, 88, 3, 88,
+	3, 88, 3, 88, 3, 88, 3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 3,
+	89, 3, 90, 3, 90, 3, 90, 3, 90, 3, 90, 3, 90, 3, 91, 3, 91, 3, 91, 3, 91,
+	3, 91, 3, 91, 3, 91, 3, 92, 3, 92, 3, 92, 3, 93, 3, 93, 3, 93, 3, 93, 3,
+	94, 3, 94, 3, 94, 3, 94, 3, 94, 3, 94, 3, 95, 3, 95, 3, 95, 3, 95, 3, 95,
+	3, 95, 3, 95, 3, 95, 3, 96, 6, 96, 882, 10, 96, 13, 96, 14, 96, 883, 3,
+	96, 3, 96, 3, 96, 5, 96, 889, 10, 96, 6, 96, 891, 10, 96, 13, 96, 14, 96,
+	892, 3, 97, 3, 97, 7, 97, 897, 10, 97, 12, 97, 14, 97, 900, 11, 97, 3,
+	98, 5, 98, 903, 10, 98, 3, 98, 3, 98, 3, 98, 6, 98, 908, 10, 98, 13, 98,
+	14, 98, 909, 5, 98, 912, 10, 98, 3, 98, 5, 98, 915, 10, 98, 3, 99, 6, 99,
+	918, 10, 99, 13, 99, 14, 99, 919, 3, 99, 3, 99, 5, 99, 924, 10, 99, 3,
+	99, 3, 99, 5, 99, 928, 10, 99, 3, 99, 7, 99, 931, 10, 99, 12, 99, 14, 99,
+	934, 11, 99, 3, 100, 3, 100, 7, 100, 938, 10, 100, 12, 100, 14, 100, 941,
+	11, 100, 3, 100, 3, 100, 3, 100, 7, 100, 946, 10, 100, 12, 100, 14, 100,
+	949, 11, 100, 3, 100, 3, 100, 6, 100, 953, 10, 100, 13, 100, 14, 100, 954,
+	3, 101, 3, 101, 5, 101, 959, 10, 101, 3, 101, 3, 101, 3, 102, 3, 102, 3,
+	102, 7, 102, 966, 10, 102, 12, 102, 14, 102, 969, 11, 102, 5, 102, 971,
+	10, 102, 3, 103, 3, 103, 7, 103, 975, 10, 103, 12, 103, 14, 103, 978, 11,
+	103, 3, 104, 3, 104, 7, 104, 982, 10, 104, 12, 104, 14, 104, 985, 11, 104,
+	3, 104, 3, 104, 3, 105, 3, 105, 3, 105, 7, 105, 992, 10, 105, 12, 105,
+	14, 105, 995, 11, 105, 3, 105, 3, 105, 3, 105, 3, 105, 7, 105, 1001, 10,
+	105, 12, 105, 14, 105, 1004, 11, 105, 3, 105, 5, 105, 1007, 10, 105, 3,
+	106, 3, 106, 3, 106, 5, 106, 1012, 10, 106, 3, 107, 3, 107, 3, 108, 3,
+	108, 3, 108, 5, 108, 1019, 10, 108, 3, 109, 3, 109, 3, 109, 3, 109, 3,
+	109, 3, 109, 3, 110, 3, 110, 3, 111, 3, 111, 3, 112, 3, 112, 7, 112, 1033,
+	10, 112, 12, 112, 14, 112, 1036, 11, 112, 3, 112, 3, 112, 3, 113, 6, 113,
+	1041, 10, 113, 13, 113, 14, 113, 1042, 3, 113, 3, 113, 2, 2, 114, 3, 3,
+	5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12, 23, 13,
+	25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19, 37, 20, 39, 21, 41, 22,
+	43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 53, 28, 55, 29, 57, 30, 59, 31,
+	61, 32, 63, 33, 65, 34, 67, 35, 69, 36, 71, 37, 73, 38, 75, 39, 77, 40,
+	79, 41, 81, 42, 83, 43, 85, 44, 87, 45, 89, 46, 91, 47, 93, 48, 95, 49,
+	97, 50, 99, 51, 101, 52, 103, 53, 105, 54, 107, 55, 109, 56, 111, 57, 113,
+	58, 115, 59, 117, 60, 119, 61, 121, 62, 123, 63, 125, 64, 127, 65, 129,
+	66, 131, 67, 133, 68, 135, 69, 137, 70, 139, 71, 141, 72, 143, 73, 145,
+	74, 147, 75, 149, 76, 151, 77, 153, 78, 155, 79, 157, 80, 159, 81, 161,
+	82, 163, 83, 165, 84, 167, 85, 169, 86, 171, 87, 173, 88, 175, 89, 177,
+	90, 179, 91, 181, 92, 183, 93, 185, 94, 187, 95, 189, 96, 191, 97, 193,
+	98, 195, 99, 197, 2, 199, 2, 201, 2, 203, 2, 205, 100, 207, 101, 209, 102,
+	211, 2, 213, 2, 215, 2, 217, 2, 219, 2, 221, 2, 223, 103, 225, 104, 3,
+	2, 26, 6, 2, 11, 12, 15, 15, 34, 34, 61, 61, 4, 2, 11, 11, 34, 34, 5, 2,
+	67, 92, 97, 97, 99, 124, 7, 2, 47, 47, 50, 59, 67, 92, 97, 97, 99, 124,
+	3, 2, 50, 59, 5, 2, 106, 106, 111, 111, 117, 117, 6, 2, 50, 59, 67, 92,
+	97, 97, 99, 124, 9, 2, 11, 12, 15, 15, 34, 36, 41, 41, 61, 61, 63, 63,
+	98, 98, 3, 2, 41, 41, 3, 2, 36, 36, 8, 2, 11, 12, 15, 15, 34, 34, 36, 36,
+	41, 41, 61, 61, 4, 2, 71, 71, 103, 103, 4, 2, 45, 45, 47, 47, 3, 2, 51,
+	59, 4, 2, 67, 92, 99, 124, 7, 2, 47, 59, 67, 92, 94, 94, 97, 97, 99, 124,
+	3, 2, 98, 98, 11, 2, 38, 38, 41, 41, 49, 49, 94, 94, 100, 100, 104, 104,
+	112, 112, 116, 116, 118, 118, 5, 2, 2, 33, 41, 41, 94, 94, 11, 2, 36, 36,
+	38, 38, 49, 49, 94, 94, 100, 100, 104, 104, 112, 112, 116, 116, 118, 118,
+	5, 2, 50, 59, 67, 72, 99, 104, 5, 2, 2, 33, 36, 36, 94, 94, 4, 2, 12, 12,
+	15, 15, 5, 2, 11, 12, 15, 15, 34, 34, 2, 1080, 2, 3, 3, 2, 2, 2, 2, 5,
+	3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13,
+	3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2,
+	21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2,
+	2, 29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2,
+	2, 2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2,
+	2, 2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3,
+	2, 2, 2, 2, 53, 3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59,
+	3, 2, 2, 2, 2, 61, 3, 2, 2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2,
+	67, 3, 2, 2, 2, 2, 69, 3, 2, 2, 2, 2, 71, 3, 2, 2, 2, 2, 73, 3, 2, 2, 2,
+	2, 75, 3, 2, 2, 2, 2, 77, 3, 2, 2, 2, 2, 79, 3, 2, 2, 2, 2, 81, 3, 2, 2,
+	2, 2, 83, 3, 2, 2, 2, 2, 85, 3, 2, 2, 2, 2, 87, 3, 2, 2, 2, 2, 89, 3, 2,
+	2, 2, 2, 91, 3, 2, 2, 2, 2, 93, 3, 2, 2, 2, 2, 95, 3, 2, 2, 2, 2, 97, 3,
+	2, 2, 2, 2, 99, 3, 2, 2, 2, 2, 101, 3, 2, 2, 2, 2, 103, 3, 2, 2, 2, 2,
+	105, 3, 2, 2, 2, 2, 107, 3, 2, 2, 2, 2, 109, 3, 2, 2, 2, 2, 111, 3, 2,
+	2, 2, 2, 113, 3, 2, 2, 2, 2, 115, 3, 2, 2, 2, 2, 117, 3, 2, 2, 2, 2, 119,
+	3, 2, 2, 2, 2, 121, 3, 2, 2, 2, 2, 123, 3, 2, 2, 2, 2, 125, 3, 2, 2, 2,
+	2, 127, 3, 2, 2, 2, 2, 129, 3, 2, 2, 2, 2, 131, 3, 2, 2, 2, 2, 133, 3,
+	2, 2, 2, 2, 135, 3, 2, 2, 2, 2, 137, 3, 2, 2, 2, 2, 139, 3, 2, 2, 2, 2,
+	141, 3, 2, 2, 2, 2, 143, 3, 2, 2, 2, 2, 145, 3, 2, 2, 2, 2, 147, 3, 2,
+	2, 2, 2, 149, 3, 2, 2, 2, 2, 151, 3, 2, 2, 2, 2, 153, 3, 2, 2, 2, 2, 155,
+	3, 2, 2, 2, 2, 157, 3, 2, 2, 2, 2, 159, 3, 2, 2, 2, 2, 161, 3, 2, 2, 2,
+	2, 163, 3, 2, 2, 2, 2, 165, 3, 2, 2, 2, 2, 167, 3, 2, 2, 2, 2, 169, 3,
+	2, 2, 2, 2, 171, 3, 2, 2, 2, 2, 173, 3, 2, 2, 2, 2, 175, 3, 2, 2, 2, 2,
+	177, 3, 2, 2, 2, 2, 179, 3, 2, 2, 2, 2, 181, 3, 2, 2, 2, 2, 183, 3, 2,
+	2, 2, 2, 185, 3, 2, 2, 2, 2, 187, 3, 2, 2, 2, 2, 189, 3, 2, 2, 2, 2, 191,
+	3, 2, 2, 2, 2, 193, 3, 2, 2, 2, 2, 195, 3, 2, 2, 2, 2, 205, 3, 2, 2, 2,
+	2, 207, 3, 2, 2, 2, 2, 209, 3, 2, 2, 2, 2, 223, 3, 2, 2, 2, 2, 225, 3,
+	2, 2, 2, 3, 227, 3, 2, 2, 2, 5, 238, 3, 2, 2, 2, 7, 240, 3, 2, 2, 2, 9,
+	242, 3, 2, 2, 2, 11, 244, 3, 2, 2, 2, 13, 249, 3, 2, 2, 2, 15, 252, 3,
+	2, 2, 2, 17, 255, 3, 2, 2, 2, 19, 258, 3, 2, 2, 2, 21, 261, 3, 2, 2, 2,
+	23, 263, 3, 2, 2, 2, 25, 265, 3, 2, 2, 2, 27, 268, 3, 2, 2, 2, 29, 271,
+	3, 2, 2, 2, 31, 273, 3, 2, 2, 2, 33, 275, 3, 2, 2, 2, 35, 277, 3, 2, 2,
+	2, 37, 279, 3, 2, 2, 2, 39, 281, 3, 2, 2, 2, 41, 283, 3, 2, 2, 2, 43, 285,
+	3, 2, 2, 2, 45, 287, 3, 2, 2, 2, 47, 289, 3, 2, 2, 2, 49, 291, 3, 2, 2,
+	2, 51, 293, 3, 2, 2, 2, 53, 295, 3, 2, 2, 2, 55, 297, 3, 2, 2, 2, 57, 299,
+	3, 2, 2, 2, 59, 301, 3, 2, 2, 2, 61, 306, 3, 2, 2, 2, 63, 312, 3, 2, 2,
+	2, 65, 316, 3, 2, 2, 2, 67, 319, 3, 2, 2, 2, 69, 324, 3, 2, 2, 2, 71, 330,
+	3, 2, 2, 2, 73, 334, 3, 2, 2, 2, 75, 337, 3, 2, 2, 2, 77, 341, 3, 2, 2,
+	2, 79, 349, 3, 2, 2, 2, 81, 355, 3, 2, 2, 2, 83, 361, 3, 2, 2, 2, 85, 365,
+	3, 2, 2, 2, 87, 373, 3, 2, 2, 2, 89, 380, 3, 2, 2, 2, 91, 385, 3, 2, 2,
+	2, 93, 393, 3, 2, 2, 2, 95, 400, 3, 2, 2, 2, 97, 406, 3, 2, 2, 2, 99, 409,
+	3, 2, 2, 2, 101, 416, 3, 2, 2, 2, 103, 428, 3, 2, 2, 2, 105, 434, 3, 2,
+	2, 2, 107, 456, 3, 2, 2, 2, 109, 469, 3, 2, 2, 2, 111, 508, 3, 2, 2, 2,
+	113, 512, 3, 2, 2, 2, 115, 547, 3, 2, 2, 2, 117, 551, 3, 2, 2, 2, 119,
+	567, 3, 2, 2, 2, 121, 600, 3, 2, 2, 2, 123, 604, 3, 2, 2, 2, 125, 615,
+	3, 2, 2, 2, 127, 618, 3, 2, 2, 2, 129, 621, 3, 2, 2, 2, 131, 631, 3, 2,
+	2, 2, 133, 636, 3, 2, 2, 2, 135, 641, 3, 2, 2, 2, 137, 652, 3, 2, 2, 2,
+	139, 661, 3, 2, 2, 2, 141, 672, 3, 2, 2, 2, 143, 683, 3, 2, 2, 2, 145,
+	692, 3, 2, 2, 2, 147, 703, 3, 2, 2, 2, 149, 716, 3, 2, 2, 2, 151, 731,
+	3, 2, 2, 2, 153, 744, 3, 2, 2, 2, 155, 759, 3, 2, 2, 2, 157, 771, 3, 2,
+	2, 2, 159, 780, 3, 2, 2, 2, 161, 786, 3, 2, 2, 2, 163, 795, 3, 2, 2, 2,
+	165, 802, 3, 2, 2, 2, 167, 810, 3, 2, 2, 2, 169, 815, 3, 2, 2, 2, 171,
+	822, 3, 2, 2, 2, 173, 828, 3, 2, 2, 2, 175, 832, 3, 2, 2, 2, 177, 838,
+	3, 2, 2, 2, 179, 846, 3, 2, 2, 2, 181, 852, 3, 2, 2, 2, 183, 859, 3, 2,
+	2, 2, 185, 862, 3, 2, 2, 2, 187, 866, 3, 2, 2, 2, 189, 872, 3, 2, 2, 2,
+	191, 890, 3, 2, 2, 2, 193, 894, 3, 2, 2, 2, 195, 902, 3, 2, 2, 2, 197,
+	917, 3, 2, 2, 2, 199, 952, 3, 2, 2, 2, 201, 956, 3, 2, 2, 2, 203, 970,
+	3, 2, 2, 2, 205, 972, 3, 2, 2, 2, 207, 979, 3, 2, 2, 2, 209, 1006, 3, 2,
+	2, 2, 211, 1008, 3, 2, 2, 2, 213, 1013, 3, 2, 2, 2, 215, 1015, 3, 2, 2,
+	2, 217, 1020, 3, 2, 2, 2, 219, 1026, 3, 2, 2, 2, 221, 1028, 3, 2, 2, 2,
+	223, 1030, 3, 2, 2, 2, 225, 1040, 3, 2, 2, 2, 227, 228, 7, 36, 2, 2, 228,
+	229, 7, 99, 2, 2, 229, 230, 7, 117, 2, 2, 230, 231, 7, 81, 2, 2, 231, 232,
+	7, 100, 2, 2, 232, 233, 7, 108, 2, 2, 233, 234, 7, 103, 2, 2, 234, 235,
+	7, 101, 2, 2, 235, 236, 7, 118, 2, 2, 236, 237, 7, 36, 2, 2, 237, 4, 3,
+	2, 2, 2, 238, 239, 7, 93, 2, 2, 239, 6, 3, 2, 2, 2, 240, 241, 7, 95, 2,
+	2, 241, 8, 3, 2, 2, 2, 242, 243, 7, 60, 2, 2, 243, 10, 3, 2, 2, 2, 244,
+	245, 7, 112, 2, 2, 245, 246, 7, 119, 2, 2, 246, 247, 7, 110, 2, 2, 247,
+	248, 7, 110, 2, 2, 248, 12, 3, 2, 2, 2, 249, 250, 7, 126, 2, 2, 250, 251,
+	7, 126, 2, 2, 251, 14, 3, 2, 2, 2, 252, 253, 7, 40, 2, 2, 253, 254, 7,
+	40, 2, 2, 254, 16, 3, 2, 2, 2, 255, 256, 7, 63, 2, 2, 256, 257, 7, 63,
+	2, 2, 257, 18, 3, 2, 2, 2, 258, 259, 7, 35, 2, 2, 259, 260, 7, 63, 2, 2,
+	260, 20, 3, 2, 2, 2, 261, 262, 7, 64, 2, 2, 262, 22, 3, 2, 2, 2, 263, 264,
+	7, 62, 2, 2, 264, 24, 3, 2, 2, 2, 265, 266, 7, 64, 2, 2, 266, 267, 7, 63,
+	2, 2, 267, 26, 3, 2, 2, 2, 268, 269, 7, 62, 2, 2, 269, 270, 7, 63, 2, 2,
+	270, 28, 3, 2, 2, 2, 271, 272, 7, 45, 2, 2, 272, 30, 3, 2, 2, 2, 273, 274,
+	7, 47, 2, 2, 274, 32, 3, 2, 2, 2, 275, 276, 7, 44, 2, 2, 276, 34, 3, 2,
+	2, 2, 277, 278, 7, 49, 2, 2, 278, 36, 3, 2, 2, 2, 279, 280, 7, 39, 2, 2,
+	280, 38, 3, 2, 2, 2, 281, 282, 7, 96, 2, 2, 282, 40, 3, 2, 2, 2, 283, 284,
+	7, 35, 2, 2, 284, 42, 3, 2, 2, 2, 285, 286, 7, 61, 2, 2, 286, 44, 3, 2,
+	2, 2, 287, 288, 7, 63, 2, 2, 288, 46, 3, 2, 2, 2, 289, 290, 7, 42, 2, 2,
+	290, 48, 3, 2, 2, 2, 291, 292, 7, 43, 2, 2, 292, 50, 3, 2, 2, 2, 293, 294,
+	7, 125, 2, 2, 294, 52, 3, 2, 2, 2, 295, 296, 7, 127, 2, 2, 296, 54, 3,
+	2, 2, 2, 297, 298, 7, 46, 2, 2, 298, 56, 3, 2, 2, 2, 299, 300, 7, 48, 2,
+	2, 300, 58, 3, 2, 2, 2, 301, 302, 7, 118, 2, 2, 302, 303, 7, 116, 2, 2,
+	303, 304, 7, 119, 2, 2, 304, 305, 7, 103, 2, 2, 305, 60, 3, 2, 2, 2, 306,
+	307, 7, 104, 2, 2, 307, 308, 7, 99, 2, 2, 308, 309, 7, 110, 2, 2, 309,
+	310, 7, 117, 2, 2, 310, 311, 7, 103, 2, 2, 311, 62, 3, 2, 2, 2, 312, 313,
+	7, 112, 2, 2, 313, 314, 7, 107, 2, 2, 314, 315, 7, 110, 2, 2, 315, 64,
+	3, 2, 2, 2, 316, 317, 7, 107, 2, 2, 317, 318, 7, 104, 2, 2, 318, 66, 3,
+	2, 2, 2, 319, 320, 7, 103, 2, 2, 320, 321, 7, 110, 2, 2, 321, 322, 7, 117,
+	2, 2, 322, 323, 7, 103, 2, 2, 323, 68, 3, 2, 2, 2, 324, 325, 7, 121, 2,
+	2, 325, 326, 7, 106, 2, 2, 326, 327, 7, 107, 2, 2, 327, 328, 7, 110, 2,
+	2, 328, 329, 7, 103, 2, 2, 329, 70, 3, 2, 2, 2, 330, 331, 7, 104, 2, 2,
+	331, 332, 7, 113, 2, 2, 332, 333, 7, 116, 2, 2, 333, 72, 3, 2, 2, 2, 334,
+	335, 7, 107, 2, 2, 335, 336, 7, 112, 2, 2, 336, 74, 3, 2, 2, 2, 337, 338,
+	7, 110, 2, 2, 338, 339, 7, 113, 2, 2, 339, 340, 7, 105, 2, 2, 340, 76,
+	3, 2, 2, 2, 341, 342, 7, 109, 2, 2, 342, 343, 7, 119, 2, 2, 343, 344, 7,
+	100, 2, 2, 344, 345, 7, 103, 2, 2, 345, 346, 7, 101, 2, 2, 346, 347, 7,
+	118, 2, 2, 347, 348, 7, 110, 2, 2, 348, 78, 3, 2, 2, 2, 349, 350, 7, 99,
+	2, 2, 350, 351, 7, 114, 2, 2, 351, 352, 7, 114, 2, 2, 352, 353, 7, 110,
+	2, 2, 353, 354, 7, 123, 2, 2, 354, 80, 3, 2, 2, 2, 355, 356, 7, 114, 2,
+	2, 356, 357, 7, 99, 2, 2, 357, 358, 7, 118, 2, 2, 358, 359, 7, 101, 2,
+	2, 359, 360, 7, 106, 2, 2, 360, 82, 3, 2, 2, 2, 361, 362, 7, 105, 2, 2,
+	362, 363, 7, 103, 2, 2, 363, 364, 7, 118, 2, 2, 364, 84, 3, 2, 2, 2, 365,
+	366, 7, 116, 2, 2, 366, 367, 7, 103, 2, 2, 367, 368, 7, 114, 2, 2, 368,
+	369, 7, 110, 2, 2, 369, 370, 7, 99, 2, 2, 370, 371, 7, 101, 2, 2, 371,
+	372, 7, 103, 2, 2, 372, 86, 3, 2, 2, 2, 373, 374, 7, 102, 2, 2, 374, 375,
+	7, 103, 2, 2, 375, 376, 7, 110, 2, 2, 376, 377, 7, 103, 2, 2, 377, 378,
+	7, 118, 2, 2, 378, 379, 7, 103, 2, 2, 379, 88, 3, 2, 2, 2, 380, 381, 7,
+	121, 2, 2, 381, 382, 7, 99, 2, 2, 382, 383, 7, 107, 2, 2, 383, 384, 7,
+	118, 2, 2, 384, 90, 3, 2, 2, 2, 385, 386, 7, 116, 2, 2, 386, 387, 7, 113,
+	2, 2, 387, 388, 7, 110, 2, 2, 388, 389, 7, 110, 2, 2, 389, 390, 7, 113,
+	2, 2, 390, 391, 7, 119, 2, 2, 391, 392, 7, 118, 2, 2, 392, 92, 3, 2, 2,
+	2, 393, 394, 7, 101, 2, 2, 394, 395, 7, 116, 2, 2, 395, 396, 7, 103, 2,
+	2, 396, 397, 7, 99, 2, 2, 397, 398, 7, 118, 2, 2, 398, 399, 7, 103, 2,
+	2, 399, 94, 3, 2, 2, 2, 400, 401, 7, 117, 2, 2, 401, 402, 7, 101, 2, 2,
+	402, 403, 7, 99, 2, 2, 403, 404, 7, 110, 2, 2, 404, 405, 7, 103, 2, 2,
+	405, 96, 3, 2, 2, 2, 406, 407, 7, 47, 2, 2, 407, 408, 7, 112, 2, 2, 408,
+	98, 3, 2, 2, 2, 409, 410, 7, 47, 2, 2, 410, 411, 7, 47, 2, 2, 411, 412,
+	7, 118, 2, 2, 412, 413, 7, 123, 2, 2, 413, 414, 7, 114, 2, 2, 414, 415,
+	7, 103, 2, 2, 415, 100, 3, 2, 2, 2, 416, 417, 7, 47, 2, 2, 417, 418, 7,
+	47, 2, 2, 418, 419, 7, 104, 2, 2, 419, 420, 7, 113, 2, 2, 420, 421, 7,
+	116, 2, 2, 421, 422, 7, 63, 2, 2, 422, 424, 3, 2, 2, 2, 423, 425, 10, 2,
+	2, 2, 424, 423, 3, 2, 2, 2, 425, 426, 3, 2, 2, 2, 426, 424, 3, 2, 2, 2,
+	426, 427, 3, 2, 2, 2, 427, 102, 3, 2, 2, 2, 428, 429, 7, 47, 2, 2, 429,
+	430, 7, 47, 2, 2, 430, 431, 7, 104, 2, 2, 431, 432, 7, 113, 2, 2, 432,
+	433, 7, 116, 2, 2, 433, 104, 3, 2, 2, 2, 434, 435, 7, 47, 2, 2, 435, 436,
+	7, 47, 2, 2, 436, 437, 7, 118, 2, 2, 437, 438, 7, 107, 2, 2, 438, 439,
+	7, 111, 2, 2, 439, 440, 7, 103, 2, 2, 440, 441, 7, 113, 2, 2, 441, 442,
+	7, 119, 2, 2, 442, 443, 7, 118, 2, 2, 443, 106, 3, 2, 2, 2, 444, 445, 7,
+	47, 2, 2, 445, 457, 7, 110, 2, 2, 446, 447, 7, 47, 2, 2, 447, 448, 7, 47,
+	2, 2, 448, 449, 7, 117, 2, 2, 449, 450, 7, 103, 2, 2, 450, 451, 7, 110,
+	2, 2, 451, 452, 7, 103, 2, 2, 452, 453, 7, 101, 2, 2, 453, 454, 7, 118,
+	2, 2, 454, 455, 7, 113, 2, 2, 455, 457, 7, 116, 2, 2, 456, 444, 3, 2, 2,
+	2, 456, 446, 3, 2, 2, 2, 457, 464, 3, 2, 2, 2, 458, 460, 9, 3, 2, 2, 459,
+	458, 3, 2, 2, 2, 460, 461, 3, 2, 2, 2, 461, 459, 3, 2, 2, 2, 461, 462,
+	3, 2, 2, 2, 462, 465, 3, 2, 2, 2, 463, 465, 7, 63, 2, 2, 464, 459, 3, 2,
+	2, 2, 464, 463, 3, 2, 2, 2, 465, 466, 3, 2, 2, 2, 466, 467, 5, 197, 99,
+	2, 467, 468, 6, 54, 2, 2, 468, 108, 3, 2, 2, 2, 469, 470, 7, 47, 2, 2,
+	470, 471, 7, 47, 2, 2, 471, 472, 7, 104, 2, 2, 472, 473, 7, 107, 2, 2,
+	473, 474, 7, 103, 2, 2, 474, 475, 7, 110, 2, 2, 475, 476, 7, 102, 2, 2,
+	476, 477, 7, 47, 2, 2, 477, 478, 7, 117, 2, 2, 478, 479, 7, 103, 2, 2,
+	479, 480, 7, 110, 2, 2, 480, 481, 7, 103, 2, 2, 481, 482, 7, 101, 2, 2,
+	482, 483, 7, 118, 2, 2, 483, 484, 7, 113, 2, 2, 484, 485, 7, 116, 2, 2,
+	485, 492, 3, 2, 2, 2, 486, 488, 9, 3, 2, 2, 487, 486, 3, 2, 2, 2, 488,
+	489, 3, 2, 2, 2, 489, 487, 3, 2, 2, 2, 489, 490, 3, 2, 2, 2, 490, 493,
+	3, 2, 2, 2, 491, 493, 7, 63, 2, 2, 492, 487, 3, 2, 2, 2, 492, 491, 3, 2,
+	2, 2, 493, 494, 3, 2, 2, 2, 494, 495, 5, 197, 99, 2, 495, 110, 3, 2, 2,
+	2, 496, 497, 7, 47, 2, 2, 497, 509, 7, 110, 2, 2, 498, 499, 7, 47, 2, 2,
+	499, 500, 7, 47, 2, 2, 500, 501, 7, 117, 2, 2, 501, 502, 7, 103, 2, 2,
+	502, 503, 7, 110, 2, 2, 503, 504, 7, 103, 2, 2, 504, 505, 7, 101, 2, 2,
+	505, 506, 7, 118, 2, 2, 506, 507, 7, 113, 2, 2, 507, 509, 7, 116, 2, 2,
+	508, 496, 3, 2, 2, 2, 508, 498, 3, 2, 2, 2, 509, 510, 3, 2, 2, 2, 510,
+	511, 6, 56, 3, 2, 511, 112, 3, 2, 2, 2, 512, 513, 7, 47, 2, 2, 513, 514,
+	7, 47, 2, 2, 514, 515, 7, 104, 2, 2, 515, 516, 7, 107, 2, 2, 516, 517,
+	7, 103, 2, 2, 517, 518, 7, 110, 2, 2, 518, 519, 7, 102, 2, 2, 519, 520,
+	7, 47, 2, 2, 520, 521, 7, 117, 2, 2, 521, 522, 7, 103, 2, 2, 522, 523,
+	7, 110, 2, 2, 523, 524, 7, 103, 2, 2, 524, 525, 7, 101, 2, 2, 525, 526,
+	7, 118, 2, 2, 526, 527, 7, 113, 2, 2, 527, 528, 7, 116, 2, 2, 528, 114,
+	3, 2, 2, 2, 529, 530, 7, 47, 2, 2, 530, 548, 7, 67, 2, 2, 531, 532, 7,
+	47, 2, 2, 532, 533, 7, 47, 2, 2, 533, 534, 7, 99, 2, 2, 534, 535, 7, 110,
+	2, 2, 535, 536, 7, 110, 2, 2, 536, 537, 7, 47, 2, 2, 537, 538, 7, 112,
+	2, 2, 538, 539, 7, 99, 2, 2, 539, 540, 7, 111, 2, 2, 540, 541, 7, 103,
+	2, 2, 541, 542, 7, 117, 2, 2, 542, 543, 7, 114, 2, 2, 543, 544, 7, 99,
+	2, 2, 544, 545, 7, 101, 2, 2, 545, 546, 7, 103, 2, 2, 546, 548, 7, 117,
+	2, 2, 547, 529, 3, 2, 2, 2, 547, 531, 3, 2, 2, 2, 548, 549, 3, 2, 2, 2,
+	549, 550, 6, 58, 4, 2, 550, 116, 3, 2, 2, 2, 551, 552, 7, 47, 2, 2, 552,
+	553, 7, 47, 2, 2, 553, 554, 7, 99, 2, 2, 554, 555, 7, 110, 2, 2, 555, 556,
+	7, 110, 2, 2, 556, 118, 3, 2, 2, 2, 557, 558, 7, 47, 2, 2, 558, 568, 7,
+	113, 2, 2, 559, 560, 7, 47, 2, 2, 560, 561, 7, 47, 2, 2, 561, 562, 7, 113,
+	2, 2, 562, 563, 7, 119, 2, 2, 563, 564, 7, 118, 2, 2, 564, 565, 7, 114,
+	2, 2, 565, 566, 7, 119, 2, 2, 566, 568, 7, 118, 2, 2, 567, 557, 3, 2, 2,
+	2, 567, 559, 3, 2, 2, 2, 568, 575, 3, 2, 2, 2, 569, 571, 9, 3, 2, 2, 570,
+	569, 3, 2, 2, 2, 571, 572, 3, 2, 2, 2, 572, 570, 3, 2, 2, 2, 572, 573,
+	3, 2, 2, 2, 573, 576, 3, 2, 2, 2, 574, 576, 7, 63, 2, 2, 575, 570, 3, 2,
+	2, 2, 575, 574, 3, 2, 2, 2, 576, 577, 3, 2, 2, 2, 577, 581, 9, 4, 2, 2,
+	578, 580, 9, 5, 2, 2, 579, 578, 3, 2, 2, 2, 580, 583, 3, 2, 2, 2, 581,
+	579, 3, 2, 2, 2, 581, 582, 3, 2, 2, 2, 582, 586, 3, 2, 2, 2, 583, 581,
+	3, 2, 2, 2, 584, 585, 7, 63, 2, 2, 585, 587, 5, 199, 100, 2, 586, 584,
+	3, 2, 2, 2, 586, 587, 3, 2, 2, 2, 587, 588, 3, 2, 2, 2, 588, 589, 6, 60,
+	5, 2, 589, 120, 3, 2, 2, 2, 590, 591, 7, 47, 2, 2, 591, 601, 7, 113, 2,
+	2, 592, 593, 7, 47, 2, 2, 593, 594, 7, 47, 2, 2, 594, 595, 7, 113, 2, 2,
+	595, 596, 7, 119, 2, 2, 596, 597, 7, 118, 2, 2, 597, 598, 7, 114, 2, 2,
+	598, 599, 7, 119, 2, 2, 599, 601, 7, 118, 2, 2, 600, 590, 3, 2, 2, 2, 600,
+	592, 3, 2, 2, 2, 601, 602, 3, 2, 2, 2, 602, 603, 6, 61, 6, 2, 603, 122,
+	3, 2, 2, 2, 604, 605, 7, 47, 2, 2, 605, 606, 7, 47, 2, 2, 606, 607, 7,
+	116, 2, 2, 607, 608, 7, 103, 2, 2, 608, 609, 7, 114, 2, 2, 609, 610, 7,
+	110, 2, 2, 610, 611, 7, 107, 2, 2, 611, 612, 7, 101, 2, 2, 612, 613, 7,
+	99, 2, 2, 613, 614, 7, 117, 2, 2, 614, 124, 3, 2, 2, 2, 615, 616, 7, 47,
+	2, 2, 616, 617, 7, 114, 2, 2, 617, 126, 3, 2, 2, 2, 618, 619, 7, 47, 2,
+	2, 619, 620, 7, 119, 2, 2, 620, 128, 3, 2, 2, 2, 621, 622, 7, 47, 2, 2,
+	622, 623, 7, 108, 2, 2, 623, 624, 7, 117, 2, 2, 624, 625, 7, 113, 2, 2,
+	625, 626, 7, 112, 2, 2, 626, 627, 7, 114, 2, 2, 627, 628, 7, 99, 2, 2,
+	628, 629, 7, 118, 2, 2, 629, 630, 7, 106, 2, 2, 630, 130, 3, 2, 2, 2, 631,
+	632, 7, 110, 2, 2, 632, 633, 7, 113, 2, 2, 633, 634, 7, 99, 2, 2, 634,
+	635, 7, 102, 2, 2, 635, 132, 3, 2, 2, 2, 636, 637, 7, 103, 2, 2, 637, 638,
+	7, 122, 2, 2, 638, 639, 7, 107, 2, 2, 639, 640, 7, 118, 2, 2, 640, 134,
+	3, 2, 2, 2, 641, 642, 7, 108, 2, 2, 642, 643, 7, 117, 2, 2, 643, 644, 7,
+	113, 2, 2, 644, 645, 7, 112, 2, 2, 645, 646, 7, 85, 2, 2, 646, 647, 7,
+	103, 2, 2, 647, 648, 7, 110, 2, 2, 648, 649, 7, 103, 2, 2, 649, 650, 7,
+	101, 2, 2, 650, 651, 7, 118, 2, 2, 651, 136, 3, 2, 2, 2, 652, 653, 7, 108,
+	2, 2, 653, 654, 7, 117, 2, 2, 654, 655, 7, 113, 2, 2, 655, 656, 7, 112,
+	2, 2, 656, 657, 7, 71, 2, 2, 657, 658, 7, 102, 2, 2, 658, 659, 7, 107,
+	2, 2, 659, 660, 7, 118, 2, 2, 660, 138, 3, 2, 2, 2, 661, 662, 7, 108, 2,
+	2, 662, 663, 7, 117, 2, 2, 663, 664, 7, 113, 2, 2, 664, 665, 7, 112, 2,
+	2, 665, 666, 7, 70, 2, 2, 666, 667, 7, 103, 2, 2, 667, 668, 7, 110, 2,
+	2, 668, 669, 7, 103, 2, 2, 669, 670, 7, 118, 2, 2, 670, 671, 7, 103, 2,
+	2, 671, 140, 3, 2, 2, 2, 672, 673, 7, 123, 2, 2, 673, 674, 7, 99, 2, 2,
+	674, 675, 7, 111, 2, 2, 675, 676, 7, 110, 2, 2, 676, 677, 7, 85, 2, 2,
+	677, 678, 7, 103, 2, 2, 678, 679, 7, 110, 2, 2, 679, 680, 7, 103, 2, 2,
+	680, 681, 7, 101, 2, 2, 681, 682, 7, 118, 2, 2, 682, 142, 3, 2, 2, 2, 683,
+	684, 7, 123, 2, 2, 684, 685, 7, 99, 2, 2, 685, 686, 7, 111, 2, 2, 686,
+	687, 7, 110, 2, 2, 687, 688, 7, 71, 2, 2, 688, 689, 7, 102, 2, 2, 689,
+	690, 7, 107, 2, 2, 690, 691, 7, 118, 2, 2, 691, 144, 3, 2, 2, 2, 692, 693,
+	7, 123, 2, 2, 693, 694, 7, 99, 2, 2, 694, 695, 7, 111, 2, 2, 695, 696,
+	7, 110, 2, 2, 696, 697, 7, 70, 2, 2, 697, 698, 7, 103, 2, 2, 698, 699,
+	7, 110, 2, 2, 699, 700, 7, 103, 2, 2, 700, 701, 7, 118, 2, 2, 701, 702,
+	7, 103, 2, 2, 702, 146, 3, 2, 2, 2, 703, 704, 7, 109, 2, 2, 704, 705, 7,
+	119, 2, 2, 705, 706, 7, 100, 2, 2, 706, 707, 7, 103, 2, 2, 707, 708, 7,
+	76, 2, 2, 708, 709, 7, 117, 2, 2, 709, 710, 7, 113, 2, 2, 710, 711, 7,
+	112, 2, 2, 711, 712, 7, 71, 2, 2, 712, 713, 7, 102, 2, 2, 713, 714, 7,
+	107, 2, 2, 714, 715, 7, 118, 2, 2, 715, 148, 3, 2, 2, 2, 716, 717, 7, 109,
+	2, 2, 717, 718, 7, 119, 2, 2, 718, 719, 7, 100, 2, 2, 719, 720, 7, 103,
+	2, 2, 720, 721, 7, 76, 2, 2, 721, 722, 7, 117, 2, 2, 722, 723, 7, 113,
+	2, 2, 723, 724, 7, 112, 2, 2, 724, 725, 7, 70, 2, 2, 725, 726, 7, 103,
+	2, 2, 726, 727, 7, 110, 2, 2, 727, 728, 7, 103, 2, 2, 728, 729, 7, 118,
+	2, 2, 729, 730, 7, 103, 2, 2, 730, 150, 3, 2, 2, 2, 731, 732, 7, 109, 2,
+	2, 732, 733, 7, 119, 2, 2, 733, 734, 7, 100, 2, 2, 734, 735, 7, 103, 2,
+	2, 735, 736, 7, 91, 2, 2, 736, 737, 7, 99, 2, 2, 737, 738, 7, 111, 2, 2,
+	738, 739, 7, 110, 2, 2, 739, 740, 7, 71, 2, 2, 740, 741, 7, 102, 2, 2,
+	741, 742, 7, 107, 2, 2, 742, 743, 7, 118, 2, 2, 743, 152, 3, 2, 2, 2, 744,
+	745, 7, 109, 2, 2, 745, 746, 7, 119, 2, 2, 746, 747, 7, 100, 2, 2, 747,
+	748, 7, 103, 2, 2, 748, 749, 7, 91, 2, 2, 749, 750, 7, 99, 2, 2, 750, 751,
+	7, 111, 2, 2, 751, 752, 7, 110, 2, 2, 752, 753, 7, 70, 2, 2, 753, 754,
+	7, 103, 2, 2, 754, 755, 7, 110, 2, 2, 755, 756, 7, 103, 2, 2, 756, 757,
+	7, 118, 2, 2, 757, 758, 7, 103, 2, 2, 758, 154, 3, 2, 2, 2, 759, 760, 7,
+	117, 2, 2, 760, 761, 7, 106, 2, 2, 761, 762, 7, 103, 2, 2, 762, 763, 7,
+	110, 2, 2, 763, 764, 7, 110, 2, 2, 764, 765, 7, 85, 2, 2, 765, 766, 7,
+	101, 2, 2, 766, 767, 7, 116, 2, 2, 767, 768, 7, 107, 2, 2, 768, 769, 7,
+	114, 2, 2, 769, 770, 7, 118, 2, 2, 770, 156, 3, 2, 2, 2, 771, 772, 7, 102,
+	2, 2, 772, 773, 7, 113, 2, 2, 773, 774, 7, 121, 2, 2, 774, 775, 7, 112,
+	2, 2, 775, 776, 7, 110, 2, 2, 776, 777, 7, 113, 2, 2, 777, 778, 7, 99,
+	2, 2, 778, 779, 7, 102, 2, 2, 779, 158, 3, 2, 2, 2, 780, 781, 7, 117, 2,
+	2, 781, 782, 7, 110, 2, 2, 782, 783, 7, 103, 2, 2, 783, 784, 7, 103, 2,
+	2, 784, 785, 7, 114, 2, 2, 785, 160, 3, 2, 2, 2, 786, 787, 7, 117, 2, 2,
+	787, 788, 7, 118, 2, 2, 788, 789, 7, 103, 2, 2, 789, 790, 7, 114, 2, 2,
+	790, 791, 7, 75, 2, 2, 791, 792, 7, 112, 2, 2, 792, 793, 7, 104, 2, 2,
+	793, 794, 7, 113, 2, 2, 794, 162, 3, 2, 2, 2, 795, 796, 7, 104, 2, 2, 796,
+	797, 7, 107, 2, 2, 797, 798, 7, 110, 2, 2, 798, 799, 7, 118, 2, 2, 799,
+	800, 7, 103, 2, 2, 800, 801, 7, 116, 2, 2, 801, 164, 3, 2, 2, 2, 802, 803,
+	7, 114, 2, 2, 803, 804, 7, 99, 2, 2, 804, 805, 7, 118, 2, 2, 805, 806,
+	7, 118, 2, 2, 806, 807, 7, 103, 2, 2, 807, 808, 7, 116, 2, 2, 808, 809,
+	7, 112, 2, 2, 809, 166, 3, 2, 2, 2, 810, 811, 7, 104, 2, 2, 811, 812, 7,
+	119, 2, 2, 812, 813, 7, 112, 2, 2, 813, 814, 7, 101, 2, 2, 814, 168, 3,
+	2, 2, 2, 815, 816, 7, 116, 2, 2, 816, 817, 7, 103, 2, 2, 817, 818, 7, 118,
+	2, 2, 818, 819, 7, 119, 2, 2, 819, 820, 7, 116, 2, 2, 820, 821, 7, 112,
+	2, 2, 821, 170, 3, 2, 2, 2, 822, 823, 7, 100, 2, 2, 823, 824, 7, 116, 2,
+	2, 824, 825, 7, 103, 2, 2, 825, 826, 7, 99, 2, 2, 826, 827, 7, 109, 2,
+	2, 827, 172, 3, 2, 2, 2, 828, 829, 7, 118, 2, 2, 829, 830, 7, 116, 2, 2,
+	830, 831, 7, 123, 2, 2, 831, 174, 3, 2, 2, 2, 832, 833, 7, 101, 2, 2, 833,
+	834, 7, 99, 2, 2, 834, 835, 7, 118, 2, 2, 835, 836, 7, 101, 2, 2, 836,
+	837, 7, 106, 2, 2, 837, 176, 3, 2, 2, 2, 838, 839, 7, 104, 2, 2, 839, 840,
+	7, 107, 2, 2, 840, 841, 7, 112, 2, 2, 841, 842, 7, 99, 2, 2, 842, 843,
+	7, 110, 2, 2, 843, 844, 7, 110, 2, 2, 844, 845, 7, 123, 2, 2, 845, 178,
+	3, 2, 2, 2, 846, 847, 7, 118, 2, 2, 847, 848, 7, 106, 2, 2, 848, 849, 7,
+	116, 2, 2, 849, 850, 7, 113, 2, 2, 850, 851, 7, 121, 2, 2, 851, 180, 3,
+	2, 2, 2, 852, 853, 7, 107, 2, 2, 853, 854, 7, 111, 2, 2, 854, 855, 7, 114,
+	2, 2, 855, 856, 7, 113, 2, 2, 856, 857, 7, 116, 2, 2, 857, 858, 7, 118,
+	2, 2, 858, 182, 3, 2, 2, 2, 859, 860, 7, 99, 2, 2, 860, 861, 7, 117, 2,
+	2, 861, 184, 3, 2, 2, 2, 862, 863, 7, 110, 2, 2, 863, 864, 7, 103, 2, 2,
+	864, 865, 7, 118, 2, 2, 865, 186, 3, 2, 2, 2, 866, 867, 7, 101, 2, 2, 867,
+	868, 7, 113, 2, 2, 868, 869, 7, 112, 2, 2, 869, 870, 7, 117, 2, 2, 870,
+	871, 7, 118, 2, 2, 871, 188, 3, 2, 2, 2, 872, 873, 7, 118, 2, 2, 873, 874,
+	7, 107, 2, 2, 874, 875, 7, 111, 2, 2, 875, 876, 7, 103, 2, 2, 876, 877,
+	7, 113, 2, 2, 877, 878, 7, 119, 2, 2, 878, 879, 7, 118, 2, 2, 879, 190,
+	3, 2, 2, 2, 880, 882, 9, 6, 2, 2, 881, 880, 3, 2, 2, 2, 882, 883, 3, 2,
+	2, 2, 883, 881, 3, 2, 2, 2, 883, 884, 3, 2, 2, 2, 884, 888, 3, 2, 2, 2,
+	885, 886, 7, 111, 2, 2, 886, 889, 7, 117, 2, 2, 887, 889, 9, 7, 2, 2, 888,
+	885, 3, 2, 2, 2, 888, 887, 3, 2, 2, 2, 889, 891, 3, 2, 2, 2, 890, 881,
+	3, 2, 2, 2, 891, 892, 3, 2, 2, 2, 892, 890, 3, 2, 2, 2, 892, 893, 3, 2,
+	2, 2, 893, 192, 3, 2, 2, 2, 894, 898, 9, 4, 2, 2, 895, 897, 9, 8, 2, 2,
+	896, 895, 3, 2, 2, 2, 897, 900, 3, 2, 2, 2, 898, 896, 3, 2, 2, 2, 898,
+	899, 3, 2, 2, 2, 899, 194, 3, 2, 2, 2, 900, 898, 3, 2, 2, 2, 901, 903,
+	7, 47, 2, 2, 902, 901, 3, 2, 2, 2, 902, 903, 3, 2, 2, 2, 903, 904, 3, 2,
+	2, 2, 904, 911, 5, 203, 102, 2, 905, 907, 7, 48, 2, 2, 906, 908, 9, 6,
+	2, 2, 907, 906, 3, 2, 2, 2, 908, 909, 3, 2, 2, 2, 909, 907, 3, 2, 2, 2,
+	909, 910, 3, 2, 2, 2, 910, 912, 3, 2, 2, 2, 911, 905, 3, 2, 2, 2, 911,
+	912, 3, 2, 2, 2, 912, 914, 3, 2, 2, 2, 913, 915, 5, 201, 101, 2, 914, 913,
+	3, 2, 2, 2, 914, 915, 3, 2, 2, 2, 915, 196, 3, 2, 2, 2, 916, 918, 10, 9,
+	2, 2, 917, 916, 3, 2, 2, 2, 918, 919, 3, 2, 2, 2, 919, 917, 3, 2, 2, 2,
+	919, 920, 3, 2, 2, 2, 920, 927, 3, 2, 2, 2, 921, 923, 7, 63, 2, 2, 922,
+	924, 7, 63, 2, 2, 923, 922, 3, 2, 2, 2, 923, 924, 3, 2, 2, 2, 924, 928,
+	3, 2, 2, 2, 925, 926, 7, 35, 2, 2, 926, 928, 7, 63, 2, 2, 927, 921, 3,
+	2, 2, 2, 927, 925, 3, 2, 2, 2, 928, 932, 3, 2, 2, 2, 929, 931, 10, 2, 2,
+	2, 930, 929, 3, 2, 2, 2, 931, 934, 3, 2, 2, 2, 932, 930, 3, 2, 2, 2, 932,
+	933, 3, 2, 2, 2, 933, 198, 3, 2, 2, 2, 934, 932, 3, 2, 2, 2, 935, 939,
+	7, 41, 2, 2, 936, 938, 10, 10, 2, 2, 937, 936, 3, 2, 2, 2, 938, 941, 3,
+	2, 2, 2, 939, 937, 3, 2, 2, 2, 939, 940, 3, 2, 2, 2, 940, 942, 3, 2, 2,
+	2, 941, 939, 3, 2, 2, 2, 942, 953, 7, 41, 2, 2, 943, 947, 7, 36, 2, 2,
+	944, 946, 10, 11, 2, 2, 945, 944, 3, 2, 2, 2, 946, 949, 3, 2, 2, 2, 947,
+	945, 3, 2, 2, 2, 947, 948, 3, 2, 2, 2, 948, 950, 3, 2, 2, 2, 949, 947,
+	3, 2, 2, 2, 950, 953, 7, 36, 2, 2, 951, 953, 10, 12, 2, 2, 952, 935, 3,
+	2, 2, 2, 952, 943, 3, 2, 2, 2, 952, 951, 3, 2, 2, 2, 953, 954, 3, 2, 2,
+	2, 954, 952, 3, 2, 2, 2, 954, 955, 3, 2, 2, 2, 955, 200, 3, 2, 2, 2, 956,
+	958, 9, 13, 2, 2, 957, 959, 9, 14, 2, 2, 958, 957, 3, 2, 2, 2, 958, 959,
+	3, 2, 2, 2, 959, 960, 3, 2, 2, 2, 960, 961, 5, 203, 102, 2, 961, 202, 3,
+	2, 2, 2, 962, 971, 7, 50, 2, 2, 963, 967, 9, 15, 2, 2, 964, 966, 9, 6,
+	2, 2, 965, 964, 3, 2, 2, 2, 966, 969, 3, 2, 2, 2, 967, 965, 3, 2, 2, 2,
+	967, 968, 3, 2, 2, 2, 968, 971, 3, 2, 2, 2, 969, 967, 3, 2, 2, 2, 970,
+	962, 3, 2, 2, 2, 970, 963, 3, 2, 2, 2, 971, 204, 3, 2, 2, 2, 972, 976,
+	9, 16, 2, 2, 973, 975, 9, 17, 2, 2, 974, 973, 3, 2, 2, 2, 975, 978, 3,
+	2, 2, 2, 976, 974, 3, 2, 2, 2, 976, 977, 3, 2, 2, 2, 977, 206, 3, 2, 2,
+	2, 978, 976, 3, 2, 2, 2, 979, 983, 7, 98, 2, 2, 980, 982, 10, 18, 2, 2,
+	981, 980, 3, 2, 2, 2, 982, 985, 3, 2, 2, 2, 983, 981, 3, 2, 2, 2, 983,
+	984, 3, 2, 2, 2, 984, 986, 3, 2, 2, 2, 985, 983, 3, 2, 2, 2, 986, 987,
+	7, 98, 2, 2, 987, 208, 3, 2, 2, 2, 988, 993, 7, 36, 2, 2, 989, 992, 5,
+	215, 108, 2, 990, 992, 5, 221, 111, 2, 991, 989, 3, 2, 2, 2, 991, 990,
+	3, 2, 2, 2, 992, 995, 3, 2, 2, 2, 993, 991, 3, 2, 2, 2, 993, 994, 3, 2,
+	2, 2, 994, 996, 3, 2, 2, 2, 995, 993, 3, 2, 2, 2, 996, 1007, 7, 36, 2,
+	2, 997, 1002, 7, 41, 2, 2, 998, 1001, 5, 211, 106, 2, 999, 1001, 5, 213,
+	107, 2, 1000, 998, 3, 2, 2, 2, 1000, 999, 3, 2, 2, 2, 1001, 1004, 3, 2,
+	2, 2, 1002, 1000, 3, 2, 2, 2, 1002, 1003, 3, 2, 2, 2, 1003, 1005, 3, 2,
+	2, 2, 1004, 1002, 3, 2, 2, 2, 1005, 1007, 7, 41, 2, 2, 1006, 988, 3, 2,
+	2, 2, 1006, 997, 3, 2, 2, 2, 1007, 210, 3, 2, 2, 2, 1008, 1011, 7, 94,
+	2, 2, 1009, 1012, 9, 19, 2, 2, 1010, 1012, 5, 217, 109, 2, 1011, 1009,
+	3, 2, 2, 2, 1011, 1010, 3, 2, 2, 2, 1012, 212, 3, 2, 2, 2, 1013, 1014,
+	10, 20, 2, 2, 1014, 214, 3, 2, 2, 2, 1015, 1018, 7, 94, 2, 2, 1016, 1019,
+	9, 21, 2, 2, 1017, 1019, 5, 217, 109, 2, 1018, 1016, 3, 2, 2, 2, 1018,
+	1017, 3, 2, 2, 2, 1019, 216, 3, 2, 2, 2, 1020, 1021, 7, 119, 2, 2, 1021,
+	1022, 5, 219, 110, 2, 1022, 1023, 5, 219, 110, 2, 1023, 1024, 5, 219, 110,
+	2, 1024, 1025, 5, 219, 110, 2, 1025, 218, 3, 2, 2, 2, 1026, 1027, 9, 22,
+	2, 2, 1027, 220, 3, 2, 2, 2, 1028, 1029, 10, 23, 2, 2, 1029, 222, 3, 2,
+	2, 2, 1030, 1034, 7, 37, 2, 2, 1031, 1033, 10, 24, 2, 2, 1032, 1031, 3,
+	2, 2, 2, 1033, 1036, 3, 2, 2, 2, 1034, 1032, 3, 2, 2, 2, 1034, 1035, 3,
+	2, 2, 2, 1035, 1037, 3, 2, 2, 2, 1036, 1034, 3, 2, 2, 2, 1037, 1038, 8,
+	112, 2, 2, 1038, 224, 3, 2, 2, 2, 1039, 1041, 9, 25, 2, 2, 1040, 1039,
+	3, 2, 2, 2, 1041, 1042, 3, 2, 2, 2, 1042, 1040, 3, 2, 2, 2, 1042, 1043,
+	3, 2, 2, 2, 1043, 1044, 3, 2, 2, 2, 1044, 1045, 8, 113, 3, 2, 1045, 226,
+	3, 2, 2, 2, 47, 2, 426, 456, 461, 464, 489, 492, 508, 547, 567, 572, 575,
+	581, 586, 600, 883, 888, 892, 898, 902, 909, 911, 914, 919, 923, 927, 932,
+	939, 947, 952, 954, 958, 967, 970, 976, 983, 991, 993, 1000, 1002, 1006,
+	1011, 1018, 1034, 1042, 4, 2, 3, 2, 8, 2, 2,
 }
 
 var lexerDeserializer = antlr.NewATNDeserializer(nil)
@@ -305,41 +503,55 @@ var lexerModeNames = []string{
 }
 
 var lexerLiteralNames = []string{
-	"", "'\"asObject\"'", "':'", "'['", "']'", "'null'", "'||'", "'&&'", "'=='",
+	"", "'\"asObject\"'", "'['", "']'", "':'", "'null'", "'||'", "'&&'", "'=='",
 	"'!='", "'>'", "'<'", "'>='", "'<='", "'+'", "'-'", "'*'", "'/'", "'%'",
-	"'^'", "'!'", "';'", "'='", "'('", "')'", "'{'", "'}'", "','", "'true'",
-	"'false'", "'nil'", "'if'", "'else'", "'while'", "'log'", "'kubectl'",
-	"'apply'", "'patch'", "'get'", "'replace'", "'delete'", "'-n'", "'--type'",
-	"'-p'", "'-u'", "'-jsonpath'", "'load'", "'exit'", "'jsonSelect'", "'jsonEdit'",
-	"'jsonDelete'", "'yamlSelect'", "'yamlEdit'", "'yamlDelete'", "'kubeJsonEdit'",
-	"'kubeJsonDelete'", "'kubeYamlEdit'", "'kubeYamlDelete'", "'shellScript'",
-	"'download'", "'sleep'", "'stepInfo'", "'filter'", "'pattern'",
+	"'^'", "'!'", "';'", "'='", "'('", "')'", "'{'", "'}'", "','", "'.'", "'true'",
+	"'false'", "'nil'", "'if'", "'else'", "'while'", "'for'", "'in'", "'log'",
+	"'kubectl'", "'apply'", "'patch'", "'get'", "'replace'", "'delete'", "'wait'",
+	"'rollout'", "'create'", "'scale'", "'-n'", "'--type'", "", "'--for'",
+	"'--timeout'", "", "", "", "'--field-selector'", "", "'--all'", "", "",
+	"'--replicas'", "'-p'", "'-u'", "'-jsonpath'", "'load'", "'exit'", "'jsonSelect'",
+	"'jsonEdit'", "'jsonDelete'", "'yamlSelect'", "'yamlEdit'", "'yamlDelete'",
+	"'kubeJsonEdit'", "'kubeJsonDelete'", "'kubeYamlEdit'", "'kubeYamlDelete'",
+	"'shellScript'", "'download'", "'sleep'", "'stepInfo'", "'filter'", "'pattern'",
+	"'func'", "'return'", "'break'", "'try'", "'catch'", "'finally'", "'throw'",
+	"'import'", "'as'", "'let'", "'const'", "'timeout'",
 }
 
 var lexerSymbolicNames = []string{
 	"", "", "", "", "", "", "OR", "AND", "EQ", "NEQ", "GT", "LT", "GTEQ", "LTEQ",
 	"PLUS", "MINUS", "MULT", "DIV", "MOD", "POW", "NOT", "SCOL", "ASSIGN",
-	"OPAR", "CPAR", "OBRACE", "CBRACE", "COMMA", "TRUE", "FALSE", "NIL", "IF",
-	"ELSE", "WHILE", "LOG", "KUBECTL", "APPLY", "PATCH", "GET", "REPLACE",
-	"DELETE", "NAMESPACE", "PATCHTYPE", "PATCHLOAD", "UPDATELOAD", "JSONPATH",
+	"OPAR", "CPAR", "OBRACE", "CBRACE", "COMMA", "DOT", "TRUE", "FALSE", "NIL",
+	"IF", "ELSE", "WHILE", "FOR", "IN", "LOG", "KUBECTL", "APPLY", "PATCH",
+	"GET", "REPLACE", "DELETE", "WAIT", "ROLLOUT", "CREATE", "SCALE", "NAMESPACE",
+	"PATCHTYPE", "FORCONDITION", "WAITFOR", "WAITTIMEOUT", "LABELSELECTOR",
+	"FIELDSELECTOR", "SELECTOR", "FIELDSELECTORFLAG", "ALLNAMESPACES", "ALL",
+	"OUTPUTFORMAT", "OUTPUT", "REPLICAS", "PATCHLOAD", "UPDATELOAD", "JSONPATH",
 	"LOAD", "EXIT", "JSONSELECT", "JSONEDIT", "JSONDELETE", "YAMLSELECT", "YAMLEDIT",
 	"YAMLDELETE", "KUBEJSONEDIT", "KUBEJSONDELETE", "KUBEYAMLEDIT", "KUBEYAMLDELETE",
-	"SHELLSCRIPT", "DOWNLOAD", "SLEEP", "STEPINFO", "FILTER", "PATTERN", "ID",
-	"NUMBER", "PATH", "RAW_STRING_LIT", "STRING", "COMMENT", "SPACE", "OTHER",
+	"SHELLSCRIPT", "DOWNLOAD", "SLEEP", "STEPINFO", "FILTER", "PATTERN", "FUNC",
+	"RETURN", "BREAK", "TRY", "CATCH", "FINALLY", "THROW", "IMPORT", "AS",
+	"LET", "CONST", "TIMEOUT", "DURATION", "ID", "NUMBER", "PATH", "RAW_STRING_LIT",
+	"STRING", "COMMENT", "SPACE",
 }
 
 var lexerRuleNames = []string{
 	"T__0", "T__1", "T__2", "T__3", "T__4", "OR", "AND", "EQ", "NEQ", "GT",
 	"LT", "GTEQ", "LTEQ", "PLUS", "MINUS", "MULT", "DIV", "MOD", "POW", "NOT",
-	"SCOL", "ASSIGN", "OPAR", "CPAR", "OBRACE", "CBRACE", "COMMA", "TRUE",
-	"FALSE", "NIL", "IF", "ELSE", "WHILE", "LOG", "KUBECTL", "APPLY", "PATCH",
-	"GET", "REPLACE", "DELETE", "NAMESPACE", "PATCHTYPE", "PATCHLOAD", "UPDATELOAD",
-	"JSONPATH", "LOAD", "EXIT", "JSONSELECT", "JSONEDIT", "JSONDELETE", "YAMLSELECT",
-	"YAMLEDIT", "YAMLDELETE", "KUBEJSONEDIT", "KUBEJSONDELETE", "KUBEYAMLEDIT",
-	"KUBEYAMLDELETE", "SHELLSCRIPT", "DOWNLOAD", "SLEEP", "STEPINFO", "FILTER",
-	"PATTERN", "ID", "NUMBER", "EXP", "INT", "PATH", "RAW_STRING_LIT", "STRING",
-	"ESCQUOTE", "SAFECODEPOINTQUOTE", "ESC", "UNICODE", "HEX", "SAFECODEPOINT",
-	"COMMENT", "SPACE", "OTHER",
+	"SCOL", "ASSIGN", "OPAR", "CPAR", "OBRACE", "CBRACE", "COMMA", "DOT", "TRUE",
+	"FALSE", "NIL", "IF", "ELSE", "WHILE", "FOR", "IN", "LOG", "KUBECTL", "APPLY",
+	"PATCH", "GET", "REPLACE", "DELETE", "WAIT", "ROLLOUT", "CREATE", "SCALE",
+	"NAMESPACE", "PATCHTYPE", "FORCONDITION", "WAITFOR", "WAITTIMEOUT", "LABELSELECTOR",
+	"FIELDSELECTOR", "SELECTOR", "FIELDSELECTORFLAG", "ALLNAMESPACES", "ALL",
+	"OUTPUTFORMAT", "OUTPUT", "REPLICAS", "PATCHLOAD", "UPDATELOAD", "JSONPATH",
+	"LOAD", "EXIT", "JSONSELECT", "JSONEDIT", "JSONDELETE", "YAMLSELECT", "YAMLEDIT",
+	"YAMLDELETE", "KUBEJSONEDIT", "KUBEJSONDELETE", "KUBEYAMLEDIT", "KUBEYAMLDELETE",
+	"SHELLSCRIPT", "DOWNLOAD", "SLEEP", "STEPINFO", "FILTER", "PATTERN", "FUNC",
+	"RETURN", "BREAK", "TRY", "CATCH", "FINALLY", "THROW", "IMPORT", "AS",
+	"LET", "CONST", "TIMEOUT", "DURATION", "ID", "NUMBER", "SELECTOR_REQUIREMENT",
+	"OUTPUT_SPEC", "EXP", "INT", "PATH", "RAW_STRING_LIT", "STRING", "ESCQUOTE",
+	"SAFECODEPOINTQUOTE", "ESC", "UNICODE", "HEX", "SAFECODEPOINT", "COMMENT",
+	"SPACE",
 }
 
 type KlangLexer struct {
@@ -375,77 +587,180 @@ func NewKlangLexer(input antlr.CharStream) *KlangLexer {
 	return l
 }
 
+func (l *KlangLexer) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
+	switch ruleIndex {
+	case 52:
+		return l.LABELSELECTOR_Sempred(localctx, predIndex)
+
+	case 54:
+		return l.SELECTOR_Sempred(localctx, predIndex)
+
+	case 56:
+		return l.ALLNAMESPACES_Sempred(localctx, predIndex)
+
+	case 58:
+		return l.OUTPUTFORMAT_Sempred(localctx, predIndex)
+
+	case 59:
+		return l.OUTPUT_Sempred(localctx, predIndex)
+
+	default:
+		panic("No registered predicate for: " + fmt.Sprint(ruleIndex))
+	}
+}
+
+func (l *KlangLexer) LABELSELECTOR_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 0:
+		return l.inKubectl()
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
+
+func (l *KlangLexer) SELECTOR_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 1:
+		return l.endsFlag() && l.inKubectl()
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
+
+func (l *KlangLexer) ALLNAMESPACES_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 2:
+		return l.endsFlag() && l.inKubectl()
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
+
+func (l *KlangLexer) OUTPUTFORMAT_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 3:
+		return l.inKubectl()
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
+
+func (l *KlangLexer) OUTPUT_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 4:
+		return l.endsFlag() && l.inKubectl()
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
+
 // KlangLexer tokens.
 const (
-	KlangLexerT__0           = 1
-	KlangLexerT__1           = 2
-	KlangLexerT__2           = 3
-	KlangLexerT__3           = 4
-	KlangLexerT__4           = 5
-	KlangLexerOR             = 6
-	KlangLexerAND            = 7
-	KlangLexerEQ             = 8
-	KlangLexerNEQ            = 9
-	KlangLexerGT             = 10
-	KlangLexerLT             = 11
-	KlangLexerGTEQ           = 12
-	KlangLexerLTEQ           = 13
-	KlangLexerPLUS           = 14
-	KlangLexerMINUS          = 15
-	KlangLexerMULT           = 16
-	KlangLexerDIV            = 17
-	KlangLexerMOD            = 18
-	KlangLexerPOW            = 19
-	KlangLexerNOT            = 20
-	KlangLexerSCOL           = 21
-	KlangLexerASSIGN         = 22
-	KlangLexerOPAR           = 23
-	KlangLexerCPAR           = 24
-	KlangLexerOBRACE         = 25
-	KlangLexerCBRACE         = 26
-	KlangLexerCOMMA          = 27
-	KlangLexerTRUE           = 28
-	KlangLexerFALSE          = 29
-	KlangLexerNIL            = 30
-	KlangLexerIF             = 31
-	KlangLexerELSE           = 32
-	KlangLexerWHILE          = 33
-	KlangLexerLOG            = 34
-	KlangLexerKUBECTL        = 35
-	KlangLexerAPPLY          = 36
-	KlangLexerPATCH          = 37
-	KlangLexerGET            = 38
-	KlangLexerREPLACE        = 39
-	KlangLexerDELETE         = 40
-	KlangLexerNAMESPACE      = 41
-	KlangLexerPATCHTYPE      = 42
-	KlangLexerPATCHLOAD      = 43
-	KlangLexerUPDATELOAD     = 44
-	KlangLexerJSONPATH       = 45
-	KlangLexerLOAD           = 46
-	KlangLexerEXIT           = 47
-	KlangLexerJSONSELECT     = 48
-	KlangLexerJSONEDIT       = 49
-	KlangLexerJSONDELETE     = 50
-	KlangLexerYAMLSELECT     = 51
-	KlangLexerYAMLEDIT       = 52
-	KlangLexerYAMLDELETE     = 53
-	KlangLexerKUBEJSONEDIT   = 54
-	KlangLexerKUBEJSONDELETE = 55
-	KlangLexerKUBEYAMLEDIT   = 56
-	KlangLexerKUBEYAMLDELETE = 57
-	KlangLexerSHELLSCRIPT    = 58
-	KlangLexerDOWNLOAD       = 59
-	KlangLexerSLEEP          = 60
-	KlangLexerSTEPINFO       = 61
-	KlangLexerFILTER         = 62
-	KlangLexerPATTERN        = 63
-	KlangLexerID             = 64
-	KlangLexerNUMBER         = 65
-	KlangLexerPATH           = 66
-	KlangLexerRAW_STRING_LIT = 67
-	KlangLexerSTRING         = 68
-	KlangLexerCOMMENT        = 69
-	KlangLexerSPACE          = 70
-	KlangLexerOTHER          = 71
+	KlangLexerT__0              = 1
+	KlangLexerT__1              = 2
+	KlangLexerT__2              = 3
+	KlangLexerT__3              = 4
+	KlangLexerT__4              = 5
+	KlangLexerOR                = 6
+	KlangLexerAND               = 7
+	KlangLexerEQ                = 8
+	KlangLexerNEQ               = 9
+	KlangLexerGT                = 10
+	KlangLexerLT                = 11
+	KlangLexerGTEQ              = 12
+	KlangLexerLTEQ              = 13
+	KlangLexerPLUS              = 14
+	KlangLexerMINUS             = 15
+	KlangLexerMULT              = 16
+	KlangLexerDIV               = 17
+	KlangLexerMOD               = 18
+	KlangLexerPOW               = 19
+	KlangLexerNOT               = 20
+	KlangLexerSCOL              = 21
+	KlangLexerASSIGN            = 22
+	KlangLexerOPAR              = 23
+	KlangLexerCPAR              = 24
+	KlangLexerOBRACE            = 25
+	KlangLexerCBRACE            = 26
+	KlangLexerCOMMA             = 27
+	KlangLexerDOT               = 28
+	KlangLexerTRUE              = 29
+	KlangLexerFALSE             = 30
+	KlangLexerNIL               = 31
+	KlangLexerIF                = 32
+	KlangLexerELSE              = 33
+	KlangLexerWHILE             = 34
+	KlangLexerFOR               = 35
+	KlangLexerIN                = 36
+	KlangLexerLOG               = 37
+	KlangLexerKUBECTL           = 38
+	KlangLexerAPPLY             = 39
+	KlangLexerPATCH             = 40
+	KlangLexerGET               = 41
+	KlangLexerREPLACE           = 42
+	KlangLexerDELETE            = 43
+	KlangLexerWAIT              = 44
+	KlangLexerROLLOUT           = 45
+	KlangLexerCREATE            = 46
+	KlangLexerSCALE             = 47
+	KlangLexerNAMESPACE         = 48
+	KlangLexerPATCHTYPE         = 49
+	KlangLexerFORCONDITION      = 50
+	KlangLexerWAITFOR           = 51
+	KlangLexerWAITTIMEOUT       = 52
+	KlangLexerLABELSELECTOR     = 53
+	KlangLexerFIELDSELECTOR     = 54
+	KlangLexerSELECTOR          = 55
+	KlangLexerFIELDSELECTORFLAG = 56
+	KlangLexerALLNAMESPACES     = 57
+	KlangLexerALL               = 58
+	KlangLexerOUTPUTFORMAT      = 59
+	KlangLexerOUTPUT            = 60
+	KlangLexerREPLICAS          = 61
+	KlangLexerPATCHLOAD         = 62
+	KlangLexerUPDATELOAD        = 63
+	KlangLexerJSONPATH          = 64
+	KlangLexerLOAD              = 65
+	KlangLexerEXIT              = 66
+	KlangLexerJSONSELECT        = 67
+	KlangLexerJSONEDIT          = 68
+	KlangLexerJSONDELETE        = 69
+	KlangLexerYAMLSELECT        = 70
+	KlangLexerYAMLEDIT          = 71
+	KlangLexerYAMLDELETE        = 72
+	KlangLexerKUBEJSONEDIT      = 73
+	KlangLexerKUBEJSONDELETE    = 74
+	KlangLexerKUBEYAMLEDIT      = 75
+	KlangLexerKUBEYAMLDELETE    = 76
+	KlangLexerSHELLSCRIPT       = 77
+	KlangLexerDOWNLOAD          = 78
+	KlangLexerSLEEP             = 79
+	KlangLexerSTEPINFO          = 80
+	KlangLexerFILTER            = 81
+	KlangLexerPATTERN           = 82
+	KlangLexerFUNC              = 83
+	KlangLexerRETURN            = 84
+	KlangLexerBREAK             = 85
+	KlangLexerTRY               = 86
+	KlangLexerCATCH             = 87
+	KlangLexerFINALLY           = 88
+	KlangLexerTHROW             = 89
+	KlangLexerIMPORT            = 90
+	KlangLexerAS                = 91
+	KlangLexerLET               = 92
+	KlangLexerCONST             = 93
+	KlangLexerTIMEOUT           = 94
+	KlangLexerDURATION          = 95
+	KlangLexerID                = 96
+	KlangLexerNUMBER            = 97
+	KlangLexerPATH              = 98
+	KlangLexerRAW_STRING_LIT    = 99
+	KlangLexerSTRING            = 100
+	KlangLexerCOMMENT           = 101
+	KlangLexerSPACE             = 102
 )
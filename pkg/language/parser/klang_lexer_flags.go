@@ -0,0 +1,68 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parser
+
+import "github.com/antlr/antlr4/runtime/Go/antlr"
+
+// endsFlag is the predicate of the short kubectl flags -l, -o and -A. They are only flags if they are not
+// followed by the rest of a name, kubectl get pods -limit is not a selector.
+func (l *KlangLexer) endsFlag() bool {
+	c := l.GetInputStream().LA(1)
+	return !(c == '_' || c == '-' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z')
+}
+
+// inKubectl is the predicate of the kubectl flags which also read as subtractions, they are only flags in the
+// statement of a kubectl command so that total -l, 10 -A and 10 -o stay subtractions. The statement is found by scanning
+// the script up to the flag, skipping strings and comments.
+func (l *KlangLexer) inKubectl() bool {
+	start := l.TokenStartCharIndex
+	if start <= 0 {
+		return false
+	}
+	text := []rune(l.GetInputStream().GetTextFromInterval(antlr.NewInterval(0, start-1)))
+	kubectl := false
+	for i := 0; i < len(text); i++ {
+		switch c := text[i]; {
+		case c == '"' || c == '\'' || c == '`':
+			for i++; i < len(text) && text[i] != c; i++ {
+				if text[i] == '\\' && c != '`' {
+					i++
+				}
+			}
+		case c == '#':
+			for i < len(text) && text[i] != '\n' {
+				i++
+			}
+		case c == ';' || c == '{' || c == '}':
+			kubectl = false
+		case isNameChar(c):
+			j := i
+			for j < len(text) && isNameChar(text[j]) {
+				j++
+			}
+			if string(text[i:j]) == "kubectl" {
+				kubectl = true
+			}
+			i = j - 1
+		}
+	}
+	return kubectl
+}
+
+func isNameChar(c rune) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
@@ -64,9 +64,51 @@ type KlangListener interface {
 	// EnterWhile_stat is called when entering the while_stat production.
 	EnterWhile_stat(c *While_statContext)
 
+	// EnterFor_stat is called when entering the for_stat production.
+	EnterFor_stat(c *For_statContext)
+
 	// EnterLog is called when entering the log production.
 	EnterLog(c *LogContext)
 
+	// EnterFunc_decl is called when entering the func_decl production.
+	EnterFunc_decl(c *Func_declContext)
+
+	// EnterParam_list is called when entering the param_list production.
+	EnterParam_list(c *Param_listContext)
+
+	// EnterReturn_stat is called when entering the return_stat production.
+	EnterReturn_stat(c *Return_statContext)
+
+	// EnterBreak_stat is called when entering the break_stat production.
+	EnterBreak_stat(c *Break_statContext)
+
+	// EnterCall_stat is called when entering the call_stat production.
+	EnterCall_stat(c *Call_statContext)
+
+	// EnterTry_stat is called when entering the try_stat production.
+	EnterTry_stat(c *Try_statContext)
+
+	// EnterCatch_clause is called when entering the catch_clause production.
+	EnterCatch_clause(c *Catch_clauseContext)
+
+	// EnterFinally_clause is called when entering the finally_clause production.
+	EnterFinally_clause(c *Finally_clauseContext)
+
+	// EnterThrow_stat is called when entering the throw_stat production.
+	EnterThrow_stat(c *Throw_statContext)
+
+	// EnterImport_stat is called when entering the import_stat production.
+	EnterImport_stat(c *Import_statContext)
+
+	// EnterTimeout_stat is called when entering the timeout_stat production.
+	EnterTimeout_stat(c *Timeout_statContext)
+
+	// EnterFunc_call is called when entering the func_call production.
+	EnterFunc_call(c *Func_callContext)
+
+	// EnterFunc_name is called when entering the func_name production.
+	EnterFunc_name(c *Func_nameContext)
+
 	// EnterApplyKubectlCommand is called when entering the applyKubectlCommand production.
 	EnterApplyKubectlCommand(c *ApplyKubectlCommandContext)
 
@@ -76,9 +118,24 @@ type KlangListener interface {
 	// EnterGetKubectlCommand is called when entering the getKubectlCommand production.
 	EnterGetKubectlCommand(c *GetKubectlCommandContext)
 
+	// EnterWaitKubectlCommand is called when entering the waitKubectlCommand production.
+	EnterWaitKubectlCommand(c *WaitKubectlCommandContext)
+
 	// EnterDeleteKubectlCommand is called when entering the deleteKubectlCommand production.
 	EnterDeleteKubectlCommand(c *DeleteKubectlCommandContext)
 
+	// EnterCreateKubectlCommand is called when entering the createKubectlCommand production.
+	EnterCreateKubectlCommand(c *CreateKubectlCommandContext)
+
+	// EnterReplaceKubectlCommand is called when entering the replaceKubectlCommand production.
+	EnterReplaceKubectlCommand(c *ReplaceKubectlCommandContext)
+
+	// EnterScaleKubectlCommand is called when entering the scaleKubectlCommand production.
+	EnterScaleKubectlCommand(c *ScaleKubectlCommandContext)
+
+	// EnterRolloutKubectlCommand is called when entering the rolloutKubectlCommand production.
+	EnterRolloutKubectlCommand(c *RolloutKubectlCommandContext)
+
 	// EnterDownload_fn is called when entering the download_fn production.
 	EnterDownload_fn(c *Download_fnContext)
 
@@ -97,6 +154,12 @@ type KlangListener interface {
 	// EnterNs is called when entering the ns production.
 	EnterNs(c *NsContext)
 
+	// EnterOutput is called when entering the output production.
+	EnterOutput(c *OutputContext)
+
+	// EnterSelector is called when entering the selector production.
+	EnterSelector(c *SelectorContext)
+
 	// EnterAsObject is called when entering the asObject production.
 	EnterAsObject(c *AsObjectContext)
 
@@ -124,6 +187,9 @@ type KlangListener interface {
 	// EnterShellScript is called when entering the shellScript production.
 	EnterShellScript(c *ShellScriptContext)
 
+	// EnterMemberExpr is called when entering the memberExpr production.
+	EnterMemberExpr(c *MemberExprContext)
+
 	// EnterYamlSelectFn is called when entering the yamlSelectFn production.
 	EnterYamlSelectFn(c *YamlSelectFnContext)
 
@@ -133,12 +199,18 @@ type KlangListener interface {
 	// EnterOrExpr is called when entering the orExpr production.
 	EnterOrExpr(c *OrExprContext)
 
+	// EnterFuncCallExpr is called when entering the funcCallExpr production.
+	EnterFuncCallExpr(c *FuncCallExprContext)
+
 	// EnterAdditiveExpr is called when entering the additiveExpr production.
 	EnterAdditiveExpr(c *AdditiveExprContext)
 
 	// EnterRelationalExpr is called when entering the relationalExpr production.
 	EnterRelationalExpr(c *RelationalExprContext)
 
+	// EnterIndexExpr is called when entering the indexExpr production.
+	EnterIndexExpr(c *IndexExprContext)
+
 	// EnterNotExpr is called when entering the notExpr production.
 	EnterNotExpr(c *NotExprContext)
 
@@ -169,6 +241,9 @@ type KlangListener interface {
 	// EnterNumberAtom is called when entering the numberAtom production.
 	EnterNumberAtom(c *NumberAtomContext)
 
+	// EnterDurationAtom is called when entering the durationAtom production.
+	EnterDurationAtom(c *DurationAtomContext)
+
 	// EnterBooleanAtom is called when entering the booleanAtom production.
 	EnterBooleanAtom(c *BooleanAtomContext)
 
@@ -181,12 +256,30 @@ type KlangListener interface {
 	// EnterStringAtom is called when entering the stringAtom production.
 	EnterStringAtom(c *StringAtomContext)
 
+	// EnterListAtom is called when entering the listAtom production.
+	EnterListAtom(c *ListAtomContext)
+
+	// EnterMapAtom is called when entering the mapAtom production.
+	EnterMapAtom(c *MapAtomContext)
+
 	// EnterJsonAtom is called when entering the jsonAtom production.
 	EnterJsonAtom(c *JsonAtomContext)
 
+	// EnterPathAtom is called when entering the pathAtom production.
+	EnterPathAtom(c *PathAtomContext)
+
 	// EnterNilAtom is called when entering the nilAtom production.
 	EnterNilAtom(c *NilAtomContext)
 
+	// EnterList_literal is called when entering the list_literal production.
+	EnterList_literal(c *List_literalContext)
+
+	// EnterMap_literal is called when entering the map_literal production.
+	EnterMap_literal(c *Map_literalContext)
+
+	// EnterMap_entry is called when entering the map_entry production.
+	EnterMap_entry(c *Map_entryContext)
+
 	// EnterJson is called when entering the json production.
 	EnterJson(c *JsonContext)
 
@@ -259,9 +352,51 @@ type KlangListener interface {
 	// ExitWhile_stat is called when exiting the while_stat production.
 	ExitWhile_stat(c *While_statContext)
 
+	// ExitFor_stat is called when exiting the for_stat production.
+	ExitFor_stat(c *For_statContext)
+
 	// ExitLog is called when exiting the log production.
 	ExitLog(c *LogContext)
 
+	// ExitFunc_decl is called when exiting the func_decl production.
+	ExitFunc_decl(c *Func_declContext)
+
+	// ExitParam_list is called when exiting the param_list production.
+	ExitParam_list(c *Param_listContext)
+
+	// ExitReturn_stat is called when exiting the return_stat production.
+	ExitReturn_stat(c *Return_statContext)
+
+	// ExitBreak_stat is called when exiting the break_stat production.
+	ExitBreak_stat(c *Break_statContext)
+
+	// ExitCall_stat is called when exiting the call_stat production.
+	ExitCall_stat(c *Call_statContext)
+
+	// ExitTry_stat is called when exiting the try_stat production.
+	ExitTry_stat(c *Try_statContext)
+
+	// ExitCatch_clause is called when exiting the catch_clause production.
+	ExitCatch_clause(c *Catch_clauseContext)
+
+	// ExitFinally_clause is called when exiting the finally_clause production.
+	ExitFinally_clause(c *Finally_clauseContext)
+
+	// ExitThrow_stat is called when exiting the throw_stat production.
+	ExitThrow_stat(c *Throw_statContext)
+
+	// ExitImport_stat is called when exiting the import_stat production.
+	ExitImport_stat(c *Import_statContext)
+
+	// ExitTimeout_stat is called when exiting the timeout_stat production.
+	ExitTimeout_stat(c *Timeout_statContext)
+
+	// ExitFunc_call is called when exiting the func_call production.
+	ExitFunc_call(c *Func_callContext)
+
+	// ExitFunc_name is called when exiting the func_name production.
+	ExitFunc_name(c *Func_nameContext)
+
 	// ExitApplyKubectlCommand is called when exiting the applyKubectlCommand production.
 	ExitApplyKubectlCommand(c *ApplyKubectlCommandContext)
 
@@ -271,9 +406,24 @@ type KlangListener interface {
 	// ExitGetKubectlCommand is called when exiting the getKubectlCommand production.
 	ExitGetKubectlCommand(c *GetKubectlCommandContext)
 
+	// ExitWaitKubectlCommand is called when exiting the waitKubectlCommand production.
+	ExitWaitKubectlCommand(c *WaitKubectlCommandContext)
+
 	// ExitDeleteKubectlCommand is called when exiting the deleteKubectlCommand production.
 	ExitDeleteKubectlCommand(c *DeleteKubectlCommandContext)
 
+	// ExitCreateKubectlCommand is called when exiting the createKubectlCommand production.
+	ExitCreateKubectlCommand(c *CreateKubectlCommandContext)
+
+	// ExitReplaceKubectlCommand is called when exiting the replaceKubectlCommand production.
+	ExitReplaceKubectlCommand(c *ReplaceKubectlCommandContext)
+
+	// ExitScaleKubectlCommand is called when exiting the scaleKubectlCommand production.
+	ExitScaleKubectlCommand(c *ScaleKubectlCommandContext)
+
+	// ExitRolloutKubectlCommand is called when exiting the rolloutKubectlCommand production.
+	ExitRolloutKubectlCommand(c *RolloutKubectlCommandContext)
+
 	// ExitDownload_fn is called when exiting the download_fn production.
 	ExitDownload_fn(c *Download_fnContext)
 
@@ -292,6 +442,12 @@ type KlangListener interface {
 	// ExitNs is called when exiting the ns production.
 	ExitNs(c *NsContext)
 
+	// ExitOutput is called when exiting the output production.
+	ExitOutput(c *OutputContext)
+
+	// ExitSelector is called when exiting the selector production.
+	ExitSelector(c *SelectorContext)
+
 	// ExitAsObject is called when exiting the asObject production.
 	ExitAsObject(c *AsObjectContext)
 
@@ -319,6 +475,9 @@ type KlangListener interface {
 	// ExitShellScript is called when exiting the shellScript production.
 	ExitShellScript(c *ShellScriptContext)
 
+	// ExitMemberExpr is called when exiting the memberExpr production.
+	ExitMemberExpr(c *MemberExprContext)
+
 	// ExitYamlSelectFn is called when exiting the yamlSelectFn production.
 	ExitYamlSelectFn(c *YamlSelectFnContext)
 
@@ -328,12 +487,18 @@ type KlangListener interface {
 	// ExitOrExpr is called when exiting the orExpr production.
 	ExitOrExpr(c *OrExprContext)
 
+	// ExitFuncCallExpr is called when exiting the funcCallExpr production.
+	ExitFuncCallExpr(c *FuncCallExprContext)
+
 	// ExitAdditiveExpr is called when exiting the additiveExpr production.
 	ExitAdditiveExpr(c *AdditiveExprContext)
 
 	// ExitRelationalExpr is called when exiting the relationalExpr production.
 	ExitRelationalExpr(c *RelationalExprContext)
 
+	// ExitIndexExpr is called when exiting the indexExpr production.
+	ExitIndexExpr(c *IndexExprContext)
+
 	// ExitNotExpr is called when exiting the notExpr production.
 	ExitNotExpr(c *NotExprContext)
 
@@ -364,6 +529,9 @@ type KlangListener interface {
 	// ExitNumberAtom is called when exiting the numberAtom production.
 	ExitNumberAtom(c *NumberAtomContext)
 
+	// ExitDurationAtom is called when exiting the durationAtom production.
+	ExitDurationAtom(c *DurationAtomContext)
+
 	// ExitBooleanAtom is called when exiting the booleanAtom production.
 	ExitBooleanAtom(c *BooleanAtomContext)
 
@@ -376,12 +544,30 @@ type KlangListener interface {
 	// ExitStringAtom is called when exiting the stringAtom production.
 	ExitStringAtom(c *StringAtomContext)
 
+	// ExitListAtom is called when exiting the listAtom production.
+	ExitListAtom(c *ListAtomContext)
+
+	// ExitMapAtom is called when exiting the mapAtom production.
+	ExitMapAtom(c *MapAtomContext)
+
 	// ExitJsonAtom is called when exiting the jsonAtom production.
 	ExitJsonAtom(c *JsonAtomContext)
 
+	// ExitPathAtom is called when exiting the pathAtom production.
+	ExitPathAtom(c *PathAtomContext)
+
 	// ExitNilAtom is called when exiting the nilAtom production.
 	ExitNilAtom(c *NilAtomContext)
 
+	// ExitList_literal is called when exiting the list_literal production.
+	ExitList_literal(c *List_literalContext)
+
+	// ExitMap_literal is called when exiting the map_literal production.
+	ExitMap_literal(c *Map_literalContext)
+
+	// ExitMap_entry is called when exiting the map_entry production.
+	ExitMap_entry(c *Map_entryContext)
+
 	// ExitJson is called when exiting the json production.
 	ExitJson(c *JsonContext)
 
@@ -15,7 +15,7 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 73, 534,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 104, 826,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
@@ -23,274 +23,429 @@ var parserATN = []uint16{
 	4, 24, 9, 24, 4, 25, 9, 25, 4, 26, 9, 26, 4, 27, 9, 27, 4, 28, 9, 28, 4,
 	29, 9, 29, 4, 30, 9, 30, 4, 31, 9, 31, 4, 32, 9, 32, 4, 33, 9, 33, 4, 34,
 	9, 34, 4, 35, 9, 35, 4, 36, 9, 36, 4, 37, 9, 37, 4, 38, 9, 38, 4, 39, 9,
-	39, 4, 40, 9, 40, 4, 41, 9, 41, 4, 42, 9, 42, 3, 2, 3, 2, 3, 2, 3, 3, 7,
-	3, 89, 10, 3, 12, 3, 14, 3, 92, 11, 3, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3,
-	4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 5, 4, 110,
-	10, 4, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 5, 5,
-	122, 10, 5, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7,
-	3, 7, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 9,
-	3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 5, 9, 154, 10, 9, 3, 9,
-	3, 9, 3, 9, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 5, 10, 166,
-	10, 10, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11,
-	3, 11, 3, 11, 3, 11, 5, 11, 180, 10, 11, 3, 11, 3, 11, 5, 11, 184, 10,
-	11, 3, 11, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12,
+	39, 4, 40, 9, 40, 4, 41, 9, 41, 4, 42, 9, 42, 4, 43, 9, 43, 4, 44, 9, 44,
+	4, 45, 9, 45, 4, 46, 9, 46, 4, 47, 9, 47, 4, 48, 9, 48, 4, 49, 9, 49, 4,
+	50, 9, 50, 4, 51, 9, 51, 4, 52, 9, 52, 4, 53, 9, 53, 4, 54, 9, 54, 4, 55,
+	9, 55, 4, 56, 9, 56, 4, 57, 9, 57, 4, 58, 9, 58, 4, 59, 9, 59, 4, 60, 9,
+	60, 4, 61, 9, 61, 3, 2, 3, 2, 3, 2, 3, 3, 7, 3, 127, 10, 3, 12, 3, 14,
+	3, 130, 11, 3, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3,
+	4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3,
+	4, 3, 4, 3, 4, 5, 4, 156, 10, 4, 3, 5, 5, 5, 159, 10, 5, 3, 5, 3, 5, 3,
+	5, 3, 5, 3, 5, 3, 5, 5, 5, 167, 10, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 5,
+	5, 174, 10, 5, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3,
+	7, 3, 7, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3,
+	9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 5, 9, 206, 10, 9, 3,
+	9, 3, 9, 3, 9, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 5, 10,
+	218, 10, 10, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3,
+	11, 3, 11, 3, 11, 3, 11, 5, 11, 232, 10, 11, 3, 11, 3, 11, 5, 11, 236,
+	10, 11, 3, 11, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12,
 	3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3,
 	12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12,
-	3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 5, 12, 225, 10,
-	12, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 5, 13,
-	236, 10, 13, 3, 13, 3, 13, 5, 13, 240, 10, 13, 3, 13, 3, 13, 3, 13, 3,
-	14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14,
+	3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 5, 12, 277,
+	10, 12, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13,
+	5, 13, 288, 10, 13, 3, 13, 3, 13, 5, 13, 292, 10, 13, 3, 13, 3, 13, 3,
+	13, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14,
 	3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3,
 	14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14,
-	3, 14, 3, 14, 3, 14, 3, 14, 5, 14, 281, 10, 14, 3, 15, 3, 15, 3, 15, 3,
-	15, 3, 16, 3, 16, 3, 16, 3, 16, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 7, 17,
-	296, 10, 17, 12, 17, 14, 17, 299, 11, 17, 3, 17, 3, 17, 5, 17, 303, 10,
-	17, 3, 18, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 5, 19, 313,
-	10, 19, 3, 20, 3, 20, 3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 21, 3, 22,
-	3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 6, 22, 330, 10, 22, 13, 22, 14,
-	22, 331, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22,
-	6, 22, 343, 10, 22, 13, 22, 14, 22, 344, 3, 22, 3, 22, 3, 22, 3, 22, 3,
-	22, 6, 22, 352, 10, 22, 13, 22, 14, 22, 353, 3, 22, 3, 22, 3, 22, 3, 22,
-	3, 22, 6, 22, 361, 10, 22, 13, 22, 14, 22, 362, 5, 22, 365, 10, 22, 3,
-	23, 3, 23, 3, 23, 3, 23, 3, 23, 5, 23, 372, 10, 23, 3, 23, 3, 23, 3, 24,
-	3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 25, 3, 25, 3, 25, 3, 25, 3,
-	25, 3, 25, 3, 25, 5, 25, 390, 10, 25, 3, 25, 3, 25, 3, 26, 3, 26, 3, 26,
-	3, 26, 3, 26, 5, 26, 399, 10, 26, 3, 26, 3, 26, 3, 27, 3, 27, 3, 27, 3,
-	27, 3, 27, 3, 27, 5, 27, 409, 10, 27, 3, 28, 3, 28, 5, 28, 413, 10, 28,
-	3, 29, 3, 29, 3, 30, 3, 30, 5, 30, 419, 10, 30, 3, 31, 3, 31, 3, 32, 3,
-	32, 5, 32, 425, 10, 32, 3, 33, 3, 33, 3, 34, 3, 34, 3, 34, 3, 34, 3, 35,
-	3, 35, 3, 35, 3, 35, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3,
-	36, 3, 36, 3, 36, 3, 36, 5, 36, 448, 10, 36, 3, 36, 3, 36, 3, 36, 3, 36,
-	3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3,
-	36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 7, 36, 471, 10, 36, 12, 36,
-	14, 36, 474, 11, 36, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3,
-	37, 3, 37, 3, 37, 3, 37, 5, 37, 487, 10, 37, 3, 38, 3, 38, 3, 39, 3, 39,
-	3, 39, 3, 39, 7, 39, 495, 10, 39, 12, 39, 14, 39, 498, 11, 39, 3, 39, 3,
-	39, 3, 39, 3, 39, 5, 39, 504, 10, 39, 3, 40, 3, 40, 3, 40, 3, 40, 3, 41,
-	3, 41, 3, 41, 3, 41, 7, 41, 514, 10, 41, 12, 41, 14, 41, 517, 11, 41, 3,
-	41, 3, 41, 3, 41, 3, 41, 5, 41, 523, 10, 41, 3, 42, 3, 42, 3, 42, 3, 42,
-	3, 42, 3, 42, 3, 42, 5, 42, 532, 10, 42, 3, 42, 2, 3, 70, 43, 2, 4, 6,
-	8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42,
-	44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78,
-	80, 82, 2, 8, 4, 2, 66, 66, 69, 70, 3, 2, 18, 20, 3, 2, 16, 17, 3, 2, 12,
-	15, 3, 2, 10, 11, 3, 2, 30, 31, 2, 575, 2, 84, 3, 2, 2, 2, 4, 90, 3, 2,
-	2, 2, 6, 109, 3, 2, 2, 2, 8, 121, 3, 2, 2, 2, 10, 123, 3, 2, 2, 2, 12,
-	126, 3, 2, 2, 2, 14, 136, 3, 2, 2, 2, 16, 144, 3, 2, 2, 2, 18, 158, 3,
-	2, 2, 2, 20, 170, 3, 2, 2, 2, 22, 224, 3, 2, 2, 2, 24, 226, 3, 2, 2, 2,
-	26, 280, 3, 2, 2, 2, 28, 282, 3, 2, 2, 2, 30, 286, 3, 2, 2, 2, 32, 290,
-	3, 2, 2, 2, 34, 304, 3, 2, 2, 2, 36, 312, 3, 2, 2, 2, 38, 314, 3, 2, 2,
-	2, 40, 318, 3, 2, 2, 2, 42, 364, 3, 2, 2, 2, 44, 366, 3, 2, 2, 2, 46, 375,
-	3, 2, 2, 2, 48, 382, 3, 2, 2, 2, 50, 393, 3, 2, 2, 2, 52, 408, 3, 2, 2,
-	2, 54, 412, 3, 2, 2, 2, 56, 414, 3, 2, 2, 2, 58, 418, 3, 2, 2, 2, 60, 420,
-	3, 2, 2, 2, 62, 424, 3, 2, 2, 2, 64, 426, 3, 2, 2, 2, 66, 428, 3, 2, 2,
-	2, 68, 432, 3, 2, 2, 2, 70, 447, 3, 2, 2, 2, 72, 486, 3, 2, 2, 2, 74, 488,
-	3, 2, 2, 2, 76, 503, 3, 2, 2, 2, 78, 505, 3, 2, 2, 2, 80, 522, 3, 2, 2,
-	2, 82, 531, 3, 2, 2, 2, 84, 85, 5, 4, 3, 2, 85, 86, 7, 2, 2, 3, 86, 3,
-	3, 2, 2, 2, 87, 89, 5, 6, 4, 2, 88, 87, 3, 2, 2, 2, 89, 92, 3, 2, 2, 2,
-	90, 88, 3, 2, 2, 2, 90, 91, 3, 2, 2, 2, 91, 5, 3, 2, 2, 2, 92, 90, 3, 2,
-	2, 2, 93, 110, 5, 8, 5, 2, 94, 110, 5, 12, 7, 2, 95, 110, 5, 14, 8, 2,
-	96, 110, 5, 16, 9, 2, 97, 110, 5, 18, 10, 2, 98, 110, 5, 22, 12, 2, 99,
-	110, 5, 20, 11, 2, 100, 110, 5, 26, 14, 2, 101, 110, 5, 24, 13, 2, 102,
-	110, 5, 32, 17, 2, 103, 110, 5, 38, 20, 2, 104, 110, 5, 28, 15, 2, 105,
-	110, 5, 30, 16, 2, 106, 110, 5, 40, 21, 2, 107, 108, 7, 73, 2, 2, 108,
-	110, 8, 4, 1, 2, 109, 93, 3, 2, 2, 2, 109, 94, 3, 2, 2, 2, 109, 95, 3,
-	2, 2, 2, 109, 96, 3, 2, 2, 2, 109, 97, 3, 2, 2, 2, 109, 98, 3, 2, 2, 2,
-	109, 99, 3, 2, 2, 2, 109, 100, 3, 2, 2, 2, 109, 101, 3, 2, 2, 2, 109, 102,
-	3, 2, 2, 2, 109, 103, 3, 2, 2, 2, 109, 104, 3, 2, 2, 2, 109, 105, 3, 2,
-	2, 2, 109, 106, 3, 2, 2, 2, 109, 107, 3, 2, 2, 2, 110, 7, 3, 2, 2, 2, 111,
-	112, 7, 66, 2, 2, 112, 113, 7, 24, 2, 2, 113, 114, 5, 70, 36, 2, 114, 115,
-	7, 23, 2, 2, 115, 122, 3, 2, 2, 2, 116, 117, 7, 66, 2, 2, 117, 118, 7,
-	24, 2, 2, 118, 119, 5, 50, 26, 2, 119, 120, 7, 23, 2, 2, 120, 122, 3, 2,
-	2, 2, 121, 111, 3, 2, 2, 2, 121, 116, 3, 2, 2, 2, 122, 9, 3, 2, 2, 2, 123,
-	124, 7, 60, 2, 2, 124, 125, 5, 60, 31, 2, 125, 11, 3, 2, 2, 2, 126, 127,
-	7, 51, 2, 2, 127, 128, 7, 25, 2, 2, 128, 129, 7, 66, 2, 2, 129, 130, 7,
-	29, 2, 2, 130, 131, 5, 60, 31, 2, 131, 132, 7, 29, 2, 2, 132, 133, 5, 70,
-	36, 2, 133, 134, 7, 26, 2, 2, 134, 135, 7, 23, 2, 2, 135, 13, 3, 2, 2,
-	2, 136, 137, 7, 52, 2, 2, 137, 138, 7, 25, 2, 2, 138, 139, 7, 66, 2, 2,
-	139, 140, 7, 29, 2, 2, 140, 141, 5, 60, 31, 2, 141, 142, 7, 26, 2, 2, 142,
-	143, 7, 23, 2, 2, 143, 15, 3, 2, 2, 2, 144, 145, 7, 54, 2, 2, 145, 146,
-	7, 25, 2, 2, 146, 147, 7, 66, 2, 2, 147, 148, 7, 29, 2, 2, 148, 149, 5,
-	60, 31, 2, 149, 150, 7, 29, 2, 2, 150, 153, 5, 70, 36, 2, 151, 152, 7,
-	29, 2, 2, 152, 154, 7, 67, 2, 2, 153, 151, 3, 2, 2, 2, 153, 154, 3, 2,
-	2, 2, 154, 155, 3, 2, 2, 2, 155, 156, 7, 26, 2, 2, 156, 157, 7, 23, 2,
-	2, 157, 17, 3, 2, 2, 2, 158, 159, 7, 55, 2, 2, 159, 160, 7, 25, 2, 2, 160,
-	161, 7, 66, 2, 2, 161, 162, 7, 29, 2, 2, 162, 165, 5, 60, 31, 2, 163, 164,
-	7, 29, 2, 2, 164, 166, 7, 67, 2, 2, 165, 163, 3, 2, 2, 2, 165, 166, 3,
-	2, 2, 2, 166, 167, 3, 2, 2, 2, 167, 168, 7, 26, 2, 2, 168, 169, 7, 23,
-	2, 2, 169, 19, 3, 2, 2, 2, 170, 171, 7, 56, 2, 2, 171, 172, 7, 25, 2, 2,
-	172, 173, 7, 66, 2, 2, 173, 174, 7, 29, 2, 2, 174, 175, 5, 60, 31, 2, 175,
-	176, 7, 29, 2, 2, 176, 179, 5, 70, 36, 2, 177, 178, 7, 29, 2, 2, 178, 180,
-	5, 60, 31, 2, 179, 177, 3, 2, 2, 2, 179, 180, 3, 2, 2, 2, 180, 183, 3,
-	2, 2, 2, 181, 182, 7, 29, 2, 2, 182, 184, 5, 56, 29, 2, 183, 181, 3, 2,
-	2, 2, 183, 184, 3, 2, 2, 2, 184, 185, 3, 2, 2, 2, 185, 186, 7, 26, 2, 2,
-	186, 187, 7, 23, 2, 2, 187, 21, 3, 2, 2, 2, 188, 189, 7, 57, 2, 2, 189,
-	190, 7, 25, 2, 2, 190, 191, 7, 66, 2, 2, 191, 192, 7, 29, 2, 2, 192, 193,
-	5, 66, 34, 2, 193, 194, 7, 29, 2, 2, 194, 195, 5, 68, 35, 2, 195, 196,
-	7, 26, 2, 2, 196, 197, 7, 23, 2, 2, 197, 225, 3, 2, 2, 2, 198, 199, 7,
-	57, 2, 2, 199, 200, 7, 25, 2, 2, 200, 201, 7, 66, 2, 2, 201, 202, 7, 29,
-	2, 2, 202, 203, 5, 68, 35, 2, 203, 204, 7, 29, 2, 2, 204, 205, 5, 66, 34,
-	2, 205, 206, 7, 26, 2, 2, 206, 207, 7, 23, 2, 2, 207, 225, 3, 2, 2, 2,
-	208, 209, 7, 57, 2, 2, 209, 210, 7, 25, 2, 2, 210, 211, 7, 66, 2, 2, 211,
-	212, 7, 29, 2, 2, 212, 213, 5, 66, 34, 2, 213, 214, 7, 26, 2, 2, 214, 215,
-	7, 23, 2, 2, 215, 225, 3, 2, 2, 2, 216, 217, 7, 57, 2, 2, 217, 218, 7,
-	25, 2, 2, 218, 219, 7, 66, 2, 2, 219, 220, 7, 29, 2, 2, 220, 221, 5, 68,
-	35, 2, 221, 222, 7, 26, 2, 2, 222, 223, 7, 23, 2, 2, 223, 225, 3, 2, 2,
-	2, 224, 188, 3, 2, 2, 2, 224, 198, 3, 2, 2, 2, 224, 208, 3, 2, 2, 2, 224,
-	216, 3, 2, 2, 2, 225, 23, 3, 2, 2, 2, 226, 227, 7, 58, 2, 2, 227, 228,
-	7, 25, 2, 2, 228, 229, 7, 66, 2, 2, 229, 230, 7, 29, 2, 2, 230, 231, 5,
-	60, 31, 2, 231, 232, 7, 29, 2, 2, 232, 235, 5, 70, 36, 2, 233, 234, 7,
-	29, 2, 2, 234, 236, 5, 60, 31, 2, 235, 233, 3, 2, 2, 2, 235, 236, 3, 2,
-	2, 2, 236, 239, 3, 2, 2, 2, 237, 238, 7, 29, 2, 2, 238, 240, 5, 56, 29,
-	2, 239, 237, 3, 2, 2, 2, 239, 240, 3, 2, 2, 2, 240, 241, 3, 2, 2, 2, 241,
-	242, 7, 26, 2, 2, 242, 243, 7, 23, 2, 2, 243, 25, 3, 2, 2, 2, 244, 245,
-	7, 59, 2, 2, 245, 246, 7, 25, 2, 2, 246, 247, 7, 66, 2, 2, 247, 248, 7,
-	29, 2, 2, 248, 249, 5, 66, 34, 2, 249, 250, 7, 29, 2, 2, 250, 251, 5, 68,
-	35, 2, 251, 252, 7, 26, 2, 2, 252, 253, 7, 23, 2, 2, 253, 281, 3, 2, 2,
-	2, 254, 255, 7, 59, 2, 2, 255, 256, 7, 25, 2, 2, 256, 257, 7, 66, 2, 2,
-	257, 258, 7, 29, 2, 2, 258, 259, 5, 68, 35, 2, 259, 260, 7, 29, 2, 2, 260,
-	261, 5, 66, 34, 2, 261, 262, 7, 26, 2, 2, 262, 263, 7, 23, 2, 2, 263, 281,
-	3, 2, 2, 2, 264, 265, 7, 59, 2, 2, 265, 266, 7, 25, 2, 2, 266, 267, 7,
-	66, 2, 2, 267, 268, 7, 29, 2, 2, 268, 269, 5, 66, 34, 2, 269, 270, 7, 26,
-	2, 2, 270, 271, 7, 23, 2, 2, 271, 281, 3, 2, 2, 2, 272, 273, 7, 59, 2,
-	2, 273, 274, 7, 25, 2, 2, 274, 275, 7, 66, 2, 2, 275, 276, 7, 29, 2, 2,
-	276, 277, 5, 68, 35, 2, 277, 278, 7, 26, 2, 2, 278, 279, 7, 23, 2, 2, 279,
-	281, 3, 2, 2, 2, 280, 244, 3, 2, 2, 2, 280, 254, 3, 2, 2, 2, 280, 264,
-	3, 2, 2, 2, 280, 272, 3, 2, 2, 2, 281, 27, 3, 2, 2, 2, 282, 283, 7, 62,
-	2, 2, 283, 284, 7, 67, 2, 2, 284, 285, 7, 23, 2, 2, 285, 29, 3, 2, 2, 2,
-	286, 287, 7, 49, 2, 2, 287, 288, 7, 67, 2, 2, 288, 289, 7, 23, 2, 2, 289,
-	31, 3, 2, 2, 2, 290, 291, 7, 33, 2, 2, 291, 297, 5, 34, 18, 2, 292, 293,
-	7, 34, 2, 2, 293, 294, 7, 33, 2, 2, 294, 296, 5, 34, 18, 2, 295, 292, 3,
-	2, 2, 2, 296, 299, 3, 2, 2, 2, 297, 295, 3, 2, 2, 2, 297, 298, 3, 2, 2,
-	2, 298, 302, 3, 2, 2, 2, 299, 297, 3, 2, 2, 2, 300, 301, 7, 34, 2, 2, 301,
-	303, 5, 36, 19, 2, 302, 300, 3, 2, 2, 2, 302, 303, 3, 2, 2, 2, 303, 33,
-	3, 2, 2, 2, 304, 305, 5, 70, 36, 2, 305, 306, 5, 36, 19, 2, 306, 35, 3,
-	2, 2, 2, 307, 308, 7, 27, 2, 2, 308, 309, 5, 4, 3, 2, 309, 310, 7, 28,
-	2, 2, 310, 313, 3, 2, 2, 2, 311, 313, 5, 6, 4, 2, 312, 307, 3, 2, 2, 2,
-	312, 311, 3, 2, 2, 2, 313, 37, 3, 2, 2, 2, 314, 315, 7, 35, 2, 2, 315,
-	316, 5, 70, 36, 2, 316, 317, 5, 36, 19, 2, 317, 39, 3, 2, 2, 2, 318, 319,
-	7, 36, 2, 2, 319, 320, 5, 70, 36, 2, 320, 321, 7, 23, 2, 2, 321, 41, 3,
-	2, 2, 2, 322, 323, 7, 37, 2, 2, 323, 329, 7, 38, 2, 2, 324, 325, 7, 43,
-	2, 2, 325, 330, 5, 54, 28, 2, 326, 330, 5, 60, 31, 2, 327, 328, 7, 46,
-	2, 2, 328, 330, 5, 64, 33, 2, 329, 324, 3, 2, 2, 2, 329, 326, 3, 2, 2,
-	2, 329, 327, 3, 2, 2, 2, 330, 331, 3, 2, 2, 2, 331, 329, 3, 2, 2, 2, 331,
-	332, 3, 2, 2, 2, 332, 365, 3, 2, 2, 2, 333, 334, 7, 37, 2, 2, 334, 342,
-	7, 39, 2, 2, 335, 336, 7, 43, 2, 2, 336, 343, 5, 54, 28, 2, 337, 343, 5,
-	62, 32, 2, 338, 339, 7, 44, 2, 2, 339, 343, 5, 58, 30, 2, 340, 341, 7,
-	45, 2, 2, 341, 343, 5, 60, 31, 2, 342, 335, 3, 2, 2, 2, 342, 337, 3, 2,
-	2, 2, 342, 338, 3, 2, 2, 2, 342, 340, 3, 2, 2, 2, 343, 344, 3, 2, 2, 2,
-	344, 342, 3, 2, 2, 2, 344, 345, 3, 2, 2, 2, 345, 365, 3, 2, 2, 2, 346,
-	347, 7, 37, 2, 2, 347, 351, 7, 40, 2, 2, 348, 349, 7, 43, 2, 2, 349, 352,
-	5, 54, 28, 2, 350, 352, 5, 62, 32, 2, 351, 348, 3, 2, 2, 2, 351, 350, 3,
-	2, 2, 2, 352, 353, 3, 2, 2, 2, 353, 351, 3, 2, 2, 2, 353, 354, 3, 2, 2,
-	2, 354, 365, 3, 2, 2, 2, 355, 356, 7, 37, 2, 2, 356, 360, 7, 42, 2, 2,
-	357, 358, 7, 43, 2, 2, 358, 361, 5, 54, 28, 2, 359, 361, 5, 62, 32, 2,
-	360, 357, 3, 2, 2, 2, 360, 359, 3, 2, 2, 2, 361, 362, 3, 2, 2, 2, 362,
-	360, 3, 2, 2, 2, 362, 363, 3, 2, 2, 2, 363, 365, 3, 2, 2, 2, 364, 322,
-	3, 2, 2, 2, 364, 333, 3, 2, 2, 2, 364, 346, 3, 2, 2, 2, 364, 355, 3, 2,
-	2, 2, 365, 43, 3, 2, 2, 2, 366, 367, 7, 61, 2, 2, 367, 368, 7, 25, 2, 2,
-	368, 371, 5, 60, 31, 2, 369, 370, 7, 29, 2, 2, 370, 372, 5, 60, 31, 2,
-	371, 369, 3, 2, 2, 2, 371, 372, 3, 2, 2, 2, 372, 373, 3, 2, 2, 2, 373,
-	374, 7, 26, 2, 2, 374, 45, 3, 2, 2, 2, 375, 376, 7, 50, 2, 2, 376, 377,
-	7, 25, 2, 2, 377, 378, 7, 66, 2, 2, 378, 379, 7, 29, 2, 2, 379, 380, 5,
-	60, 31, 2, 380, 381, 7, 26, 2, 2, 381, 47, 3, 2, 2, 2, 382, 383, 7, 53,
-	2, 2, 383, 384, 7, 25, 2, 2, 384, 385, 7, 66, 2, 2, 385, 386, 7, 29, 2,
-	2, 386, 389, 5, 60, 31, 2, 387, 388, 7, 29, 2, 2, 388, 390, 7, 67, 2, 2,
-	389, 387, 3, 2, 2, 2, 389, 390, 3, 2, 2, 2, 390, 391, 3, 2, 2, 2, 391,
-	392, 7, 26, 2, 2, 392, 49, 3, 2, 2, 2, 393, 394, 7, 48, 2, 2, 394, 395,
-	7, 25, 2, 2, 395, 398, 5, 60, 31, 2, 396, 397, 7, 29, 2, 2, 397, 399, 7,
-	70, 2, 2, 398, 396, 3, 2, 2, 2, 398, 399, 3, 2, 2, 2, 399, 400, 3, 2, 2,
-	2, 400, 401, 7, 26, 2, 2, 401, 51, 3, 2, 2, 2, 402, 403, 7, 63, 2, 2, 403,
-	404, 7, 70, 2, 2, 404, 409, 7, 23, 2, 2, 405, 406, 7, 63, 2, 2, 406, 407,
-	7, 69, 2, 2, 407, 409, 7, 23, 2, 2, 408, 402, 3, 2, 2, 2, 408, 405, 3,
-	2, 2, 2, 409, 53, 3, 2, 2, 2, 410, 413, 5, 60, 31, 2, 411, 413, 7, 68,
-	2, 2, 412, 410, 3, 2, 2, 2, 412, 411, 3, 2, 2, 2, 413, 55, 3, 2, 2, 2,
-	414, 415, 7, 3, 2, 2, 415, 57, 3, 2, 2, 2, 416, 419, 7, 68, 2, 2, 417,
-	419, 5, 60, 31, 2, 418, 416, 3, 2, 2, 2, 418, 417, 3, 2, 2, 2, 419, 59,
-	3, 2, 2, 2, 420, 421, 9, 2, 2, 2, 421, 61, 3, 2, 2, 2, 422, 425, 7, 68,
-	2, 2, 423, 425, 5, 60, 31, 2, 424, 422, 3, 2, 2, 2, 424, 423, 3, 2, 2,
-	2, 425, 63, 3, 2, 2, 2, 426, 427, 5, 60, 31, 2, 427, 65, 3, 2, 2, 2, 428,
-	429, 7, 64, 2, 2, 429, 430, 7, 24, 2, 2, 430, 431, 5, 60, 31, 2, 431, 67,
-	3, 2, 2, 2, 432, 433, 7, 65, 2, 2, 433, 434, 7, 24, 2, 2, 434, 435, 5,
-	60, 31, 2, 435, 69, 3, 2, 2, 2, 436, 437, 8, 36, 1, 2, 437, 438, 7, 17,
-	2, 2, 438, 448, 5, 70, 36, 16, 439, 440, 7, 22, 2, 2, 440, 448, 5, 70,
-	36, 15, 441, 448, 5, 42, 22, 2, 442, 448, 5, 46, 24, 2, 443, 448, 5, 48,
-	25, 2, 444, 448, 5, 10, 6, 2, 445, 448, 5, 44, 23, 2, 446, 448, 5, 72,
-	37, 2, 447, 436, 3, 2, 2, 2, 447, 439, 3, 2, 2, 2, 447, 441, 3, 2, 2, 2,
-	447, 442, 3, 2, 2, 2, 447, 443, 3, 2, 2, 2, 447, 444, 3, 2, 2, 2, 447,
-	445, 3, 2, 2, 2, 447, 446, 3, 2, 2, 2, 448, 472, 3, 2, 2, 2, 449, 450,
-	12, 17, 2, 2, 450, 451, 7, 21, 2, 2, 451, 471, 5, 70, 36, 17, 452, 453,
-	12, 14, 2, 2, 453, 454, 9, 3, 2, 2, 454, 471, 5, 70, 36, 15, 455, 456,
-	12, 13, 2, 2, 456, 457, 9, 4, 2, 2, 457, 471, 5, 70, 36, 14, 458, 459,
-	12, 12, 2, 2, 459, 460, 9, 5, 2, 2, 460, 471, 5, 70, 36, 13, 461, 462,
-	12, 11, 2, 2, 462, 463, 9, 6, 2, 2, 463, 471, 5, 70, 36, 12, 464, 465,
-	12, 10, 2, 2, 465, 466, 7, 9, 2, 2, 466, 471, 5, 70, 36, 11, 467, 468,
-	12, 9, 2, 2, 468, 469, 7, 8, 2, 2, 469, 471, 5, 70, 36, 10, 470, 449, 3,
-	2, 2, 2, 470, 452, 3, 2, 2, 2, 470, 455, 3, 2, 2, 2, 470, 458, 3, 2, 2,
-	2, 470, 461, 3, 2, 2, 2, 470, 464, 3, 2, 2, 2, 470, 467, 3, 2, 2, 2, 471,
-	474, 3, 2, 2, 2, 472, 470, 3, 2, 2, 2, 472, 473, 3, 2, 2, 2, 473, 71, 3,
-	2, 2, 2, 474, 472, 3, 2, 2, 2, 475, 476, 7, 25, 2, 2, 476, 477, 5, 70,
-	36, 2, 477, 478, 7, 26, 2, 2, 478, 487, 3, 2, 2, 2, 479, 487, 7, 67, 2,
-	2, 480, 487, 9, 7, 2, 2, 481, 487, 7, 69, 2, 2, 482, 487, 7, 66, 2, 2,
-	483, 487, 7, 70, 2, 2, 484, 487, 5, 74, 38, 2, 485, 487, 7, 32, 2, 2, 486,
-	475, 3, 2, 2, 2, 486, 479, 3, 2, 2, 2, 486, 480, 3, 2, 2, 2, 486, 481,
-	3, 2, 2, 2, 486, 482, 3, 2, 2, 2, 486, 483, 3, 2, 2, 2, 486, 484, 3, 2,
-	2, 2, 486, 485, 3, 2, 2, 2, 487, 73, 3, 2, 2, 2, 488, 489, 5, 82, 42, 2,
-	489, 75, 3, 2, 2, 2, 490, 491, 7, 27, 2, 2, 491, 496, 5, 78, 40, 2, 492,
-	493, 7, 29, 2, 2, 493, 495, 5, 78, 40, 2, 494, 492, 3, 2, 2, 2, 495, 498,
-	3, 2, 2, 2, 496, 494, 3, 2, 2, 2, 496, 497, 3, 2, 2, 2, 497, 499, 3, 2,
-	2, 2, 498, 496, 3, 2, 2, 2, 499, 500, 7, 28, 2, 2, 500, 504, 3, 2, 2, 2,
-	501, 502, 7, 27, 2, 2, 502, 504, 7, 28, 2, 2, 503, 490, 3, 2, 2, 2, 503,
-	501, 3, 2, 2, 2, 504, 77, 3, 2, 2, 2, 505, 506, 7, 70, 2, 2, 506, 507,
-	7, 4, 2, 2, 507, 508, 5, 82, 42, 2, 508, 79, 3, 2, 2, 2, 509, 510, 7, 5,
-	2, 2, 510, 515, 5, 82, 42, 2, 511, 512, 7, 29, 2, 2, 512, 514, 5, 82, 42,
-	2, 513, 511, 3, 2, 2, 2, 514, 517, 3, 2, 2, 2, 515, 513, 3, 2, 2, 2, 515,
-	516, 3, 2, 2, 2, 516, 518, 3, 2, 2, 2, 517, 515, 3, 2, 2, 2, 518, 519,
-	7, 6, 2, 2, 519, 523, 3, 2, 2, 2, 520, 521, 7, 5, 2, 2, 521, 523, 7, 6,
-	2, 2, 522, 509, 3, 2, 2, 2, 522, 520, 3, 2, 2, 2, 523, 81, 3, 2, 2, 2,
-	524, 532, 7, 70, 2, 2, 525, 532, 7, 67, 2, 2, 526, 532, 5, 76, 39, 2, 527,
-	532, 5, 80, 41, 2, 528, 532, 7, 30, 2, 2, 529, 532, 7, 31, 2, 2, 530, 532,
-	7, 7, 2, 2, 531, 524, 3, 2, 2, 2, 531, 525, 3, 2, 2, 2, 531, 526, 3, 2,
-	2, 2, 531, 527, 3, 2, 2, 2, 531, 528, 3, 2, 2, 2, 531, 529, 3, 2, 2, 2,
-	531, 530, 3, 2, 2, 2, 532, 83, 3, 2, 2, 2, 41, 90, 109, 121, 153, 165,
-	179, 183, 224, 235, 239, 280, 297, 302, 312, 329, 331, 342, 344, 351, 353,
-	360, 362, 364, 371, 389, 398, 408, 412, 418, 424, 447, 470, 472, 486, 496,
-	503, 515, 522, 531,
+	3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 5, 14, 333, 10, 14, 3, 15, 3, 15, 3,
+	15, 3, 15, 3, 16, 3, 16, 3, 16, 3, 16, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17,
+	7, 17, 348, 10, 17, 12, 17, 14, 17, 351, 11, 17, 3, 17, 3, 17, 5, 17, 355,
+	10, 17, 3, 18, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 5, 19,
+	365, 10, 19, 3, 20, 3, 20, 3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 21, 5,
+	21, 375, 10, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 22, 3, 22, 3, 22, 3, 22,
+	3, 23, 3, 23, 3, 23, 3, 23, 5, 23, 389, 10, 23, 3, 23, 3, 23, 3, 23, 3,
+	23, 3, 23, 3, 24, 3, 24, 3, 24, 7, 24, 399, 10, 24, 12, 24, 14, 24, 402,
+	11, 24, 3, 25, 3, 25, 5, 25, 406, 10, 25, 3, 25, 3, 25, 3, 26, 3, 26, 3,
+	26, 3, 27, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 5, 28,
+	422, 10, 28, 3, 28, 5, 28, 425, 10, 28, 3, 29, 3, 29, 3, 29, 3, 29, 3,
+	29, 3, 29, 3, 29, 3, 29, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 31, 3, 31,
+	3, 31, 3, 31, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 33, 3, 33, 3,
+	33, 3, 33, 3, 33, 3, 33, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 7, 34, 461,
+	10, 34, 12, 34, 14, 34, 464, 11, 34, 5, 34, 466, 10, 34, 3, 34, 3, 34,
+	3, 35, 3, 35, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 6, 36, 479,
+	10, 36, 13, 36, 14, 36, 480, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36,
+	3, 36, 3, 36, 3, 36, 6, 36, 492, 10, 36, 13, 36, 14, 36, 493, 3, 36, 3,
+	36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 6, 36, 504, 10, 36, 13, 36,
+	14, 36, 505, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3,
+	36, 3, 36, 5, 36, 518, 10, 36, 3, 36, 6, 36, 521, 10, 36, 13, 36, 14, 36,
+	522, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 6, 36, 532, 10, 36,
+	13, 36, 14, 36, 533, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 6,
+	36, 543, 10, 36, 13, 36, 14, 36, 544, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36,
+	3, 36, 3, 36, 6, 36, 554, 10, 36, 13, 36, 14, 36, 555, 3, 36, 3, 36, 3,
+	36, 3, 36, 3, 36, 3, 36, 3, 36, 5, 36, 565, 10, 36, 3, 36, 6, 36, 568,
+	10, 36, 13, 36, 14, 36, 569, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36,
+	3, 36, 3, 36, 5, 36, 580, 10, 36, 3, 36, 6, 36, 583, 10, 36, 13, 36, 14,
+	36, 584, 5, 36, 587, 10, 36, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 5, 37,
+	594, 10, 37, 3, 37, 3, 37, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3,
+	38, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 5, 39, 612, 10, 39,
+	3, 39, 3, 39, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 5, 40, 621, 10, 40, 3,
+	40, 3, 40, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 5, 41, 631, 10, 41,
+	3, 42, 3, 42, 5, 42, 635, 10, 42, 3, 43, 3, 43, 3, 43, 5, 43, 640, 10,
+	43, 3, 43, 3, 43, 3, 43, 5, 43, 645, 10, 43, 3, 43, 5, 43, 648, 10, 43,
+	3, 44, 3, 44, 3, 44, 3, 44, 5, 44, 654, 10, 44, 3, 44, 3, 44, 3, 44, 5,
+	44, 659, 10, 44, 3, 44, 5, 44, 662, 10, 44, 3, 45, 3, 45, 3, 46, 3, 46,
+	5, 46, 668, 10, 46, 3, 47, 3, 47, 3, 48, 3, 48, 5, 48, 674, 10, 48, 3,
+	49, 3, 49, 3, 50, 3, 50, 3, 50, 3, 50, 3, 51, 3, 51, 3, 51, 3, 51, 3, 52,
+	3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3,
+	52, 5, 52, 698, 10, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52,
+	3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3,
+	52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52,
+	3, 52, 7, 52, 729, 10, 52, 12, 52, 14, 52, 732, 11, 52, 3, 53, 3, 53, 3,
+	53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53,
+	3, 53, 3, 53, 5, 53, 749, 10, 53, 3, 54, 3, 54, 3, 54, 3, 54, 7, 54, 755,
+	10, 54, 12, 54, 14, 54, 758, 11, 54, 5, 54, 760, 10, 54, 3, 54, 3, 54,
+	3, 55, 3, 55, 3, 55, 3, 55, 7, 55, 768, 10, 55, 12, 55, 14, 55, 771, 11,
+	55, 5, 55, 773, 10, 55, 3, 55, 3, 55, 3, 56, 3, 56, 3, 56, 3, 56, 3, 57,
+	3, 57, 3, 58, 3, 58, 3, 58, 3, 58, 7, 58, 787, 10, 58, 12, 58, 14, 58,
+	790, 11, 58, 3, 58, 3, 58, 3, 58, 3, 58, 5, 58, 796, 10, 58, 3, 59, 3,
+	59, 3, 59, 3, 59, 3, 60, 3, 60, 3, 60, 3, 60, 7, 60, 806, 10, 60, 12, 60,
+	14, 60, 809, 11, 60, 3, 60, 3, 60, 3, 60, 3, 60, 5, 60, 815, 10, 60, 3,
+	61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 5, 61, 824, 10, 61, 3, 61,
+	2, 3, 102, 62, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30,
+	32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66,
+	68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102,
+	104, 106, 108, 110, 112, 114, 116, 118, 120, 2, 12, 3, 2, 94, 95, 5, 2,
+	44, 44, 98, 98, 100, 100, 4, 2, 98, 98, 101, 102, 3, 2, 18, 20, 3, 2, 16,
+	17, 3, 2, 12, 15, 3, 2, 10, 11, 4, 2, 98, 98, 100, 100, 3, 2, 31, 32, 4,
+	2, 98, 98, 102, 102, 2, 917, 2, 122, 3, 2, 2, 2, 4, 128, 3, 2, 2, 2, 6,
+	155, 3, 2, 2, 2, 8, 173, 3, 2, 2, 2, 10, 175, 3, 2, 2, 2, 12, 178, 3, 2,
+	2, 2, 14, 188, 3, 2, 2, 2, 16, 196, 3, 2, 2, 2, 18, 210, 3, 2, 2, 2, 20,
+	222, 3, 2, 2, 2, 22, 276, 3, 2, 2, 2, 24, 278, 3, 2, 2, 2, 26, 332, 3,
+	2, 2, 2, 28, 334, 3, 2, 2, 2, 30, 338, 3, 2, 2, 2, 32, 342, 3, 2, 2, 2,
+	34, 356, 3, 2, 2, 2, 36, 364, 3, 2, 2, 2, 38, 366, 3, 2, 2, 2, 40, 370,
+	3, 2, 2, 2, 42, 380, 3, 2, 2, 2, 44, 384, 3, 2, 2, 2, 46, 395, 3, 2, 2,
+	2, 48, 403, 3, 2, 2, 2, 50, 409, 3, 2, 2, 2, 52, 412, 3, 2, 2, 2, 54, 415,
+	3, 2, 2, 2, 56, 426, 3, 2, 2, 2, 58, 434, 3, 2, 2, 2, 60, 439, 3, 2, 2,
+	2, 62, 443, 3, 2, 2, 2, 64, 449, 3, 2, 2, 2, 66, 455, 3, 2, 2, 2, 68, 469,
+	3, 2, 2, 2, 70, 586, 3, 2, 2, 2, 72, 588, 3, 2, 2, 2, 74, 597, 3, 2, 2,
+	2, 76, 604, 3, 2, 2, 2, 78, 615, 3, 2, 2, 2, 80, 630, 3, 2, 2, 2, 82, 634,
+	3, 2, 2, 2, 84, 647, 3, 2, 2, 2, 86, 661, 3, 2, 2, 2, 88, 663, 3, 2, 2,
+	2, 90, 667, 3, 2, 2, 2, 92, 669, 3, 2, 2, 2, 94, 673, 3, 2, 2, 2, 96, 675,
+	3, 2, 2, 2, 98, 677, 3, 2, 2, 2, 100, 681, 3, 2, 2, 2, 102, 697, 3, 2,
+	2, 2, 104, 748, 3, 2, 2, 2, 106, 750, 3, 2, 2, 2, 108, 763, 3, 2, 2, 2,
+	110, 776, 3, 2, 2, 2, 112, 780, 3, 2, 2, 2, 114, 795, 3, 2, 2, 2, 116,
+	797, 3, 2, 2, 2, 118, 814, 3, 2, 2, 2, 120, 823, 3, 2, 2, 2, 122, 123,
+	5, 4, 3, 2, 123, 124, 7, 2, 2, 3, 124, 3, 3, 2, 2, 2, 125, 127, 5, 6, 4,
+	2, 126, 125, 3, 2, 2, 2, 127, 130, 3, 2, 2, 2, 128, 126, 3, 2, 2, 2, 128,
+	129, 3, 2, 2, 2, 129, 5, 3, 2, 2, 2, 130, 128, 3, 2, 2, 2, 131, 156, 5,
+	8, 5, 2, 132, 156, 5, 12, 7, 2, 133, 156, 5, 14, 8, 2, 134, 156, 5, 16,
+	9, 2, 135, 156, 5, 18, 10, 2, 136, 156, 5, 22, 12, 2, 137, 156, 5, 20,
+	11, 2, 138, 156, 5, 26, 14, 2, 139, 156, 5, 24, 13, 2, 140, 156, 5, 32,
+	17, 2, 141, 156, 5, 38, 20, 2, 142, 156, 5, 40, 21, 2, 143, 156, 5, 28,
+	15, 2, 144, 156, 5, 30, 16, 2, 145, 156, 5, 42, 22, 2, 146, 156, 5, 44,
+	23, 2, 147, 156, 5, 48, 25, 2, 148, 156, 5, 50, 26, 2, 149, 156, 5, 52,
+	27, 2, 150, 156, 5, 54, 28, 2, 151, 156, 5, 60, 31, 2, 152, 156, 5, 62,
+	32, 2, 153, 156, 5, 64, 33, 2, 154, 156, 5, 80, 41, 2, 155, 131, 3, 2,
+	2, 2, 155, 132, 3, 2, 2, 2, 155, 133, 3, 2, 2, 2, 155, 134, 3, 2, 2, 2,
+	155, 135, 3, 2, 2, 2, 155, 136, 3, 2, 2, 2, 155, 137, 3, 2, 2, 2, 155,
+	138, 3, 2, 2, 2, 155, 139, 3, 2, 2, 2, 155, 140, 3, 2, 2, 2, 155, 141,
+	3, 2, 2, 2, 155, 142, 3, 2, 2, 2, 155, 143, 3, 2, 2, 2, 155, 144, 3, 2,
+	2, 2, 155, 145, 3, 2, 2, 2, 155, 146, 3, 2, 2, 2, 155, 147, 3, 2, 2, 2,
+	155, 148, 3, 2, 2, 2, 155, 149, 3, 2, 2, 2, 155, 150, 3, 2, 2, 2, 155,
+	151, 3, 2, 2, 2, 155, 152, 3, 2, 2, 2, 155, 153, 3, 2, 2, 2, 155, 154,
+	3, 2, 2, 2, 156, 7, 3, 2, 2, 2, 157, 159, 9, 2, 2, 2, 158, 157, 3, 2, 2,
+	2, 158, 159, 3, 2, 2, 2, 159, 160, 3, 2, 2, 2, 160, 161, 7, 98, 2, 2, 161,
+	162, 7, 24, 2, 2, 162, 163, 5, 102, 52, 2, 163, 164, 7, 23, 2, 2, 164,
+	174, 3, 2, 2, 2, 165, 167, 9, 2, 2, 2, 166, 165, 3, 2, 2, 2, 166, 167,
+	3, 2, 2, 2, 167, 168, 3, 2, 2, 2, 168, 169, 7, 98, 2, 2, 169, 170, 7, 24,
+	2, 2, 170, 171, 5, 78, 40, 2, 171, 172, 7, 23, 2, 2, 172, 174, 3, 2, 2,
+	2, 173, 158, 3, 2, 2, 2, 173, 166, 3, 2, 2, 2, 174, 9, 3, 2, 2, 2, 175,
+	176, 7, 79, 2, 2, 176, 177, 5, 92, 47, 2, 177, 11, 3, 2, 2, 2, 178, 179,
+	7, 70, 2, 2, 179, 180, 7, 25, 2, 2, 180, 181, 7, 98, 2, 2, 181, 182, 7,
+	29, 2, 2, 182, 183, 5, 92, 47, 2, 183, 184, 7, 29, 2, 2, 184, 185, 5, 102,
+	52, 2, 185, 186, 7, 26, 2, 2, 186, 187, 7, 23, 2, 2, 187, 13, 3, 2, 2,
+	2, 188, 189, 7, 71, 2, 2, 189, 190, 7, 25, 2, 2, 190, 191, 7, 98, 2, 2,
+	191, 192, 7, 29, 2, 2, 192, 193, 5, 92, 47, 2, 193, 194, 7, 26, 2, 2, 194,
+	195, 7, 23, 2, 2, 195, 15, 3, 2, 2, 2, 196, 197, 7, 73, 2, 2, 197, 198,
+	7, 25, 2, 2, 198, 199, 7, 98, 2, 2, 199, 200, 7, 29, 2, 2, 200, 201, 5,
+	92, 47, 2, 201, 202, 7, 29, 2, 2, 202, 205, 5, 102, 52, 2, 203, 204, 7,
+	29, 2, 2, 204, 206, 7, 99, 2, 2, 205, 203, 3, 2, 2, 2, 205, 206, 3, 2,
+	2, 2, 206, 207, 3, 2, 2, 2, 207, 208, 7, 26, 2, 2, 208, 209, 7, 23, 2,
+	2, 209, 17, 3, 2, 2, 2, 210, 211, 7, 74, 2, 2, 211, 212, 7, 25, 2, 2, 212,
+	213, 7, 98, 2, 2, 213, 214, 7, 29, 2, 2, 214, 217, 5, 92, 47, 2, 215, 216,
+	7, 29, 2, 2, 216, 218, 7, 99, 2, 2, 217, 215, 3, 2, 2, 2, 217, 218, 3,
+	2, 2, 2, 218, 219, 3, 2, 2, 2, 219, 220, 7, 26, 2, 2, 220, 221, 7, 23,
+	2, 2, 221, 19, 3, 2, 2, 2, 222, 223, 7, 75, 2, 2, 223, 224, 7, 25, 2, 2,
+	224, 225, 7, 98, 2, 2, 225, 226, 7, 29, 2, 2, 226, 227, 5, 92, 47, 2, 227,
+	228, 7, 29, 2, 2, 228, 231, 5, 102, 52, 2, 229, 230, 7, 29, 2, 2, 230,
+	232, 5, 92, 47, 2, 231, 229, 3, 2, 2, 2, 231, 232, 3, 2, 2, 2, 232, 235,
+	3, 2, 2, 2, 233, 234, 7, 29, 2, 2, 234, 236, 5, 88, 45, 2, 235, 233, 3,
+	2, 2, 2, 235, 236, 3, 2, 2, 2, 236, 237, 3, 2, 2, 2, 237, 238, 7, 26, 2,
+	2, 238, 239, 7, 23, 2, 2, 239, 21, 3, 2, 2, 2, 240, 241, 7, 76, 2, 2, 241,
+	242, 7, 25, 2, 2, 242, 243, 7, 98, 2, 2, 243, 244, 7, 29, 2, 2, 244, 245,
+	5, 98, 50, 2, 245, 246, 7, 29, 2, 2, 246, 247, 5, 100, 51, 2, 247, 248,
+	7, 26, 2, 2, 248, 249, 7, 23, 2, 2, 249, 277, 3, 2, 2, 2, 250, 251, 7,
+	76, 2, 2, 251, 252, 7, 25, 2, 2, 252, 253, 7, 98, 2, 2, 253, 254, 7, 29,
+	2, 2, 254, 255, 5, 100, 51, 2, 255, 256, 7, 29, 2, 2, 256, 257, 5, 98,
+	50, 2, 257, 258, 7, 26, 2, 2, 258, 259, 7, 23, 2, 2, 259, 277, 3, 2, 2,
+	2, 260, 261, 7, 76, 2, 2, 261, 262, 7, 25, 2, 2, 262, 263, 7, 98, 2, 2,
+	263, 264, 7, 29, 2, 2, 264, 265, 5, 98, 50, 2, 265, 266, 7, 26, 2, 2, 266,
+	267, 7, 23, 2, 2, 267, 277, 3, 2, 2, 2, 268, 269, 7, 76, 2, 2, 269, 270,
+	7, 25, 2, 2, 270, 271, 7, 98, 2, 2, 271, 272, 7, 29, 2, 2, 272, 273, 5,
+	100, 51, 2, 273, 274, 7, 26, 2, 2, 274, 275, 7, 23, 2, 2, 275, 277, 3,
+	2, 2, 2, 276, 240, 3, 2, 2, 2, 276, 250, 3, 2, 2, 2, 276, 260, 3, 2, 2,
+	2, 276, 268, 3, 2, 2, 2, 277, 23, 3, 2, 2, 2, 278, 279, 7, 77, 2, 2, 279,
+	280, 7, 25, 2, 2, 280, 281, 7, 98, 2, 2, 281, 282, 7, 29, 2, 2, 282, 283,
+	5, 92, 47, 2, 283, 284, 7, 29, 2, 2, 284, 287, 5, 102, 52, 2, 285, 286,
+	7, 29, 2, 2, 286, 288, 5, 92, 47, 2, 287, 285, 3, 2, 2, 2, 287, 288, 3,
+	2, 2, 2, 288, 291, 3, 2, 2, 2, 289, 290, 7, 29, 2, 2, 290, 292, 5, 88,
+	45, 2, 291, 289, 3, 2, 2, 2, 291, 292, 3, 2, 2, 2, 292, 293, 3, 2, 2, 2,
+	293, 294, 7, 26, 2, 2, 294, 295, 7, 23, 2, 2, 295, 25, 3, 2, 2, 2, 296,
+	297, 7, 78, 2, 2, 297, 298, 7, 25, 2, 2, 298, 299, 7, 98, 2, 2, 299, 300,
+	7, 29, 2, 2, 300, 301, 5, 98, 50, 2, 301, 302, 7, 29, 2, 2, 302, 303, 5,
+	100, 51, 2, 303, 304, 7, 26, 2, 2, 304, 305, 7, 23, 2, 2, 305, 333, 3,
+	2, 2, 2, 306, 307, 7, 78, 2, 2, 307, 308, 7, 25, 2, 2, 308, 309, 7, 98,
+	2, 2, 309, 310, 7, 29, 2, 2, 310, 311, 5, 100, 51, 2, 311, 312, 7, 29,
+	2, 2, 312, 313, 5, 98, 50, 2, 313, 314, 7, 26, 2, 2, 314, 315, 7, 23, 2,
+	2, 315, 333, 3, 2, 2, 2, 316, 317, 7, 78, 2, 2, 317, 318, 7, 25, 2, 2,
+	318, 319, 7, 98, 2, 2, 319, 320, 7, 29, 2, 2, 320, 321, 5, 98, 50, 2, 321,
+	322, 7, 26, 2, 2, 322, 323, 7, 23, 2, 2, 323, 333, 3, 2, 2, 2, 324, 325,
+	7, 78, 2, 2, 325, 326, 7, 25, 2, 2, 326, 327, 7, 98, 2, 2, 327, 328, 7,
+	29, 2, 2, 328, 329, 5, 100, 51, 2, 329, 330, 7, 26, 2, 2, 330, 331, 7,
+	23, 2, 2, 331, 333, 3, 2, 2, 2, 332, 296, 3, 2, 2, 2, 332, 306, 3, 2, 2,
+	2, 332, 316, 3, 2, 2, 2, 332, 324, 3, 2, 2, 2, 333, 27, 3, 2, 2, 2, 334,
+	335, 7, 81, 2, 2, 335, 336, 5, 102, 52, 2, 336, 337, 7, 23, 2, 2, 337,
+	29, 3, 2, 2, 2, 338, 339, 7, 68, 2, 2, 339, 340, 7, 99, 2, 2, 340, 341,
+	7, 23, 2, 2, 341, 31, 3, 2, 2, 2, 342, 343, 7, 34, 2, 2, 343, 349, 5, 34,
+	18, 2, 344, 345, 7, 35, 2, 2, 345, 346, 7, 34, 2, 2, 346, 348, 5, 34, 18,
+	2, 347, 344, 3, 2, 2, 2, 348, 351, 3, 2, 2, 2, 349, 347, 3, 2, 2, 2, 349,
+	350, 3, 2, 2, 2, 350, 354, 3, 2, 2, 2, 351, 349, 3, 2, 2, 2, 352, 353,
+	7, 35, 2, 2, 353, 355, 5, 36, 19, 2, 354, 352, 3, 2, 2, 2, 354, 355, 3,
+	2, 2, 2, 355, 33, 3, 2, 2, 2, 356, 357, 5, 102, 52, 2, 357, 358, 5, 36,
+	19, 2, 358, 35, 3, 2, 2, 2, 359, 360, 7, 27, 2, 2, 360, 361, 5, 4, 3, 2,
+	361, 362, 7, 28, 2, 2, 362, 365, 3, 2, 2, 2, 363, 365, 5, 6, 4, 2, 364,
+	359, 3, 2, 2, 2, 364, 363, 3, 2, 2, 2, 365, 37, 3, 2, 2, 2, 366, 367, 7,
+	36, 2, 2, 367, 368, 5, 102, 52, 2, 368, 369, 5, 36, 19, 2, 369, 39, 3,
+	2, 2, 2, 370, 371, 7, 37, 2, 2, 371, 374, 7, 98, 2, 2, 372, 373, 7, 29,
+	2, 2, 373, 375, 7, 98, 2, 2, 374, 372, 3, 2, 2, 2, 374, 375, 3, 2, 2, 2,
+	375, 376, 3, 2, 2, 2, 376, 377, 7, 38, 2, 2, 377, 378, 5, 102, 52, 2, 378,
+	379, 5, 36, 19, 2, 379, 41, 3, 2, 2, 2, 380, 381, 7, 39, 2, 2, 381, 382,
+	5, 102, 52, 2, 382, 383, 7, 23, 2, 2, 383, 43, 3, 2, 2, 2, 384, 385, 7,
+	85, 2, 2, 385, 386, 7, 98, 2, 2, 386, 388, 7, 25, 2, 2, 387, 389, 5, 46,
+	24, 2, 388, 387, 3, 2, 2, 2, 388, 389, 3, 2, 2, 2, 389, 390, 3, 2, 2, 2,
+	390, 391, 7, 26, 2, 2, 391, 392, 7, 27, 2, 2, 392, 393, 5, 4, 3, 2, 393,
+	394, 7, 28, 2, 2, 394, 45, 3, 2, 2, 2, 395, 400, 7, 98, 2, 2, 396, 397,
+	7, 29, 2, 2, 397, 399, 7, 98, 2, 2, 398, 396, 3, 2, 2, 2, 399, 402, 3,
+	2, 2, 2, 400, 398, 3, 2, 2, 2, 400, 401, 3, 2, 2, 2, 401, 47, 3, 2, 2,
+	2, 402, 400, 3, 2, 2, 2, 403, 405, 7, 86, 2, 2, 404, 406, 5, 102, 52, 2,
+	405, 404, 3, 2, 2, 2, 405, 406, 3, 2, 2, 2, 406, 407, 3, 2, 2, 2, 407,
+	408, 7, 23, 2, 2, 408, 49, 3, 2, 2, 2, 409, 410, 7, 87, 2, 2, 410, 411,
+	7, 23, 2, 2, 411, 51, 3, 2, 2, 2, 412, 413, 5, 66, 34, 2, 413, 414, 7,
+	23, 2, 2, 414, 53, 3, 2, 2, 2, 415, 416, 7, 88, 2, 2, 416, 417, 7, 27,
+	2, 2, 417, 418, 5, 4, 3, 2, 418, 424, 7, 28, 2, 2, 419, 421, 5, 56, 29,
+	2, 420, 422, 5, 58, 30, 2, 421, 420, 3, 2, 2, 2, 421, 422, 3, 2, 2, 2,
+	422, 425, 3, 2, 2, 2, 423, 425, 5, 58, 30, 2, 424, 419, 3, 2, 2, 2, 424,
+	423, 3, 2, 2, 2, 425, 55, 3, 2, 2, 2, 426, 427, 7, 89, 2, 2, 427, 428,
+	7, 25, 2, 2, 428, 429, 7, 98, 2, 2, 429, 430, 7, 26, 2, 2, 430, 431, 7,
+	27, 2, 2, 431, 432, 5, 4, 3, 2, 432, 433, 7, 28, 2, 2, 433, 57, 3, 2, 2,
+	2, 434, 435, 7, 90, 2, 2, 435, 436, 7, 27, 2, 2, 436, 437, 5, 4, 3, 2,
+	437, 438, 7, 28, 2, 2, 438, 59, 3, 2, 2, 2, 439, 440, 7, 91, 2, 2, 440,
+	441, 5, 102, 52, 2, 441, 442, 7, 23, 2, 2, 442, 61, 3, 2, 2, 2, 443, 444,
+	7, 92, 2, 2, 444, 445, 5, 92, 47, 2, 445, 446, 7, 93, 2, 2, 446, 447, 7,
+	98, 2, 2, 447, 448, 7, 23, 2, 2, 448, 63, 3, 2, 2, 2, 449, 450, 7, 96,
+	2, 2, 450, 451, 5, 102, 52, 2, 451, 452, 7, 27, 2, 2, 452, 453, 5, 4, 3,
+	2, 453, 454, 7, 28, 2, 2, 454, 65, 3, 2, 2, 2, 455, 456, 5, 68, 35, 2,
+	456, 465, 7, 25, 2, 2, 457, 462, 5, 102, 52, 2, 458, 459, 7, 29, 2, 2,
+	459, 461, 5, 102, 52, 2, 460, 458, 3, 2, 2, 2, 461, 464, 3, 2, 2, 2, 462,
+	460, 3, 2, 2, 2, 462, 463, 3, 2, 2, 2, 463, 466, 3, 2, 2, 2, 464, 462,
+	3, 2, 2, 2, 465, 457, 3, 2, 2, 2, 465, 466, 3, 2, 2, 2, 466, 467, 3, 2,
+	2, 2, 467, 468, 7, 26, 2, 2, 468, 67, 3, 2, 2, 2, 469, 470, 9, 3, 2, 2,
+	470, 69, 3, 2, 2, 2, 471, 472, 7, 40, 2, 2, 472, 478, 7, 41, 2, 2, 473,
+	474, 7, 50, 2, 2, 474, 479, 5, 82, 42, 2, 475, 479, 5, 92, 47, 2, 476,
+	477, 7, 65, 2, 2, 477, 479, 5, 96, 49, 2, 478, 473, 3, 2, 2, 2, 478, 475,
+	3, 2, 2, 2, 478, 476, 3, 2, 2, 2, 479, 480, 3, 2, 2, 2, 480, 478, 3, 2,
+	2, 2, 480, 481, 3, 2, 2, 2, 481, 587, 3, 2, 2, 2, 482, 483, 7, 40, 2, 2,
+	483, 491, 7, 42, 2, 2, 484, 485, 7, 50, 2, 2, 485, 492, 5, 82, 42, 2, 486,
+	492, 5, 94, 48, 2, 487, 488, 7, 51, 2, 2, 488, 492, 5, 90, 46, 2, 489,
+	490, 7, 64, 2, 2, 490, 492, 5, 92, 47, 2, 491, 484, 3, 2, 2, 2, 491, 486,
+	3, 2, 2, 2, 491, 487, 3, 2, 2, 2, 491, 489, 3, 2, 2, 2, 492, 493, 3, 2,
+	2, 2, 493, 491, 3, 2, 2, 2, 493, 494, 3, 2, 2, 2, 494, 587, 3, 2, 2, 2,
+	495, 496, 7, 40, 2, 2, 496, 503, 7, 43, 2, 2, 497, 498, 7, 50, 2, 2, 498,
+	504, 5, 82, 42, 2, 499, 504, 5, 94, 48, 2, 500, 504, 5, 86, 44, 2, 501,
+	504, 7, 59, 2, 2, 502, 504, 5, 84, 43, 2, 503, 497, 3, 2, 2, 2, 503, 499,
+	3, 2, 2, 2, 503, 500, 3, 2, 2, 2, 503, 501, 3, 2, 2, 2, 503, 502, 3, 2,
+	2, 2, 504, 505, 3, 2, 2, 2, 505, 503, 3, 2, 2, 2, 505, 506, 3, 2, 2, 2,
+	506, 587, 3, 2, 2, 2, 507, 508, 7, 40, 2, 2, 508, 520, 7, 46, 2, 2, 509,
+	510, 7, 50, 2, 2, 510, 521, 5, 82, 42, 2, 511, 521, 5, 94, 48, 2, 512,
+	521, 7, 52, 2, 2, 513, 514, 7, 53, 2, 2, 514, 521, 5, 92, 47, 2, 515, 517,
+	7, 54, 2, 2, 516, 518, 7, 24, 2, 2, 517, 516, 3, 2, 2, 2, 517, 518, 3,
+	2, 2, 2, 518, 519, 3, 2, 2, 2, 519, 521, 5, 102, 52, 2, 520, 509, 3, 2,
+	2, 2, 520, 511, 3, 2, 2, 2, 520, 512, 3, 2, 2, 2, 520, 513, 3, 2, 2, 2,
+	520, 515, 3, 2, 2, 2, 521, 522, 3, 2, 2, 2, 522, 520, 3, 2, 2, 2, 522,
+	523, 3, 2, 2, 2, 523, 587, 3, 2, 2, 2, 524, 525, 7, 40, 2, 2, 525, 531,
+	7, 45, 2, 2, 526, 527, 7, 50, 2, 2, 527, 532, 5, 82, 42, 2, 528, 532, 5,
+	94, 48, 2, 529, 532, 5, 86, 44, 2, 530, 532, 7, 60, 2, 2, 531, 526, 3,
+	2, 2, 2, 531, 528, 3, 2, 2, 2, 531, 529, 3, 2, 2, 2, 531, 530, 3, 2, 2,
+	2, 532, 533, 3, 2, 2, 2, 533, 531, 3, 2, 2, 2, 533, 534, 3, 2, 2, 2, 534,
+	587, 3, 2, 2, 2, 535, 536, 7, 40, 2, 2, 536, 542, 7, 48, 2, 2, 537, 538,
+	7, 50, 2, 2, 538, 543, 5, 82, 42, 2, 539, 543, 5, 92, 47, 2, 540, 541,
+	7, 65, 2, 2, 541, 543, 5, 96, 49, 2, 542, 537, 3, 2, 2, 2, 542, 539, 3,
+	2, 2, 2, 542, 540, 3, 2, 2, 2, 543, 544, 3, 2, 2, 2, 544, 542, 3, 2, 2,
+	2, 544, 545, 3, 2, 2, 2, 545, 587, 3, 2, 2, 2, 546, 547, 7, 40, 2, 2, 547,
+	553, 7, 44, 2, 2, 548, 549, 7, 50, 2, 2, 549, 554, 5, 82, 42, 2, 550, 554,
+	5, 92, 47, 2, 551, 552, 7, 65, 2, 2, 552, 554, 5, 96, 49, 2, 553, 548,
+	3, 2, 2, 2, 553, 550, 3, 2, 2, 2, 553, 551, 3, 2, 2, 2, 554, 555, 3, 2,
+	2, 2, 555, 553, 3, 2, 2, 2, 555, 556, 3, 2, 2, 2, 556, 587, 3, 2, 2, 2,
+	557, 558, 7, 40, 2, 2, 558, 567, 7, 49, 2, 2, 559, 560, 7, 50, 2, 2, 560,
+	568, 5, 82, 42, 2, 561, 568, 5, 94, 48, 2, 562, 564, 7, 63, 2, 2, 563,
+	565, 7, 24, 2, 2, 564, 563, 3, 2, 2, 2, 564, 565, 3, 2, 2, 2, 565, 566,
+	3, 2, 2, 2, 566, 568, 5, 102, 52, 2, 567, 559, 3, 2, 2, 2, 567, 561, 3,
+	2, 2, 2, 567, 562, 3, 2, 2, 2, 568, 569, 3, 2, 2, 2, 569, 567, 3, 2, 2,
+	2, 569, 570, 3, 2, 2, 2, 570, 587, 3, 2, 2, 2, 571, 572, 7, 40, 2, 2, 572,
+	573, 7, 47, 2, 2, 573, 582, 7, 98, 2, 2, 574, 575, 7, 50, 2, 2, 575, 583,
+	5, 82, 42, 2, 576, 583, 5, 94, 48, 2, 577, 579, 7, 54, 2, 2, 578, 580,
+	7, 24, 2, 2, 579, 578, 3, 2, 2, 2, 579, 580, 3, 2, 2, 2, 580, 581, 3, 2,
+	2, 2, 581, 583, 5, 102, 52, 2, 582, 574, 3, 2, 2, 2, 582, 576, 3, 2, 2,
+	2, 582, 577, 3, 2, 2, 2, 583, 584, 3, 2, 2, 2, 584, 582, 3, 2, 2, 2, 584,
+	585, 3, 2, 2, 2, 585, 587, 3, 2, 2, 2, 586, 471, 3, 2, 2, 2, 586, 482,
+	3, 2, 2, 2, 586, 495, 3, 2, 2, 2, 586, 507, 3, 2, 2, 2, 586, 524, 3, 2,
+	2, 2, 586, 535, 3, 2, 2, 2, 586, 546, 3, 2, 2, 2, 586, 557, 3, 2, 2, 2,
+	586, 571, 3, 2, 2, 2, 587, 71, 3, 2, 2, 2, 588, 589, 7, 80, 2, 2, 589,
+	590, 7, 25, 2, 2, 590, 593, 5, 92, 47, 2, 591, 592, 7, 29, 2, 2, 592, 594,
+	5, 92, 47, 2, 593, 591, 3, 2, 2, 2, 593, 594, 3, 2, 2, 2, 594, 595, 3,
+	2, 2, 2, 595, 596, 7, 26, 2, 2, 596, 73, 3, 2, 2, 2, 597, 598, 7, 69, 2,
+	2, 598, 599, 7, 25, 2, 2, 599, 600, 7, 98, 2, 2, 600, 601, 7, 29, 2, 2,
+	601, 602, 5, 92, 47, 2, 602, 603, 7, 26, 2, 2, 603, 75, 3, 2, 2, 2, 604,
+	605, 7, 72, 2, 2, 605, 606, 7, 25, 2, 2, 606, 607, 7, 98, 2, 2, 607, 608,
+	7, 29, 2, 2, 608, 611, 5, 92, 47, 2, 609, 610, 7, 29, 2, 2, 610, 612, 7,
+	99, 2, 2, 611, 609, 3, 2, 2, 2, 611, 612, 3, 2, 2, 2, 612, 613, 3, 2, 2,
+	2, 613, 614, 7, 26, 2, 2, 614, 77, 3, 2, 2, 2, 615, 616, 7, 67, 2, 2, 616,
+	617, 7, 25, 2, 2, 617, 620, 5, 92, 47, 2, 618, 619, 7, 29, 2, 2, 619, 621,
+	7, 102, 2, 2, 620, 618, 3, 2, 2, 2, 620, 621, 3, 2, 2, 2, 621, 622, 3,
+	2, 2, 2, 622, 623, 7, 26, 2, 2, 623, 79, 3, 2, 2, 2, 624, 625, 7, 82, 2,
+	2, 625, 626, 7, 102, 2, 2, 626, 631, 7, 23, 2, 2, 627, 628, 7, 82, 2, 2,
+	628, 629, 7, 101, 2, 2, 629, 631, 7, 23, 2, 2, 630, 624, 3, 2, 2, 2, 630,
+	627, 3, 2, 2, 2, 631, 81, 3, 2, 2, 2, 632, 635, 5, 92, 47, 2, 633, 635,
+	7, 100, 2, 2, 634, 632, 3, 2, 2, 2, 634, 633, 3, 2, 2, 2, 635, 83, 3, 2,
+	2, 2, 636, 648, 7, 61, 2, 2, 637, 639, 7, 62, 2, 2, 638, 640, 7, 24, 2,
+	2, 639, 638, 3, 2, 2, 2, 639, 640, 3, 2, 2, 2, 640, 641, 3, 2, 2, 2, 641,
+	648, 5, 92, 47, 2, 642, 644, 7, 66, 2, 2, 643, 645, 7, 24, 2, 2, 644, 643,
+	3, 2, 2, 2, 644, 645, 3, 2, 2, 2, 645, 646, 3, 2, 2, 2, 646, 648, 5, 92,
+	47, 2, 647, 636, 3, 2, 2, 2, 647, 637, 3, 2, 2, 2, 647, 642, 3, 2, 2, 2,
+	648, 85, 3, 2, 2, 2, 649, 662, 7, 55, 2, 2, 650, 662, 7, 56, 2, 2, 651,
+	653, 7, 57, 2, 2, 652, 654, 7, 24, 2, 2, 653, 652, 3, 2, 2, 2, 653, 654,
+	3, 2, 2, 2, 654, 655, 3, 2, 2, 2, 655, 662, 5, 92, 47, 2, 656, 658, 7,
+	58, 2, 2, 657, 659, 7, 24, 2, 2, 658, 657, 3, 2, 2, 2, 658, 659, 3, 2,
+	2, 2, 659, 660, 3, 2, 2, 2, 660, 662, 5, 92, 47, 2, 661, 649, 3, 2, 2,
+	2, 661, 650, 3, 2, 2, 2, 661, 651, 3, 2, 2, 2, 661, 656, 3, 2, 2, 2, 662,
+	87, 3, 2, 2, 2, 663, 664, 7, 3, 2, 2, 664, 89, 3, 2, 2, 2, 665, 668, 7,
+	100, 2, 2, 666, 668, 5, 92, 47, 2, 667, 665, 3, 2, 2, 2, 667, 666, 3, 2,
+	2, 2, 668, 91, 3, 2, 2, 2, 669, 670, 9, 4, 2, 2, 670, 93, 3, 2, 2, 2, 671,
+	674, 7, 100, 2, 2, 672, 674, 5, 92, 47, 2, 673, 671, 3, 2, 2, 2, 673, 672,
+	3, 2, 2, 2, 674, 95, 3, 2, 2, 2, 675, 676, 5, 92, 47, 2, 676, 97, 3, 2,
+	2, 2, 677, 678, 7, 83, 2, 2, 678, 679, 7, 24, 2, 2, 679, 680, 5, 92, 47,
+	2, 680, 99, 3, 2, 2, 2, 681, 682, 7, 84, 2, 2, 682, 683, 7, 24, 2, 2, 683,
+	684, 5, 92, 47, 2, 684, 101, 3, 2, 2, 2, 685, 686, 8, 52, 1, 2, 686, 687,
+	7, 17, 2, 2, 687, 698, 5, 102, 52, 17, 688, 689, 7, 22, 2, 2, 689, 698,
+	5, 102, 52, 16, 690, 698, 5, 70, 36, 2, 691, 698, 5, 74, 38, 2, 692, 698,
+	5, 76, 39, 2, 693, 698, 5, 10, 6, 2, 694, 698, 5, 72, 37, 2, 695, 698,
+	5, 66, 34, 2, 696, 698, 5, 104, 53, 2, 697, 685, 3, 2, 2, 2, 697, 688,
+	3, 2, 2, 2, 697, 690, 3, 2, 2, 2, 697, 691, 3, 2, 2, 2, 697, 692, 3, 2,
+	2, 2, 697, 693, 3, 2, 2, 2, 697, 694, 3, 2, 2, 2, 697, 695, 3, 2, 2, 2,
+	697, 696, 3, 2, 2, 2, 698, 730, 3, 2, 2, 2, 699, 700, 12, 18, 2, 2, 700,
+	701, 7, 21, 2, 2, 701, 729, 5, 102, 52, 18, 702, 703, 12, 15, 2, 2, 703,
+	704, 9, 5, 2, 2, 704, 729, 5, 102, 52, 16, 705, 706, 12, 14, 2, 2, 706,
+	707, 9, 6, 2, 2, 707, 729, 5, 102, 52, 15, 708, 709, 12, 13, 2, 2, 709,
+	710, 9, 7, 2, 2, 710, 729, 5, 102, 52, 14, 711, 712, 12, 12, 2, 2, 712,
+	713, 9, 8, 2, 2, 713, 729, 5, 102, 52, 13, 714, 715, 12, 11, 2, 2, 715,
+	716, 7, 9, 2, 2, 716, 729, 5, 102, 52, 12, 717, 718, 12, 10, 2, 2, 718,
+	719, 7, 8, 2, 2, 719, 729, 5, 102, 52, 11, 720, 721, 12, 20, 2, 2, 721,
+	722, 7, 4, 2, 2, 722, 723, 5, 102, 52, 2, 723, 724, 7, 5, 2, 2, 724, 729,
+	3, 2, 2, 2, 725, 726, 12, 19, 2, 2, 726, 727, 7, 30, 2, 2, 727, 729, 9,
+	9, 2, 2, 728, 699, 3, 2, 2, 2, 728, 702, 3, 2, 2, 2, 728, 705, 3, 2, 2,
+	2, 728, 708, 3, 2, 2, 2, 728, 711, 3, 2, 2, 2, 728, 714, 3, 2, 2, 2, 728,
+	717, 3, 2, 2, 2, 728, 720, 3, 2, 2, 2, 728, 725, 3, 2, 2, 2, 729, 732,
+	3, 2, 2, 2, 730, 728, 3, 2, 2, 2, 730, 731, 3, 2, 2, 2, 731, 103, 3, 2,
+	2, 2, 732, 730, 3, 2, 2, 2, 733, 734, 7, 25, 2, 2, 734, 735, 5, 102, 52,
+	2, 735, 736, 7, 26, 2, 2, 736, 749, 3, 2, 2, 2, 737, 749, 7, 99, 2, 2,
+	738, 749, 7, 97, 2, 2, 739, 749, 9, 10, 2, 2, 740, 749, 7, 101, 2, 2, 741,
+	749, 7, 98, 2, 2, 742, 749, 7, 102, 2, 2, 743, 749, 5, 106, 54, 2, 744,
+	749, 5, 108, 55, 2, 745, 749, 5, 112, 57, 2, 746, 749, 7, 100, 2, 2, 747,
+	749, 7, 33, 2, 2, 748, 733, 3, 2, 2, 2, 748, 737, 3, 2, 2, 2, 748, 738,
+	3, 2, 2, 2, 748, 739, 3, 2, 2, 2, 748, 740, 3, 2, 2, 2, 748, 741, 3, 2,
+	2, 2, 748, 742, 3, 2, 2, 2, 748, 743, 3, 2, 2, 2, 748, 744, 3, 2, 2, 2,
+	748, 745, 3, 2, 2, 2, 748, 746, 3, 2, 2, 2, 748, 747, 3, 2, 2, 2, 749,
+	105, 3, 2, 2, 2, 750, 759, 7, 4, 2, 2, 751, 756, 5, 102, 52, 2, 752, 753,
+	7, 29, 2, 2, 753, 755, 5, 102, 52, 2, 754, 752, 3, 2, 2, 2, 755, 758, 3,
+	2, 2, 2, 756, 754, 3, 2, 2, 2, 756, 757, 3, 2, 2, 2, 757, 760, 3, 2, 2,
+	2, 758, 756, 3, 2, 2, 2, 759, 751, 3, 2, 2, 2, 759, 760, 3, 2, 2, 2, 760,
+	761, 3, 2, 2, 2, 761, 762, 7, 5, 2, 2, 762, 107, 3, 2, 2, 2, 763, 772,
+	7, 27, 2, 2, 764, 769, 5, 110, 56, 2, 765, 766, 7, 29, 2, 2, 766, 768,
+	5, 110, 56, 2, 767, 765, 3, 2, 2, 2, 768, 771, 3, 2, 2, 2, 769, 767, 3,
+	2, 2, 2, 769, 770, 3, 2, 2, 2, 770, 773, 3, 2, 2, 2, 771, 769, 3, 2, 2,
+	2, 772, 764, 3, 2, 2, 2, 772, 773, 3, 2, 2, 2, 773, 774, 3, 2, 2, 2, 774,
+	775, 7, 28, 2, 2, 775, 109, 3, 2, 2, 2, 776, 777, 9, 11, 2, 2, 777, 778,
+	7, 6, 2, 2, 778, 779, 5, 102, 52, 2, 779, 111, 3, 2, 2, 2, 780, 781, 5,
+	120, 61, 2, 781, 113, 3, 2, 2, 2, 782, 783, 7, 27, 2, 2, 783, 788, 5, 116,
+	59, 2, 784, 785, 7, 29, 2, 2, 785, 787, 5, 116, 59, 2, 786, 784, 3, 2,
+	2, 2, 787, 790, 3, 2, 2, 2, 788, 786, 3, 2, 2, 2, 788, 789, 3, 2, 2, 2,
+	789, 791, 3, 2, 2, 2, 790, 788, 3, 2, 2, 2, 791, 792, 7, 28, 2, 2, 792,
+	796, 3, 2, 2, 2, 793, 794, 7, 27, 2, 2, 794, 796, 7, 28, 2, 2, 795, 782,
+	3, 2, 2, 2, 795, 793, 3, 2, 2, 2, 796, 115, 3, 2, 2, 2, 797, 798, 7, 102,
+	2, 2, 798, 799, 7, 6, 2, 2, 799, 800, 5, 120, 61, 2, 800, 117, 3, 2, 2,
+	2, 801, 802, 7, 4, 2, 2, 802, 807, 5, 120, 61, 2, 803, 804, 7, 29, 2, 2,
+	804, 806, 5, 120, 61, 2, 805, 803, 3, 2, 2, 2, 806, 809, 3, 2, 2, 2, 807,
+	805, 3, 2, 2, 2, 807, 808, 3, 2, 2, 2, 808, 810, 3, 2, 2, 2, 809, 807,
+	3, 2, 2, 2, 810, 811, 7, 5, 2, 2, 811, 815, 3, 2, 2, 2, 812, 813, 7, 4,
+	2, 2, 813, 815, 7, 5, 2, 2, 814, 801, 3, 2, 2, 2, 814, 812, 3, 2, 2, 2,
+	815, 119, 3, 2, 2, 2, 816, 824, 7, 102, 2, 2, 817, 824, 7, 99, 2, 2, 818,
+	824, 5, 114, 58, 2, 819, 824, 5, 118, 60, 2, 820, 824, 7, 31, 2, 2, 821,
+	824, 7, 32, 2, 2, 822, 824, 7, 7, 2, 2, 823, 816, 3, 2, 2, 2, 823, 817,
+	3, 2, 2, 2, 823, 818, 3, 2, 2, 2, 823, 819, 3, 2, 2, 2, 823, 820, 3, 2,
+	2, 2, 823, 821, 3, 2, 2, 2, 823, 822, 3, 2, 2, 2, 824, 121, 3, 2, 2, 2,
+	74, 128, 155, 158, 166, 173, 205, 217, 231, 235, 276, 287, 291, 332, 349,
+	354, 364, 374, 388, 400, 405, 421, 424, 462, 465, 478, 480, 491, 493, 503,
+	505, 517, 520, 522, 531, 533, 542, 544, 553, 555, 564, 567, 569, 579, 582,
+	584, 586, 593, 611, 620, 630, 634, 639, 644, 647, 653, 658, 661, 667, 673,
+	697, 728, 730, 748, 756, 759, 769, 772, 788, 795, 807, 814, 823,
 }
 var deserializer = antlr.NewATNDeserializer(nil)
 var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
 
 var literalNames = []string{
-	"", "'\"asObject\"'", "':'", "'['", "']'", "'null'", "'||'", "'&&'", "'=='",
+	"", "'\"asObject\"'", "'['", "']'", "':'", "'null'", "'||'", "'&&'", "'=='",
 	"'!='", "'>'", "'<'", "'>='", "'<='", "'+'", "'-'", "'*'", "'/'", "'%'",
-	"'^'", "'!'", "';'", "'='", "'('", "')'", "'{'", "'}'", "','", "'true'",
-	"'false'", "'nil'", "'if'", "'else'", "'while'", "'log'", "'kubectl'",
-	"'apply'", "'patch'", "'get'", "'replace'", "'delete'", "'-n'", "'--type'",
-	"'-p'", "'-u'", "'-jsonpath'", "'load'", "'exit'", "'jsonSelect'", "'jsonEdit'",
-	"'jsonDelete'", "'yamlSelect'", "'yamlEdit'", "'yamlDelete'", "'kubeJsonEdit'",
-	"'kubeJsonDelete'", "'kubeYamlEdit'", "'kubeYamlDelete'", "'shellScript'",
-	"'download'", "'sleep'", "'stepInfo'", "'filter'", "'pattern'",
+	"'^'", "'!'", "';'", "'='", "'('", "')'", "'{'", "'}'", "','", "'.'", "'true'",
+	"'false'", "'nil'", "'if'", "'else'", "'while'", "'for'", "'in'", "'log'",
+	"'kubectl'", "'apply'", "'patch'", "'get'", "'replace'", "'delete'", "'wait'",
+	"'rollout'", "'create'", "'scale'", "'-n'", "'--type'", "", "'--for'",
+	"'--timeout'", "", "", "", "'--field-selector'", "", "'--all'", "", "",
+	"'--replicas'", "'-p'", "'-u'", "'-jsonpath'", "'load'", "'exit'", "'jsonSelect'",
+	"'jsonEdit'", "'jsonDelete'", "'yamlSelect'", "'yamlEdit'", "'yamlDelete'",
+	"'kubeJsonEdit'", "'kubeJsonDelete'", "'kubeYamlEdit'", "'kubeYamlDelete'",
+	"'shellScript'", "'download'", "'sleep'", "'stepInfo'", "'filter'", "'pattern'",
+	"'func'", "'return'", "'break'", "'try'", "'catch'", "'finally'", "'throw'",
+	"'import'", "'as'", "'let'", "'const'", "'timeout'",
 }
 var symbolicNames = []string{
 	"", "", "", "", "", "", "OR", "AND", "EQ", "NEQ", "GT", "LT", "GTEQ", "LTEQ",
 	"PLUS", "MINUS", "MULT", "DIV", "MOD", "POW", "NOT", "SCOL", "ASSIGN",
-	"OPAR", "CPAR", "OBRACE", "CBRACE", "COMMA", "TRUE", "FALSE", "NIL", "IF",
-	"ELSE", "WHILE", "LOG", "KUBECTL", "APPLY", "PATCH", "GET", "REPLACE",
-	"DELETE", "NAMESPACE", "PATCHTYPE", "PATCHLOAD", "UPDATELOAD", "JSONPATH",
+	"OPAR", "CPAR", "OBRACE", "CBRACE", "COMMA", "DOT", "TRUE", "FALSE", "NIL",
+	"IF", "ELSE", "WHILE", "FOR", "IN", "LOG", "KUBECTL", "APPLY", "PATCH",
+	"GET", "REPLACE", "DELETE", "WAIT", "ROLLOUT", "CREATE", "SCALE", "NAMESPACE",
+	"PATCHTYPE", "FORCONDITION", "WAITFOR", "WAITTIMEOUT", "LABELSELECTOR",
+	"FIELDSELECTOR", "SELECTOR", "FIELDSELECTORFLAG", "ALLNAMESPACES", "ALL",
+	"OUTPUTFORMAT", "OUTPUT", "REPLICAS", "PATCHLOAD", "UPDATELOAD", "JSONPATH",
 	"LOAD", "EXIT", "JSONSELECT", "JSONEDIT", "JSONDELETE", "YAMLSELECT", "YAMLEDIT",
 	"YAMLDELETE", "KUBEJSONEDIT", "KUBEJSONDELETE", "KUBEYAMLEDIT", "KUBEYAMLDELETE",
-	"SHELLSCRIPT", "DOWNLOAD", "SLEEP", "STEPINFO", "FILTER", "PATTERN", "ID",
-	"NUMBER", "PATH", "RAW_STRING_LIT", "STRING", "COMMENT", "SPACE", "OTHER",
+	"SHELLSCRIPT", "DOWNLOAD", "SLEEP", "STEPINFO", "FILTER", "PATTERN", "FUNC",
+	"RETURN", "BREAK", "TRY", "CATCH", "FINALLY", "THROW", "IMPORT", "AS",
+	"LET", "CONST", "TIMEOUT", "DURATION", "ID", "NUMBER", "PATH", "RAW_STRING_LIT",
+	"STRING", "COMMENT", "SPACE",
 }
 
 var ruleNames = []string{
 	"parse", "block", "stat", "assignment", "shell_script", "json_edit_fn",
 	"json_delete_fn", "yaml_edit_fn", "yaml_delete_fn", "kube_json_edit_fn",
 	"kube_json_delete_fn", "kube_yaml_edit_fn", "kube_yaml_delete_fn", "sleep_fn",
-	"exit_fn", "if_stat", "condition_block", "stat_block", "while_stat", "log",
-	"kubectl_command", "download_fn", "json_select_fn", "yaml_select_fn", "load_fn",
-	"stepInfo", "ns", "asObject", "patch_type", "string_or_id", "resource",
-	"kubernetes_object_config", "filter", "pattern", "expr", "atom", "json",
-	"obj", "pair", "arr", "value",
+	"exit_fn", "if_stat", "condition_block", "stat_block", "while_stat", "for_stat",
+	"log", "func_decl", "param_list", "return_stat", "break_stat", "call_stat",
+	"try_stat", "catch_clause", "finally_clause", "throw_stat", "import_stat",
+	"timeout_stat", "func_call", "func_name", "kubectl_command", "download_fn",
+	"json_select_fn", "yaml_select_fn", "load_fn", "stepInfo", "ns", "output",
+	"selector", "asObject", "patch_type", "string_or_id", "resource", "kubernetes_object_config",
+	"filter", "pattern", "expr", "atom", "list_literal", "map_literal", "map_entry",
+	"json", "obj", "pair", "arr", "value",
 }
 var decisionToDFA = make([]*antlr.DFA, len(deserializedATN.DecisionToState))
 
@@ -320,78 +475,109 @@ func NewKlangParser(input antlr.TokenStream) *KlangParser {
 
 // KlangParser tokens.
 const (
-	KlangParserEOF            = antlr.TokenEOF
-	KlangParserT__0           = 1
-	KlangParserT__1           = 2
-	KlangParserT__2           = 3
-	KlangParserT__3           = 4
-	KlangParserT__4           = 5
-	KlangParserOR             = 6
-	KlangParserAND            = 7
-	KlangParserEQ             = 8
-	KlangParserNEQ            = 9
-	KlangParserGT             = 10
-	KlangParserLT             = 11
-	KlangParserGTEQ           = 12
-	KlangParserLTEQ           = 13
-	KlangParserPLUS           = 14
-	KlangParserMINUS          = 15
-	KlangParserMULT           = 16
-	KlangParserDIV            = 17
-	KlangParserMOD            = 18
-	KlangParserPOW            = 19
-	KlangParserNOT            = 20
-	KlangParserSCOL           = 21
-	KlangParserASSIGN         = 22
-	KlangParserOPAR           = 23
-	KlangParserCPAR           = 24
-	KlangParserOBRACE         = 25
-	KlangParserCBRACE         = 26
-	KlangParserCOMMA          = 27
-	KlangParserTRUE           = 28
-	KlangParserFALSE          = 29
-	KlangParserNIL            = 30
-	KlangParserIF             = 31
-	KlangParserELSE           = 32
-	KlangParserWHILE          = 33
-	KlangParserLOG            = 34
-	KlangParserKUBECTL        = 35
-	KlangParserAPPLY          = 36
-	KlangParserPATCH          = 37
-	KlangParserGET            = 38
-	KlangParserREPLACE        = 39
-	KlangParserDELETE         = 40
-	KlangParserNAMESPACE      = 41
-	KlangParserPATCHTYPE      = 42
-	KlangParserPATCHLOAD      = 43
-	KlangParserUPDATELOAD     = 44
-	KlangParserJSONPATH       = 45
-	KlangParserLOAD           = 46
-	KlangParserEXIT           = 47
-	KlangParserJSONSELECT     = 48
-	KlangParserJSONEDIT       = 49
-	KlangParserJSONDELETE     = 50
-	KlangParserYAMLSELECT     = 51
-	KlangParserYAMLEDIT       = 52
-	KlangParserYAMLDELETE     = 53
-	KlangParserKUBEJSONEDIT   = 54
-	KlangParserKUBEJSONDELETE = 55
-	KlangParserKUBEYAMLEDIT   = 56
-	KlangParserKUBEYAMLDELETE = 57
-	KlangParserSHELLSCRIPT    = 58
-	KlangParserDOWNLOAD       = 59
-	KlangParserSLEEP          = 60
-	KlangParserSTEPINFO       = 61
-	KlangParserFILTER         = 62
-	KlangParserPATTERN        = 63
-	KlangParserID             = 64
-	KlangParserNUMBER         = 65
-	KlangParserPATH           = 66
-	KlangParserRAW_STRING_LIT = 67
-	KlangParserSTRING         = 68
-	KlangParserCOMMENT        = 69
-	KlangParserSPACE          = 70
-	KlangParserOTHER          = 71
+	KlangParserEOF               = antlr.TokenEOF
+	KlangParserT__0              = 1
+	KlangParserT__1              = 2
+	KlangParserT__2              = 3
+	KlangParserT__3              = 4
+	KlangParserT__4              = 5
+	KlangParserOR                = 6
+	KlangParserAND               = 7
+	KlangParserEQ                = 8
+	KlangParserNEQ               = 9
+	KlangParserGT                = 10
+	KlangParserLT                = 11
+	KlangParserGTEQ              = 12
+	KlangParserLTEQ              = 13
+	KlangParserPLUS              = 14
+	KlangParserMINUS             = 15
+	KlangParserMULT              = 16
+	KlangParserDIV               = 17
+	KlangParserMOD               = 18
+	KlangParserPOW               = 19
+	KlangParserNOT               = 20
+	KlangParserSCOL              = 21
+	KlangParserASSIGN            = 22
+	KlangParserOPAR              = 23
+	KlangParserCPAR              = 24
+	KlangParserOBRACE            = 25
+	KlangParserCBRACE            = 26
+	KlangParserCOMMA             = 27
+	KlangParserDOT               = 28
+	KlangParserTRUE              = 29
+	KlangParserFALSE             = 30
+	KlangParserNIL               = 31
+	KlangParserIF                = 32
+	KlangParserELSE              = 33
+	KlangParserWHILE             = 34
+	KlangParserFOR               = 35
+	KlangParserIN                = 36
+	KlangParserLOG               = 37
+	KlangParserKUBECTL           = 38
+	KlangParserAPPLY             = 39
+	KlangParserPATCH             = 40
+	KlangParserGET               = 41
+	KlangParserREPLACE           = 42
+	KlangParserDELETE            = 43
+	KlangParserWAIT              = 44
+	KlangParserROLLOUT           = 45
+	KlangParserCREATE            = 46
+	KlangParserSCALE             = 47
+	KlangParserNAMESPACE         = 48
+	KlangParserPATCHTYPE         = 49
+	KlangParserFORCONDITION      = 50
+	KlangParserWAITFOR           = 51
+	KlangParserWAITTIMEOUT       = 52
+	KlangParserLABELSELECTOR     = 53
+	KlangParserFIELDSELECTOR     = 54
+	KlangParserSELECTOR          = 55
+	KlangParserFIELDSELECTORFLAG = 56
+	KlangParserALLNAMESPACES     = 57
+	KlangParserALL               = 58
+	KlangParserOUTPUTFORMAT      = 59
+	KlangParserOUTPUT            = 60
+	KlangParserREPLICAS          = 61
+	KlangParserPATCHLOAD         = 62
+	KlangParserUPDATELOAD        = 63
+	KlangParserJSONPATH          = 64
+	KlangParserLOAD              = 65
+	KlangParserEXIT              = 66
+	KlangParserJSONSELECT        = 67
+	KlangParserJSONEDIT          = 68
+	KlangParserJSONDELETE        = 69
+	KlangParserYAMLSELECT        = 70
+	KlangParserYAMLEDIT          = 71
+	KlangParserYAMLDELETE        = 72
+	KlangParserKUBEJSONEDIT      = 73
+	KlangParserKUBEJSONDELETE    = 74
+	KlangParserKUBEYAMLEDIT      = 75
+	KlangParserKUBEYAMLDELETE    = 76
+	KlangParserSHELLSCRIPT       = 77
+	KlangParserDOWNLOAD          = 78
+	KlangParserSLEEP             = 79
+	KlangParserSTEPINFO          = 80
+	KlangParserFILTER            = 81
+	KlangParserPATTERN           = 82
+	KlangParserFUNC              = 83
+	KlangParserRETURN            = 84
+	KlangParserBREAK             = 85
+	KlangParserTRY               = 86
+	KlangParserCATCH             = 87
+	KlangParserFINALLY           = 88
+	KlangParserTHROW             = 89
+	KlangParserIMPORT            = 90
+	KlangParserAS                = 91
+	KlangParserLET               = 92
+	KlangParserCONST             = 93
+	KlangParserTIMEOUT           = 94
+	KlangParserDURATION          = 95
+	KlangParserID                = 96
+	KlangParserNUMBER            = 97
+	KlangParserPATH              = 98
+	KlangParserRAW_STRING_LIT    = 99
+	KlangParserSTRING            = 100
+	KlangParserCOMMENT           = 101
+	KlangParserSPACE             = 102
 )
 
 // KlangParser rules.
@@ -415,28 +601,47 @@ const (
 	KlangParserRULE_condition_block          = 16
 	KlangParserRULE_stat_block               = 17
 	KlangParserRULE_while_stat               = 18
-	KlangParserRULE_log                      = 19
-	KlangParserRULE_kubectl_command          = 20
-	KlangParserRULE_download_fn              = 21
-	KlangParserRULE_json_select_fn           = 22
-	KlangParserRULE_yaml_select_fn           = 23
-	KlangParserRULE_load_fn                  = 24
-	KlangParserRULE_stepInfo                 = 25
-	KlangParserRULE_ns                       = 26
-	KlangParserRULE_asObject                 = 27
-	KlangParserRULE_patch_type               = 28
-	KlangParserRULE_string_or_id             = 29
-	KlangParserRULE_resource                 = 30
-	KlangParserRULE_kubernetes_object_config = 31
-	KlangParserRULE_filter                   = 32
-	KlangParserRULE_pattern                  = 33
-	KlangParserRULE_expr                     = 34
-	KlangParserRULE_atom                     = 35
-	KlangParserRULE_json                     = 36
-	KlangParserRULE_obj                      = 37
-	KlangParserRULE_pair                     = 38
-	KlangParserRULE_arr                      = 39
-	KlangParserRULE_value                    = 40
+	KlangParserRULE_for_stat                 = 19
+	KlangParserRULE_log                      = 20
+	KlangParserRULE_func_decl                = 21
+	KlangParserRULE_param_list               = 22
+	KlangParserRULE_return_stat              = 23
+	KlangParserRULE_break_stat               = 24
+	KlangParserRULE_call_stat                = 25
+	KlangParserRULE_try_stat                 = 26
+	KlangParserRULE_catch_clause             = 27
+	KlangParserRULE_finally_clause           = 28
+	KlangParserRULE_throw_stat               = 29
+	KlangParserRULE_import_stat              = 30
+	KlangParserRULE_timeout_stat             = 31
+	KlangParserRULE_func_call                = 32
+	KlangParserRULE_func_name                = 33
+	KlangParserRULE_kubectl_command          = 34
+	KlangParserRULE_download_fn              = 35
+	KlangParserRULE_json_select_fn           = 36
+	KlangParserRULE_yaml_select_fn           = 37
+	KlangParserRULE_load_fn                  = 38
+	KlangParserRULE_stepInfo                 = 39
+	KlangParserRULE_ns                       = 40
+	KlangParserRULE_output                   = 41
+	KlangParserRULE_selector                 = 42
+	KlangParserRULE_asObject                 = 43
+	KlangParserRULE_patch_type               = 44
+	KlangParserRULE_string_or_id             = 45
+	KlangParserRULE_resource                 = 46
+	KlangParserRULE_kubernetes_object_config = 47
+	KlangParserRULE_filter                   = 48
+	KlangParserRULE_pattern                  = 49
+	KlangParserRULE_expr                     = 50
+	KlangParserRULE_atom                     = 51
+	KlangParserRULE_list_literal             = 52
+	KlangParserRULE_map_literal              = 53
+	KlangParserRULE_map_entry                = 54
+	KlangParserRULE_json                     = 55
+	KlangParserRULE_obj                      = 56
+	KlangParserRULE_pair                     = 57
+	KlangParserRULE_arr                      = 58
+	KlangParserRULE_value                    = 59
 )
 
 // IParseContext is an interface to support dynamic dispatch.
@@ -533,11 +738,11 @@ func (p *KlangParser) Parse() (localctx IParseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(82)
+		p.SetState(120)
 		p.Block()
 	}
 	{
-		p.SetState(83)
+		p.SetState(121)
 		p.Match(KlangParserEOF)
 	}
 
@@ -647,17 +852,17 @@ func (p *KlangParser) Block() (localctx IBlockContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(88)
+	p.SetState(126)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for (((_la-31)&-(0x1f+1)) == 0 && ((1<<uint((_la-31)))&((1<<(KlangParserIF-31))|(1<<(KlangParserWHILE-31))|(1<<(KlangParserLOG-31))|(1<<(KlangParserEXIT-31))|(1<<(KlangParserJSONEDIT-31))|(1<<(KlangParserJSONDELETE-31))|(1<<(KlangParserYAMLEDIT-31))|(1<<(KlangParserYAMLDELETE-31))|(1<<(KlangParserKUBEJSONEDIT-31))|(1<<(KlangParserKUBEJSONDELETE-31))|(1<<(KlangParserKUBEYAMLEDIT-31))|(1<<(KlangParserKUBEYAMLDELETE-31))|(1<<(KlangParserSLEEP-31)))) != 0) || _la == KlangParserID || _la == KlangParserOTHER {
+	for (((_la-32)&-(0x1f+1)) == 0 && ((1<<uint((_la-32)))&((1<<(KlangParserIF-32))|(1<<(KlangParserWHILE-32))|(1<<(KlangParserFOR-32))|(1<<(KlangParserLOG-32))|(1<<(KlangParserREPLACE-32)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(KlangParserEXIT-66))|(1<<(KlangParserJSONEDIT-66))|(1<<(KlangParserJSONDELETE-66))|(1<<(KlangParserYAMLEDIT-66))|(1<<(KlangParserYAMLDELETE-66))|(1<<(KlangParserKUBEJSONEDIT-66))|(1<<(KlangParserKUBEJSONDELETE-66))|(1<<(KlangParserKUBEYAMLEDIT-66))|(1<<(KlangParserKUBEYAMLDELETE-66))|(1<<(KlangParserSLEEP-66))|(1<<(KlangParserSTEPINFO-66))|(1<<(KlangParserFUNC-66))|(1<<(KlangParserRETURN-66))|(1<<(KlangParserBREAK-66))|(1<<(KlangParserTRY-66))|(1<<(KlangParserTHROW-66))|(1<<(KlangParserIMPORT-66))|(1<<(KlangParserLET-66))|(1<<(KlangParserCONST-66))|(1<<(KlangParserTIMEOUT-66))|(1<<(KlangParserID-66)))) != 0) || _la == KlangParserPATH {
 		{
-			p.SetState(85)
+			p.SetState(123)
 			p.Stat()
 		}
 
-		p.SetState(90)
+		p.SetState(128)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -672,12 +877,6 @@ type IStatContext interface {
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// Get_OTHER returns the _OTHER token.
-	Get_OTHER() antlr.Token
-
-	// Set_OTHER sets the _OTHER token.
-	Set_OTHER(antlr.Token)
-
 	// IsStatContext differentiates from other interfaces.
 	IsStatContext()
 }
@@ -685,7 +884,6 @@ type IStatContext interface {
 type StatContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
-	_OTHER antlr.Token
 }
 
 func NewEmptyStatContext() *StatContext {
@@ -710,10 +908,6 @@ func NewStatContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokin
 
 func (s *StatContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *StatContext) Get_OTHER() antlr.Token { return s._OTHER }
-
-func (s *StatContext) Set_OTHER(v antlr.Token) { s._OTHER = v }
-
 func (s *StatContext) Assignment() IAssignmentContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAssignmentContext)(nil)).Elem(), 0)
 
@@ -824,6 +1018,16 @@ func (s *StatContext) While_stat() IWhile_statContext {
 	return t.(IWhile_statContext)
 }
 
+func (s *StatContext) For_stat() IFor_statContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFor_statContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFor_statContext)
+}
+
 func (s *StatContext) Sleep_fn() ISleep_fnContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISleep_fnContext)(nil)).Elem(), 0)
 
@@ -854,8 +1058,94 @@ func (s *StatContext) Log() ILogContext {
 	return t.(ILogContext)
 }
 
-func (s *StatContext) OTHER() antlr.TerminalNode {
-	return s.GetToken(KlangParserOTHER, 0)
+func (s *StatContext) Func_decl() IFunc_declContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunc_declContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunc_declContext)
+}
+
+func (s *StatContext) Return_stat() IReturn_statContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IReturn_statContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IReturn_statContext)
+}
+
+func (s *StatContext) Break_stat() IBreak_statContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBreak_statContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBreak_statContext)
+}
+
+func (s *StatContext) Call_stat() ICall_statContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICall_statContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICall_statContext)
+}
+
+func (s *StatContext) Try_stat() ITry_statContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITry_statContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITry_statContext)
+}
+
+func (s *StatContext) Throw_stat() IThrow_statContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IThrow_statContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IThrow_statContext)
+}
+
+func (s *StatContext) Import_stat() IImport_statContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IImport_statContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IImport_statContext)
+}
+
+func (s *StatContext) Timeout_stat() ITimeout_statContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITimeout_statContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITimeout_statContext)
+}
+
+func (s *StatContext) StepInfo() IStepInfoContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStepInfoContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStepInfoContext)
 }
 
 func (s *StatContext) GetRuleContext() antlr.RuleContext {
@@ -898,127 +1188,177 @@ func (p *KlangParser) Stat() (localctx IStatContext) {
 		}
 	}()
 
-	p.SetState(107)
+	p.SetState(153)
 	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case KlangParserID:
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 1, p.GetParserRuleContext()) {
+	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(91)
+			p.SetState(129)
 			p.Assignment()
 		}
 
-	case KlangParserJSONEDIT:
+	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(92)
+			p.SetState(130)
 			p.Json_edit_fn()
 		}
 
-	case KlangParserJSONDELETE:
+	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(93)
+			p.SetState(131)
 			p.Json_delete_fn()
 		}
 
-	case KlangParserYAMLEDIT:
+	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(94)
+			p.SetState(132)
 			p.Yaml_edit_fn()
 		}
 
-	case KlangParserYAMLDELETE:
+	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(95)
+			p.SetState(133)
 			p.Yaml_delete_fn()
 		}
 
-	case KlangParserKUBEJSONDELETE:
+	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(96)
+			p.SetState(134)
 			p.Kube_json_delete_fn()
 		}
 
-	case KlangParserKUBEJSONEDIT:
+	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(97)
+			p.SetState(135)
 			p.Kube_json_edit_fn()
 		}
 
-	case KlangParserKUBEYAMLDELETE:
+	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(98)
+			p.SetState(136)
 			p.Kube_yaml_delete_fn()
 		}
 
-	case KlangParserKUBEYAMLEDIT:
+	case 9:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(99)
+			p.SetState(137)
 			p.Kube_yaml_edit_fn()
 		}
 
-	case KlangParserIF:
+	case 10:
 		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(100)
+			p.SetState(138)
 			p.If_stat()
 		}
 
-	case KlangParserWHILE:
+	case 11:
 		p.EnterOuterAlt(localctx, 11)
 		{
-			p.SetState(101)
+			p.SetState(139)
 			p.While_stat()
 		}
 
-	case KlangParserSLEEP:
+	case 12:
 		p.EnterOuterAlt(localctx, 12)
 		{
-			p.SetState(102)
-			p.Sleep_fn()
+			p.SetState(140)
+			p.For_stat()
 		}
 
-	case KlangParserEXIT:
+	case 13:
 		p.EnterOuterAlt(localctx, 13)
 		{
-			p.SetState(103)
-			p.Exit_fn()
+			p.SetState(141)
+			p.Sleep_fn()
 		}
 
-	case KlangParserLOG:
+	case 14:
 		p.EnterOuterAlt(localctx, 14)
 		{
-			p.SetState(104)
-			p.Log()
+			p.SetState(142)
+			p.Exit_fn()
 		}
 
-	case KlangParserOTHER:
+	case 15:
 		p.EnterOuterAlt(localctx, 15)
 		{
-			p.SetState(105)
+			p.SetState(143)
+			p.Log()
+		}
 
-			var _m = p.Match(KlangParserOTHER)
+	case 16:
+		p.EnterOuterAlt(localctx, 16)
+		{
+			p.SetState(144)
+			p.Func_decl()
+		}
 
-			localctx.(*StatContext)._OTHER = _m
+	case 17:
+		p.EnterOuterAlt(localctx, 17)
+		{
+			p.SetState(145)
+			p.Return_stat()
+		}
+
+	case 18:
+		p.EnterOuterAlt(localctx, 18)
+		{
+			p.SetState(146)
+			p.Break_stat()
+		}
+
+	case 19:
+		p.EnterOuterAlt(localctx, 19)
+		{
+			p.SetState(147)
+			p.Call_stat()
+		}
+
+	case 20:
+		p.EnterOuterAlt(localctx, 20)
+		{
+			p.SetState(148)
+			p.Try_stat()
+		}
+
+	case 21:
+		p.EnterOuterAlt(localctx, 21)
+		{
+			p.SetState(149)
+			p.Throw_stat()
+		}
+
+	case 22:
+		p.EnterOuterAlt(localctx, 22)
+		{
+			p.SetState(150)
+			p.Import_stat()
+		}
+
+	case 23:
+		p.EnterOuterAlt(localctx, 23)
+		{
+			p.SetState(151)
+			p.Timeout_stat()
+		}
+
+	case 24:
+		p.EnterOuterAlt(localctx, 24)
+		{
+			p.SetState(152)
+			p.StepInfo()
 		}
-		fmt.Println("unknown char: " + (func() string {
-			if localctx.(*StatContext).Get_OTHER() == nil {
-				return ""
-			} else {
-				return localctx.(*StatContext).Get_OTHER().GetText()
-			}
-		}()))
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
@@ -1084,6 +1424,14 @@ func (s *AssignmentContext) SCOL() antlr.TerminalNode {
 	return s.GetToken(KlangParserSCOL, 0)
 }
 
+func (s *AssignmentContext) LET() antlr.TerminalNode {
+	return s.GetToken(KlangParserLET, 0)
+}
+
+func (s *AssignmentContext) CONST() antlr.TerminalNode {
+	return s.GetToken(KlangParserCONST, 0)
+}
+
 func (s *AssignmentContext) Load_fn() ILoad_fnContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILoad_fnContext)(nil)).Elem(), 0)
 
@@ -1117,6 +1465,7 @@ func (s *AssignmentContext) ExitRule(listener antlr.ParseTreeListener) {
 func (p *KlangParser) Assignment() (localctx IAssignmentContext) {
 	localctx = NewAssignmentContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 6, KlangParserRULE_assignment)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -1134,44 +1483,76 @@ func (p *KlangParser) Assignment() (localctx IAssignmentContext) {
 		}
 	}()
 
-	p.SetState(119)
+	p.SetState(171)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 4, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
+		p.SetState(156)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == KlangParserLET || _la == KlangParserCONST {
+			p.SetState(155)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == KlangParserLET || _la == KlangParserCONST) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+
+		}
 		{
-			p.SetState(109)
+			p.SetState(158)
 			p.Match(KlangParserID)
 		}
 		{
-			p.SetState(110)
+			p.SetState(159)
 			p.Match(KlangParserASSIGN)
 		}
 		{
-			p.SetState(111)
+			p.SetState(160)
 			p.expr(0)
 		}
 		{
-			p.SetState(112)
+			p.SetState(161)
 			p.Match(KlangParserSCOL)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
+		p.SetState(164)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == KlangParserLET || _la == KlangParserCONST {
+			p.SetState(163)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == KlangParserLET || _la == KlangParserCONST) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+
+		}
 		{
-			p.SetState(114)
+			p.SetState(166)
 			p.Match(KlangParserID)
 		}
 		{
-			p.SetState(115)
+			p.SetState(167)
 			p.Match(KlangParserASSIGN)
 		}
 		{
-			p.SetState(116)
+			p.SetState(168)
 			p.Load_fn()
 		}
 		{
-			p.SetState(117)
+			p.SetState(169)
 			p.Match(KlangParserSCOL)
 		}
 
@@ -1274,11 +1655,11 @@ func (p *KlangParser) Shell_script() (localctx IShell_scriptContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(121)
+		p.SetState(173)
 		p.Match(KlangParserSHELLSCRIPT)
 	}
 	{
-		p.SetState(122)
+		p.SetState(174)
 		p.String_or_id()
 	}
 
@@ -1413,39 +1794,39 @@ func (p *KlangParser) Json_edit_fn() (localctx IJson_edit_fnContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(124)
+		p.SetState(176)
 		p.Match(KlangParserJSONEDIT)
 	}
 	{
-		p.SetState(125)
+		p.SetState(177)
 		p.Match(KlangParserOPAR)
 	}
 	{
-		p.SetState(126)
+		p.SetState(178)
 		p.Match(KlangParserID)
 	}
 	{
-		p.SetState(127)
+		p.SetState(179)
 		p.Match(KlangParserCOMMA)
 	}
 	{
-		p.SetState(128)
+		p.SetState(180)
 		p.String_or_id()
 	}
 	{
-		p.SetState(129)
+		p.SetState(181)
 		p.Match(KlangParserCOMMA)
 	}
 	{
-		p.SetState(130)
+		p.SetState(182)
 		p.expr(0)
 	}
 	{
-		p.SetState(131)
+		p.SetState(183)
 		p.Match(KlangParserCPAR)
 	}
 	{
-		p.SetState(132)
+		p.SetState(184)
 		p.Match(KlangParserSCOL)
 	}
 
@@ -1566,31 +1947,31 @@ func (p *KlangParser) Json_delete_fn() (localctx IJson_delete_fnContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(134)
+		p.SetState(186)
 		p.Match(KlangParserJSONDELETE)
 	}
 	{
-		p.SetState(135)
+		p.SetState(187)
 		p.Match(KlangParserOPAR)
 	}
 	{
-		p.SetState(136)
+		p.SetState(188)
 		p.Match(KlangParserID)
 	}
 	{
-		p.SetState(137)
+		p.SetState(189)
 		p.Match(KlangParserCOMMA)
 	}
 	{
-		p.SetState(138)
+		p.SetState(190)
 		p.String_or_id()
 	}
 	{
-		p.SetState(139)
+		p.SetState(191)
 		p.Match(KlangParserCPAR)
 	}
 	{
-		p.SetState(140)
+		p.SetState(192)
 		p.Match(KlangParserSCOL)
 	}
 
@@ -1730,54 +2111,54 @@ func (p *KlangParser) Yaml_edit_fn() (localctx IYaml_edit_fnContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(142)
+		p.SetState(194)
 		p.Match(KlangParserYAMLEDIT)
 	}
 	{
-		p.SetState(143)
+		p.SetState(195)
 		p.Match(KlangParserOPAR)
 	}
 	{
-		p.SetState(144)
+		p.SetState(196)
 		p.Match(KlangParserID)
 	}
 	{
-		p.SetState(145)
+		p.SetState(197)
 		p.Match(KlangParserCOMMA)
 	}
 	{
-		p.SetState(146)
+		p.SetState(198)
 		p.String_or_id()
 	}
 	{
-		p.SetState(147)
+		p.SetState(199)
 		p.Match(KlangParserCOMMA)
 	}
 	{
-		p.SetState(148)
+		p.SetState(200)
 		p.expr(0)
 	}
-	p.SetState(151)
+	p.SetState(203)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == KlangParserCOMMA {
 		{
-			p.SetState(149)
+			p.SetState(201)
 			p.Match(KlangParserCOMMA)
 		}
 		{
-			p.SetState(150)
+			p.SetState(202)
 			p.Match(KlangParserNUMBER)
 		}
 
 	}
 	{
-		p.SetState(153)
+		p.SetState(205)
 		p.Match(KlangParserCPAR)
 	}
 	{
-		p.SetState(154)
+		p.SetState(206)
 		p.Match(KlangParserSCOL)
 	}
 
@@ -1907,46 +2288,46 @@ func (p *KlangParser) Yaml_delete_fn() (localctx IYaml_delete_fnContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(156)
+		p.SetState(208)
 		p.Match(KlangParserYAMLDELETE)
 	}
 	{
-		p.SetState(157)
+		p.SetState(209)
 		p.Match(KlangParserOPAR)
 	}
 	{
-		p.SetState(158)
+		p.SetState(210)
 		p.Match(KlangParserID)
 	}
 	{
-		p.SetState(159)
+		p.SetState(211)
 		p.Match(KlangParserCOMMA)
 	}
 	{
-		p.SetState(160)
+		p.SetState(212)
 		p.String_or_id()
 	}
-	p.SetState(163)
+	p.SetState(215)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == KlangParserCOMMA {
 		{
-			p.SetState(161)
+			p.SetState(213)
 			p.Match(KlangParserCOMMA)
 		}
 		{
-			p.SetState(162)
+			p.SetState(214)
 			p.Match(KlangParserNUMBER)
 		}
 
 	}
 	{
-		p.SetState(165)
+		p.SetState(217)
 		p.Match(KlangParserCPAR)
 	}
 	{
-		p.SetState(166)
+		p.SetState(218)
 		p.Match(KlangParserSCOL)
 	}
 
@@ -2105,68 +2486,68 @@ func (p *KlangParser) Kube_json_edit_fn() (localctx IKube_json_edit_fnContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(168)
+		p.SetState(220)
 		p.Match(KlangParserKUBEJSONEDIT)
 	}
 	{
-		p.SetState(169)
+		p.SetState(221)
 		p.Match(KlangParserOPAR)
 	}
 	{
-		p.SetState(170)
+		p.SetState(222)
 		p.Match(KlangParserID)
 	}
 	{
-		p.SetState(171)
+		p.SetState(223)
 		p.Match(KlangParserCOMMA)
 	}
 	{
-		p.SetState(172)
+		p.SetState(224)
 		p.String_or_id()
 	}
 	{
-		p.SetState(173)
+		p.SetState(225)
 		p.Match(KlangParserCOMMA)
 	}
 	{
-		p.SetState(174)
+		p.SetState(226)
 		p.expr(0)
 	}
-	p.SetState(177)
+	p.SetState(229)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 5, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 7, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(175)
+			p.SetState(227)
 			p.Match(KlangParserCOMMA)
 		}
 		{
-			p.SetState(176)
+			p.SetState(228)
 			p.String_or_id()
 		}
 
 	}
-	p.SetState(181)
+	p.SetState(233)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == KlangParserCOMMA {
 		{
-			p.SetState(179)
+			p.SetState(231)
 			p.Match(KlangParserCOMMA)
 		}
 		{
-			p.SetState(180)
+			p.SetState(232)
 			p.AsObject()
 		}
 
 	}
 	{
-		p.SetState(183)
+		p.SetState(235)
 		p.Match(KlangParserCPAR)
 	}
 	{
-		p.SetState(184)
+		p.SetState(236)
 		p.Match(KlangParserSCOL)
 	}
 
@@ -2299,146 +2680,146 @@ func (p *KlangParser) Kube_json_delete_fn() (localctx IKube_json_delete_fnContex
 		}
 	}()
 
-	p.SetState(222)
+	p.SetState(274)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 7, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 9, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(186)
+			p.SetState(238)
 			p.Match(KlangParserKUBEJSONDELETE)
 		}
 		{
-			p.SetState(187)
+			p.SetState(239)
 			p.Match(KlangParserOPAR)
 		}
 		{
-			p.SetState(188)
+			p.SetState(240)
 			p.Match(KlangParserID)
 		}
 		{
-			p.SetState(189)
+			p.SetState(241)
 			p.Match(KlangParserCOMMA)
 		}
 		{
-			p.SetState(190)
+			p.SetState(242)
 			p.Filter()
 		}
 		{
-			p.SetState(191)
+			p.SetState(243)
 			p.Match(KlangParserCOMMA)
 		}
 		{
-			p.SetState(192)
+			p.SetState(244)
 			p.Pattern()
 		}
 		{
-			p.SetState(193)
+			p.SetState(245)
 			p.Match(KlangParserCPAR)
 		}
 		{
-			p.SetState(194)
+			p.SetState(246)
 			p.Match(KlangParserSCOL)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(196)
+			p.SetState(248)
 			p.Match(KlangParserKUBEJSONDELETE)
 		}
 		{
-			p.SetState(197)
+			p.SetState(249)
 			p.Match(KlangParserOPAR)
 		}
 		{
-			p.SetState(198)
+			p.SetState(250)
 			p.Match(KlangParserID)
 		}
 		{
-			p.SetState(199)
+			p.SetState(251)
 			p.Match(KlangParserCOMMA)
 		}
 		{
-			p.SetState(200)
+			p.SetState(252)
 			p.Pattern()
 		}
 		{
-			p.SetState(201)
+			p.SetState(253)
 			p.Match(KlangParserCOMMA)
 		}
 		{
-			p.SetState(202)
+			p.SetState(254)
 			p.Filter()
 		}
 		{
-			p.SetState(203)
+			p.SetState(255)
 			p.Match(KlangParserCPAR)
 		}
 		{
-			p.SetState(204)
+			p.SetState(256)
 			p.Match(KlangParserSCOL)
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(206)
+			p.SetState(258)
 			p.Match(KlangParserKUBEJSONDELETE)
 		}
 		{
-			p.SetState(207)
+			p.SetState(259)
 			p.Match(KlangParserOPAR)
 		}
 		{
-			p.SetState(208)
+			p.SetState(260)
 			p.Match(KlangParserID)
 		}
 		{
-			p.SetState(209)
+			p.SetState(261)
 			p.Match(KlangParserCOMMA)
 		}
 		{
-			p.SetState(210)
+			p.SetState(262)
 			p.Filter()
 		}
 		{
-			p.SetState(211)
+			p.SetState(263)
 			p.Match(KlangParserCPAR)
 		}
 		{
-			p.SetState(212)
+			p.SetState(264)
 			p.Match(KlangParserSCOL)
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(214)
+			p.SetState(266)
 			p.Match(KlangParserKUBEJSONDELETE)
 		}
 		{
-			p.SetState(215)
+			p.SetState(267)
 			p.Match(KlangParserOPAR)
 		}
 		{
-			p.SetState(216)
+			p.SetState(268)
 			p.Match(KlangParserID)
 		}
 		{
-			p.SetState(217)
+			p.SetState(269)
 			p.Match(KlangParserCOMMA)
 		}
 		{
-			p.SetState(218)
+			p.SetState(270)
 			p.Pattern()
 		}
 		{
-			p.SetState(219)
+			p.SetState(271)
 			p.Match(KlangParserCPAR)
 		}
 		{
-			p.SetState(220)
+			p.SetState(272)
 			p.Match(KlangParserSCOL)
 		}
 
@@ -2599,68 +2980,68 @@ func (p *KlangParser) Kube_yaml_edit_fn() (localctx IKube_yaml_edit_fnContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(224)
+		p.SetState(276)
 		p.Match(KlangParserKUBEYAMLEDIT)
 	}
 	{
-		p.SetState(225)
+		p.SetState(277)
 		p.Match(KlangParserOPAR)
 	}
 	{
-		p.SetState(226)
+		p.SetState(278)
 		p.Match(KlangParserID)
 	}
 	{
-		p.SetState(227)
+		p.SetState(279)
 		p.Match(KlangParserCOMMA)
 	}
 	{
-		p.SetState(228)
+		p.SetState(280)
 		p.String_or_id()
 	}
 	{
-		p.SetState(229)
+		p.SetState(281)
 		p.Match(KlangParserCOMMA)
 	}
 	{
-		p.SetState(230)
+		p.SetState(282)
 		p.expr(0)
 	}
-	p.SetState(233)
+	p.SetState(285)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 8, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 10, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(231)
+			p.SetState(283)
 			p.Match(KlangParserCOMMA)
 		}
 		{
-			p.SetState(232)
+			p.SetState(284)
 			p.String_or_id()
 		}
 
 	}
-	p.SetState(237)
+	p.SetState(289)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == KlangParserCOMMA {
 		{
-			p.SetState(235)
+			p.SetState(287)
 			p.Match(KlangParserCOMMA)
 		}
 		{
-			p.SetState(236)
+			p.SetState(288)
 			p.AsObject()
 		}
 
 	}
 	{
-		p.SetState(239)
+		p.SetState(291)
 		p.Match(KlangParserCPAR)
 	}
 	{
-		p.SetState(240)
+		p.SetState(292)
 		p.Match(KlangParserSCOL)
 	}
 
@@ -2793,146 +3174,146 @@ func (p *KlangParser) Kube_yaml_delete_fn() (localctx IKube_yaml_delete_fnContex
 		}
 	}()
 
-	p.SetState(278)
+	p.SetState(330)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 10, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 12, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(242)
+			p.SetState(294)
 			p.Match(KlangParserKUBEYAMLDELETE)
 		}
 		{
-			p.SetState(243)
+			p.SetState(295)
 			p.Match(KlangParserOPAR)
 		}
 		{
-			p.SetState(244)
+			p.SetState(296)
 			p.Match(KlangParserID)
 		}
 		{
-			p.SetState(245)
+			p.SetState(297)
 			p.Match(KlangParserCOMMA)
 		}
 		{
-			p.SetState(246)
+			p.SetState(298)
 			p.Filter()
 		}
 		{
-			p.SetState(247)
+			p.SetState(299)
 			p.Match(KlangParserCOMMA)
 		}
 		{
-			p.SetState(248)
+			p.SetState(300)
 			p.Pattern()
 		}
 		{
-			p.SetState(249)
+			p.SetState(301)
 			p.Match(KlangParserCPAR)
 		}
 		{
-			p.SetState(250)
+			p.SetState(302)
 			p.Match(KlangParserSCOL)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(252)
+			p.SetState(304)
 			p.Match(KlangParserKUBEYAMLDELETE)
 		}
 		{
-			p.SetState(253)
+			p.SetState(305)
 			p.Match(KlangParserOPAR)
 		}
 		{
-			p.SetState(254)
+			p.SetState(306)
 			p.Match(KlangParserID)
 		}
 		{
-			p.SetState(255)
+			p.SetState(307)
 			p.Match(KlangParserCOMMA)
 		}
 		{
-			p.SetState(256)
+			p.SetState(308)
 			p.Pattern()
 		}
 		{
-			p.SetState(257)
+			p.SetState(309)
 			p.Match(KlangParserCOMMA)
 		}
 		{
-			p.SetState(258)
+			p.SetState(310)
 			p.Filter()
 		}
 		{
-			p.SetState(259)
+			p.SetState(311)
 			p.Match(KlangParserCPAR)
 		}
 		{
-			p.SetState(260)
+			p.SetState(312)
 			p.Match(KlangParserSCOL)
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(262)
+			p.SetState(314)
 			p.Match(KlangParserKUBEYAMLDELETE)
 		}
 		{
-			p.SetState(263)
+			p.SetState(315)
 			p.Match(KlangParserOPAR)
 		}
 		{
-			p.SetState(264)
+			p.SetState(316)
 			p.Match(KlangParserID)
 		}
 		{
-			p.SetState(265)
+			p.SetState(317)
 			p.Match(KlangParserCOMMA)
 		}
 		{
-			p.SetState(266)
+			p.SetState(318)
 			p.Filter()
 		}
 		{
-			p.SetState(267)
+			p.SetState(319)
 			p.Match(KlangParserCPAR)
 		}
 		{
-			p.SetState(268)
+			p.SetState(320)
 			p.Match(KlangParserSCOL)
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(270)
+			p.SetState(322)
 			p.Match(KlangParserKUBEYAMLDELETE)
 		}
 		{
-			p.SetState(271)
+			p.SetState(323)
 			p.Match(KlangParserOPAR)
 		}
 		{
-			p.SetState(272)
+			p.SetState(324)
 			p.Match(KlangParserID)
 		}
 		{
-			p.SetState(273)
+			p.SetState(325)
 			p.Match(KlangParserCOMMA)
 		}
 		{
-			p.SetState(274)
+			p.SetState(326)
 			p.Pattern()
 		}
 		{
-			p.SetState(275)
+			p.SetState(327)
 			p.Match(KlangParserCPAR)
 		}
 		{
-			p.SetState(276)
+			p.SetState(328)
 			p.Match(KlangParserSCOL)
 		}
 
@@ -2983,8 +3364,14 @@ func (s *Sleep_fnContext) SLEEP() antlr.TerminalNode {
 	return s.GetToken(KlangParserSLEEP, 0)
 }
 
-func (s *Sleep_fnContext) NUMBER() antlr.TerminalNode {
-	return s.GetToken(KlangParserNUMBER, 0)
+func (s *Sleep_fnContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
 }
 
 func (s *Sleep_fnContext) SCOL() antlr.TerminalNode {
@@ -3033,15 +3420,15 @@ func (p *KlangParser) Sleep_fn() (localctx ISleep_fnContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(280)
+		p.SetState(332)
 		p.Match(KlangParserSLEEP)
 	}
 	{
-		p.SetState(281)
-		p.Match(KlangParserNUMBER)
+		p.SetState(333)
+		p.expr(0)
 	}
 	{
-		p.SetState(282)
+		p.SetState(334)
 		p.Match(KlangParserSCOL)
 	}
 
@@ -3140,15 +3527,15 @@ func (p *KlangParser) Exit_fn() (localctx IExit_fnContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(284)
+		p.SetState(336)
 		p.Match(KlangParserEXIT)
 	}
 	{
-		p.SetState(285)
+		p.SetState(337)
 		p.Match(KlangParserNUMBER)
 	}
 	{
-		p.SetState(286)
+		p.SetState(338)
 		p.Match(KlangParserSCOL)
 	}
 
@@ -3286,47 +3673,47 @@ func (p *KlangParser) If_stat() (localctx IIf_statContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(288)
+		p.SetState(340)
 		p.Match(KlangParserIF)
 	}
 	{
-		p.SetState(289)
+		p.SetState(341)
 		p.Condition_block()
 	}
-	p.SetState(295)
+	p.SetState(347)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 11, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 13, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(290)
+				p.SetState(342)
 				p.Match(KlangParserELSE)
 			}
 			{
-				p.SetState(291)
+				p.SetState(343)
 				p.Match(KlangParserIF)
 			}
 			{
-				p.SetState(292)
+				p.SetState(344)
 				p.Condition_block()
 			}
 
 		}
-		p.SetState(297)
+		p.SetState(349)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 11, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 13, p.GetParserRuleContext())
 	}
-	p.SetState(300)
+	p.SetState(352)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 12, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 14, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(298)
+			p.SetState(350)
 			p.Match(KlangParserELSE)
 		}
 		{
-			p.SetState(299)
+			p.SetState(351)
 			p.Stat_block()
 		}
 
@@ -3435,11 +3822,11 @@ func (p *KlangParser) Condition_block() (localctx ICondition_blockContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(302)
+		p.SetState(354)
 		p.expr(0)
 	}
 	{
-		p.SetState(303)
+		p.SetState(355)
 		p.Stat_block()
 	}
 
@@ -3552,29 +3939,29 @@ func (p *KlangParser) Stat_block() (localctx IStat_blockContext) {
 		}
 	}()
 
-	p.SetState(310)
+	p.SetState(362)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case KlangParserOBRACE:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(305)
+			p.SetState(357)
 			p.Match(KlangParserOBRACE)
 		}
 		{
-			p.SetState(306)
+			p.SetState(358)
 			p.Block()
 		}
 		{
-			p.SetState(307)
+			p.SetState(359)
 			p.Match(KlangParserCBRACE)
 		}
 
-	case KlangParserIF, KlangParserWHILE, KlangParserLOG, KlangParserEXIT, KlangParserJSONEDIT, KlangParserJSONDELETE, KlangParserYAMLEDIT, KlangParserYAMLDELETE, KlangParserKUBEJSONEDIT, KlangParserKUBEJSONDELETE, KlangParserKUBEYAMLEDIT, KlangParserKUBEYAMLDELETE, KlangParserSLEEP, KlangParserID, KlangParserOTHER:
+	case KlangParserIF, KlangParserWHILE, KlangParserFOR, KlangParserLOG, KlangParserREPLACE, KlangParserEXIT, KlangParserJSONEDIT, KlangParserJSONDELETE, KlangParserYAMLEDIT, KlangParserYAMLDELETE, KlangParserKUBEJSONEDIT, KlangParserKUBEJSONDELETE, KlangParserKUBEYAMLEDIT, KlangParserKUBEYAMLDELETE, KlangParserSLEEP, KlangParserSTEPINFO, KlangParserFUNC, KlangParserRETURN, KlangParserBREAK, KlangParserTRY, KlangParserTHROW, KlangParserIMPORT, KlangParserLET, KlangParserCONST, KlangParserTIMEOUT, KlangParserID, KlangParserPATH:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(309)
+			p.SetState(361)
 			p.Stat()
 		}
 
@@ -3689,15 +4076,174 @@ func (p *KlangParser) While_stat() (localctx IWhile_statContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(312)
+		p.SetState(364)
 		p.Match(KlangParserWHILE)
 	}
 	{
-		p.SetState(313)
+		p.SetState(365)
+		p.expr(0)
+	}
+	{
+		p.SetState(366)
+		p.Stat_block()
+	}
+
+	return localctx
+}
+
+// IFor_statContext is an interface to support dynamic dispatch.
+type IFor_statContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsFor_statContext differentiates from other interfaces.
+	IsFor_statContext()
+}
+
+type For_statContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFor_statContext() *For_statContext {
+	var p = new(For_statContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_for_stat
+	return p
+}
+
+func (*For_statContext) IsFor_statContext() {}
+
+func NewFor_statContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *For_statContext {
+	var p = new(For_statContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KlangParserRULE_for_stat
+
+	return p
+}
+
+func (s *For_statContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *For_statContext) FOR() antlr.TerminalNode {
+	return s.GetToken(KlangParserFOR, 0)
+}
+
+func (s *For_statContext) AllID() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserID)
+}
+
+func (s *For_statContext) ID(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserID, i)
+}
+
+func (s *For_statContext) IN() antlr.TerminalNode {
+	return s.GetToken(KlangParserIN, 0)
+}
+
+func (s *For_statContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *For_statContext) Stat_block() IStat_blockContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStat_blockContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStat_blockContext)
+}
+
+func (s *For_statContext) COMMA() antlr.TerminalNode {
+	return s.GetToken(KlangParserCOMMA, 0)
+}
+
+func (s *For_statContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *For_statContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *For_statContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterFor_stat(s)
+	}
+}
+
+func (s *For_statContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitFor_stat(s)
+	}
+}
+
+func (p *KlangParser) For_stat() (localctx IFor_statContext) {
+	localctx = NewFor_statContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 38, KlangParserRULE_for_stat)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(368)
+		p.Match(KlangParserFOR)
+	}
+	{
+		p.SetState(369)
+		p.Match(KlangParserID)
+	}
+	p.SetState(372)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == KlangParserCOMMA {
+		{
+			p.SetState(370)
+			p.Match(KlangParserCOMMA)
+		}
+		{
+			p.SetState(371)
+			p.Match(KlangParserID)
+		}
+
+	}
+	{
+		p.SetState(374)
+		p.Match(KlangParserIN)
+	}
+	{
+		p.SetState(375)
 		p.expr(0)
 	}
 	{
-		p.SetState(314)
+		p.SetState(376)
 		p.Stat_block()
 	}
 
@@ -3782,7 +4328,7 @@ func (s *LogContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *KlangParser) Log() (localctx ILogContext) {
 	localctx = NewLogContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, KlangParserRULE_log)
+	p.EnterRule(localctx, 40, KlangParserRULE_log)
 
 	defer func() {
 		p.ExitRule()
@@ -3802,535 +4348,605 @@ func (p *KlangParser) Log() (localctx ILogContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(316)
+		p.SetState(378)
 		p.Match(KlangParserLOG)
 	}
 	{
-		p.SetState(317)
+		p.SetState(379)
 		p.expr(0)
 	}
 	{
-		p.SetState(318)
+		p.SetState(380)
 		p.Match(KlangParserSCOL)
 	}
 
 	return localctx
 }
 
-// IKubectl_commandContext is an interface to support dynamic dispatch.
-type IKubectl_commandContext interface {
+// IFunc_declContext is an interface to support dynamic dispatch.
+type IFunc_declContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsKubectl_commandContext differentiates from other interfaces.
-	IsKubectl_commandContext()
+	// IsFunc_declContext differentiates from other interfaces.
+	IsFunc_declContext()
 }
 
-type Kubectl_commandContext struct {
+type Func_declContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyKubectl_commandContext() *Kubectl_commandContext {
-	var p = new(Kubectl_commandContext)
+func NewEmptyFunc_declContext() *Func_declContext {
+	var p = new(Func_declContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = KlangParserRULE_kubectl_command
+	p.RuleIndex = KlangParserRULE_func_decl
 	return p
 }
 
-func (*Kubectl_commandContext) IsKubectl_commandContext() {}
+func (*Func_declContext) IsFunc_declContext() {}
 
-func NewKubectl_commandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Kubectl_commandContext {
-	var p = new(Kubectl_commandContext)
+func NewFunc_declContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Func_declContext {
+	var p = new(Func_declContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = KlangParserRULE_kubectl_command
+	p.RuleIndex = KlangParserRULE_func_decl
 
 	return p
 }
 
-func (s *Kubectl_commandContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *Kubectl_commandContext) CopyFrom(ctx *Kubectl_commandContext) {
-	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
-}
-
-func (s *Kubectl_commandContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *Func_declContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *Kubectl_commandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *Func_declContext) FUNC() antlr.TerminalNode {
+	return s.GetToken(KlangParserFUNC, 0)
 }
 
-type DeleteKubectlCommandContext struct {
-	*Kubectl_commandContext
+func (s *Func_declContext) ID() antlr.TerminalNode {
+	return s.GetToken(KlangParserID, 0)
 }
 
-func NewDeleteKubectlCommandContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *DeleteKubectlCommandContext {
-	var p = new(DeleteKubectlCommandContext)
-
-	p.Kubectl_commandContext = NewEmptyKubectl_commandContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*Kubectl_commandContext))
-
-	return p
+func (s *Func_declContext) OPAR() antlr.TerminalNode {
+	return s.GetToken(KlangParserOPAR, 0)
 }
 
-func (s *DeleteKubectlCommandContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *Func_declContext) CPAR() antlr.TerminalNode {
+	return s.GetToken(KlangParserCPAR, 0)
 }
 
-func (s *DeleteKubectlCommandContext) KUBECTL() antlr.TerminalNode {
-	return s.GetToken(KlangParserKUBECTL, 0)
+func (s *Func_declContext) OBRACE() antlr.TerminalNode {
+	return s.GetToken(KlangParserOBRACE, 0)
 }
 
-func (s *DeleteKubectlCommandContext) DELETE() antlr.TerminalNode {
-	return s.GetToken(KlangParserDELETE, 0)
-}
+func (s *Func_declContext) Block() IBlockContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBlockContext)(nil)).Elem(), 0)
 
-func (s *DeleteKubectlCommandContext) AllNAMESPACE() []antlr.TerminalNode {
-	return s.GetTokens(KlangParserNAMESPACE)
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *DeleteKubectlCommandContext) NAMESPACE(i int) antlr.TerminalNode {
-	return s.GetToken(KlangParserNAMESPACE, i)
+	return t.(IBlockContext)
 }
 
-func (s *DeleteKubectlCommandContext) AllNs() []INsContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*INsContext)(nil)).Elem())
-	var tst = make([]INsContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(INsContext)
-		}
-	}
-
-	return tst
+func (s *Func_declContext) CBRACE() antlr.TerminalNode {
+	return s.GetToken(KlangParserCBRACE, 0)
 }
 
-func (s *DeleteKubectlCommandContext) Ns(i int) INsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*INsContext)(nil)).Elem(), i)
+func (s *Func_declContext) Param_list() IParam_listContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IParam_listContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(INsContext)
+	return t.(IParam_listContext)
 }
 
-func (s *DeleteKubectlCommandContext) AllResource() []IResourceContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IResourceContext)(nil)).Elem())
-	var tst = make([]IResourceContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IResourceContext)
-		}
-	}
-
-	return tst
+func (s *Func_declContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *DeleteKubectlCommandContext) Resource(i int) IResourceContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IResourceContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IResourceContext)
+func (s *Func_declContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *DeleteKubectlCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *Func_declContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterDeleteKubectlCommand(s)
+		listenerT.EnterFunc_decl(s)
 	}
 }
 
-func (s *DeleteKubectlCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *Func_declContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitDeleteKubectlCommand(s)
+		listenerT.ExitFunc_decl(s)
 	}
 }
 
-type PatchKubectlCommandContext struct {
-	*Kubectl_commandContext
-}
-
-func NewPatchKubectlCommandContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PatchKubectlCommandContext {
-	var p = new(PatchKubectlCommandContext)
-
-	p.Kubectl_commandContext = NewEmptyKubectl_commandContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*Kubectl_commandContext))
+func (p *KlangParser) Func_decl() (localctx IFunc_declContext) {
+	localctx = NewFunc_declContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 42, KlangParserRULE_func_decl)
+	var _la int
 
-	return p
-}
+	defer func() {
+		p.ExitRule()
+	}()
 
-func (s *PatchKubectlCommandContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
 
-func (s *PatchKubectlCommandContext) KUBECTL() antlr.TerminalNode {
-	return s.GetToken(KlangParserKUBECTL, 0)
-}
-
-func (s *PatchKubectlCommandContext) PATCH() antlr.TerminalNode {
-	return s.GetToken(KlangParserPATCH, 0)
-}
-
-func (s *PatchKubectlCommandContext) AllNAMESPACE() []antlr.TerminalNode {
-	return s.GetTokens(KlangParserNAMESPACE)
-}
-
-func (s *PatchKubectlCommandContext) NAMESPACE(i int) antlr.TerminalNode {
-	return s.GetToken(KlangParserNAMESPACE, i)
-}
-
-func (s *PatchKubectlCommandContext) AllNs() []INsContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*INsContext)(nil)).Elem())
-	var tst = make([]INsContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(INsContext)
-		}
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(382)
+		p.Match(KlangParserFUNC)
 	}
-
-	return tst
-}
-
-func (s *PatchKubectlCommandContext) Ns(i int) INsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*INsContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
+	{
+		p.SetState(383)
+		p.Match(KlangParserID)
 	}
+	{
+		p.SetState(384)
+		p.Match(KlangParserOPAR)
+	}
+	p.SetState(386)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	return t.(INsContext)
-}
-
-func (s *PatchKubectlCommandContext) AllResource() []IResourceContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IResourceContext)(nil)).Elem())
-	var tst = make([]IResourceContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IResourceContext)
+	if _la == KlangParserID {
+		{
+			p.SetState(385)
+			p.Param_list()
 		}
+
+	}
+	{
+		p.SetState(388)
+		p.Match(KlangParserCPAR)
+	}
+	{
+		p.SetState(389)
+		p.Match(KlangParserOBRACE)
+	}
+	{
+		p.SetState(390)
+		p.Block()
+	}
+	{
+		p.SetState(391)
+		p.Match(KlangParserCBRACE)
 	}
 
-	return tst
+	return localctx
 }
 
-func (s *PatchKubectlCommandContext) Resource(i int) IResourceContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IResourceContext)(nil)).Elem(), i)
+// IParam_listContext is an interface to support dynamic dispatch.
+type IParam_listContext interface {
+	antlr.ParserRuleContext
 
-	if t == nil {
-		return nil
-	}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-	return t.(IResourceContext)
+	// IsParam_listContext differentiates from other interfaces.
+	IsParam_listContext()
 }
 
-func (s *PatchKubectlCommandContext) AllPATCHTYPE() []antlr.TerminalNode {
-	return s.GetTokens(KlangParserPATCHTYPE)
+type Param_listContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
 }
 
-func (s *PatchKubectlCommandContext) PATCHTYPE(i int) antlr.TerminalNode {
-	return s.GetToken(KlangParserPATCHTYPE, i)
+func NewEmptyParam_listContext() *Param_listContext {
+	var p = new(Param_listContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_param_list
+	return p
 }
 
-func (s *PatchKubectlCommandContext) AllPatch_type() []IPatch_typeContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IPatch_typeContext)(nil)).Elem())
-	var tst = make([]IPatch_typeContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IPatch_typeContext)
-		}
-	}
+func (*Param_listContext) IsParam_listContext() {}
 
-	return tst
-}
+func NewParam_listContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Param_listContext {
+	var p = new(Param_listContext)
 
-func (s *PatchKubectlCommandContext) Patch_type(i int) IPatch_typeContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPatch_typeContext)(nil)).Elem(), i)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-	if t == nil {
-		return nil
-	}
+	p.parser = parser
+	p.RuleIndex = KlangParserRULE_param_list
 
-	return t.(IPatch_typeContext)
+	return p
 }
 
-func (s *PatchKubectlCommandContext) AllPATCHLOAD() []antlr.TerminalNode {
-	return s.GetTokens(KlangParserPATCHLOAD)
-}
+func (s *Param_listContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *PatchKubectlCommandContext) PATCHLOAD(i int) antlr.TerminalNode {
-	return s.GetToken(KlangParserPATCHLOAD, i)
+func (s *Param_listContext) AllID() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserID)
 }
 
-func (s *PatchKubectlCommandContext) AllString_or_id() []IString_or_idContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IString_or_idContext)(nil)).Elem())
-	var tst = make([]IString_or_idContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IString_or_idContext)
-		}
-	}
+func (s *Param_listContext) ID(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserID, i)
+}
 
-	return tst
+func (s *Param_listContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserCOMMA)
 }
 
-func (s *PatchKubectlCommandContext) String_or_id(i int) IString_or_idContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), i)
+func (s *Param_listContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserCOMMA, i)
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *Param_listContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-	return t.(IString_or_idContext)
+func (s *Param_listContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *PatchKubectlCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *Param_listContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterPatchKubectlCommand(s)
+		listenerT.EnterParam_list(s)
 	}
 }
 
-func (s *PatchKubectlCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *Param_listContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitPatchKubectlCommand(s)
+		listenerT.ExitParam_list(s)
 	}
 }
 
-type ApplyKubectlCommandContext struct {
-	*Kubectl_commandContext
-}
+func (p *KlangParser) Param_list() (localctx IParam_listContext) {
+	localctx = NewParam_listContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, KlangParserRULE_param_list)
+	var _la int
 
-func NewApplyKubectlCommandContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ApplyKubectlCommandContext {
-	var p = new(ApplyKubectlCommandContext)
+	defer func() {
+		p.ExitRule()
+	}()
 
-	p.Kubectl_commandContext = NewEmptyKubectl_commandContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*Kubectl_commandContext))
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
 
-	return p
-}
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(393)
+		p.Match(KlangParserID)
+	}
+	p.SetState(398)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-func (s *ApplyKubectlCommandContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+	for _la == KlangParserCOMMA {
+		{
+			p.SetState(394)
+			p.Match(KlangParserCOMMA)
+		}
+		{
+			p.SetState(395)
+			p.Match(KlangParserID)
+		}
 
-func (s *ApplyKubectlCommandContext) KUBECTL() antlr.TerminalNode {
-	return s.GetToken(KlangParserKUBECTL, 0)
-}
+		p.SetState(400)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
 
-func (s *ApplyKubectlCommandContext) APPLY() antlr.TerminalNode {
-	return s.GetToken(KlangParserAPPLY, 0)
+	return localctx
 }
 
-func (s *ApplyKubectlCommandContext) AllNAMESPACE() []antlr.TerminalNode {
-	return s.GetTokens(KlangParserNAMESPACE)
+// IReturn_statContext is an interface to support dynamic dispatch.
+type IReturn_statContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsReturn_statContext differentiates from other interfaces.
+	IsReturn_statContext()
 }
 
-func (s *ApplyKubectlCommandContext) NAMESPACE(i int) antlr.TerminalNode {
-	return s.GetToken(KlangParserNAMESPACE, i)
+type Return_statContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
 }
 
-func (s *ApplyKubectlCommandContext) AllNs() []INsContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*INsContext)(nil)).Elem())
-	var tst = make([]INsContext, len(ts))
+func NewEmptyReturn_statContext() *Return_statContext {
+	var p = new(Return_statContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_return_stat
+	return p
+}
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(INsContext)
-		}
-	}
+func (*Return_statContext) IsReturn_statContext() {}
 
-	return tst
-}
+func NewReturn_statContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Return_statContext {
+	var p = new(Return_statContext)
 
-func (s *ApplyKubectlCommandContext) Ns(i int) INsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*INsContext)(nil)).Elem(), i)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-	if t == nil {
-		return nil
-	}
+	p.parser = parser
+	p.RuleIndex = KlangParserRULE_return_stat
 
-	return t.(INsContext)
+	return p
 }
 
-func (s *ApplyKubectlCommandContext) AllString_or_id() []IString_or_idContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IString_or_idContext)(nil)).Elem())
-	var tst = make([]IString_or_idContext, len(ts))
+func (s *Return_statContext) GetParser() antlr.Parser { return s.parser }
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IString_or_idContext)
-		}
-	}
+func (s *Return_statContext) RETURN() antlr.TerminalNode {
+	return s.GetToken(KlangParserRETURN, 0)
+}
 
-	return tst
+func (s *Return_statContext) SCOL() antlr.TerminalNode {
+	return s.GetToken(KlangParserSCOL, 0)
 }
 
-func (s *ApplyKubectlCommandContext) String_or_id(i int) IString_or_idContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), i)
+func (s *Return_statContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IString_or_idContext)
+	return t.(IExprContext)
 }
 
-func (s *ApplyKubectlCommandContext) AllUPDATELOAD() []antlr.TerminalNode {
-	return s.GetTokens(KlangParserUPDATELOAD)
+func (s *Return_statContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *ApplyKubectlCommandContext) UPDATELOAD(i int) antlr.TerminalNode {
-	return s.GetToken(KlangParserUPDATELOAD, i)
+func (s *Return_statContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ApplyKubectlCommandContext) AllKubernetes_object_config() []IKubernetes_object_configContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IKubernetes_object_configContext)(nil)).Elem())
-	var tst = make([]IKubernetes_object_configContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IKubernetes_object_configContext)
-		}
+func (s *Return_statContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterReturn_stat(s)
 	}
+}
 
-	return tst
+func (s *Return_statContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitReturn_stat(s)
+	}
 }
 
-func (s *ApplyKubectlCommandContext) Kubernetes_object_config(i int) IKubernetes_object_configContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IKubernetes_object_configContext)(nil)).Elem(), i)
+func (p *KlangParser) Return_stat() (localctx IReturn_statContext) {
+	localctx = NewReturn_statContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, KlangParserRULE_return_stat)
+	var _la int
 
-	if t == nil {
-		return nil
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(401)
+		p.Match(KlangParserRETURN)
 	}
+	p.SetState(403)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	return t.(IKubernetes_object_configContext)
-}
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<KlangParserT__1)|(1<<KlangParserT__4)|(1<<KlangParserMINUS)|(1<<KlangParserNOT)|(1<<KlangParserOPAR)|(1<<KlangParserOBRACE)|(1<<KlangParserTRUE)|(1<<KlangParserFALSE)|(1<<KlangParserNIL))) != 0) || (((_la-38)&-(0x1f+1)) == 0 && ((1<<uint((_la-38)))&((1<<(KlangParserKUBECTL-38))|(1<<(KlangParserREPLACE-38))|(1<<(KlangParserJSONSELECT-38)))) != 0) || (((_la-70)&-(0x1f+1)) == 0 && ((1<<uint((_la-70)))&((1<<(KlangParserYAMLSELECT-70))|(1<<(KlangParserSHELLSCRIPT-70))|(1<<(KlangParserDOWNLOAD-70))|(1<<(KlangParserDURATION-70))|(1<<(KlangParserID-70))|(1<<(KlangParserNUMBER-70))|(1<<(KlangParserPATH-70))|(1<<(KlangParserRAW_STRING_LIT-70))|(1<<(KlangParserSTRING-70)))) != 0) {
+		{
+			p.SetState(402)
+			p.expr(0)
+		}
 
-func (s *ApplyKubectlCommandContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterApplyKubectlCommand(s)
 	}
+	{
+		p.SetState(405)
+		p.Match(KlangParserSCOL)
+	}
+
+	return localctx
 }
 
-func (s *ApplyKubectlCommandContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitApplyKubectlCommand(s)
-	}
+// IBreak_statContext is an interface to support dynamic dispatch.
+type IBreak_statContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsBreak_statContext differentiates from other interfaces.
+	IsBreak_statContext()
 }
 
-type GetKubectlCommandContext struct {
-	*Kubectl_commandContext
+type Break_statContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
 }
 
-func NewGetKubectlCommandContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *GetKubectlCommandContext {
-	var p = new(GetKubectlCommandContext)
+func NewEmptyBreak_statContext() *Break_statContext {
+	var p = new(Break_statContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_break_stat
+	return p
+}
+
+func (*Break_statContext) IsBreak_statContext() {}
+
+func NewBreak_statContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Break_statContext {
+	var p = new(Break_statContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-	p.Kubectl_commandContext = NewEmptyKubectl_commandContext()
 	p.parser = parser
-	p.CopyFrom(ctx.(*Kubectl_commandContext))
+	p.RuleIndex = KlangParserRULE_break_stat
 
 	return p
 }
 
-func (s *GetKubectlCommandContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *Break_statContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Break_statContext) BREAK() antlr.TerminalNode {
+	return s.GetToken(KlangParserBREAK, 0)
 }
 
-func (s *GetKubectlCommandContext) KUBECTL() antlr.TerminalNode {
-	return s.GetToken(KlangParserKUBECTL, 0)
+func (s *Break_statContext) SCOL() antlr.TerminalNode {
+	return s.GetToken(KlangParserSCOL, 0)
 }
 
-func (s *GetKubectlCommandContext) GET() antlr.TerminalNode {
-	return s.GetToken(KlangParserGET, 0)
+func (s *Break_statContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *GetKubectlCommandContext) AllNAMESPACE() []antlr.TerminalNode {
-	return s.GetTokens(KlangParserNAMESPACE)
+func (s *Break_statContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *GetKubectlCommandContext) NAMESPACE(i int) antlr.TerminalNode {
-	return s.GetToken(KlangParserNAMESPACE, i)
+func (s *Break_statContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterBreak_stat(s)
+	}
 }
 
-func (s *GetKubectlCommandContext) AllNs() []INsContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*INsContext)(nil)).Elem())
-	var tst = make([]INsContext, len(ts))
+func (s *Break_statContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitBreak_stat(s)
+	}
+}
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(INsContext)
+func (p *KlangParser) Break_stat() (localctx IBreak_statContext) {
+	localctx = NewBreak_statContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 48, KlangParserRULE_break_stat)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
 		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(407)
+		p.Match(KlangParserBREAK)
+	}
+	{
+		p.SetState(408)
+		p.Match(KlangParserSCOL)
 	}
 
-	return tst
+	return localctx
 }
 
-func (s *GetKubectlCommandContext) Ns(i int) INsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*INsContext)(nil)).Elem(), i)
+// ICall_statContext is an interface to support dynamic dispatch.
+type ICall_statContext interface {
+	antlr.ParserRuleContext
 
-	if t == nil {
-		return nil
-	}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-	return t.(INsContext)
+	// IsCall_statContext differentiates from other interfaces.
+	IsCall_statContext()
 }
 
-func (s *GetKubectlCommandContext) AllResource() []IResourceContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IResourceContext)(nil)).Elem())
-	var tst = make([]IResourceContext, len(ts))
+type Call_statContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IResourceContext)
-		}
-	}
+func NewEmptyCall_statContext() *Call_statContext {
+	var p = new(Call_statContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_call_stat
+	return p
+}
 
-	return tst
+func (*Call_statContext) IsCall_statContext() {}
+
+func NewCall_statContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Call_statContext {
+	var p = new(Call_statContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KlangParserRULE_call_stat
+
+	return p
 }
 
-func (s *GetKubectlCommandContext) Resource(i int) IResourceContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IResourceContext)(nil)).Elem(), i)
+func (s *Call_statContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Call_statContext) Func_call() IFunc_callContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunc_callContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IResourceContext)
+	return t.(IFunc_callContext)
 }
 
-func (s *GetKubectlCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *Call_statContext) SCOL() antlr.TerminalNode {
+	return s.GetToken(KlangParserSCOL, 0)
+}
+
+func (s *Call_statContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Call_statContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Call_statContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterGetKubectlCommand(s)
+		listenerT.EnterCall_stat(s)
 	}
 }
 
-func (s *GetKubectlCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *Call_statContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitGetKubectlCommand(s)
+		listenerT.ExitCall_stat(s)
 	}
 }
 
-func (p *KlangParser) Kubectl_command() (localctx IKubectl_commandContext) {
-	localctx = NewKubectl_commandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 40, KlangParserRULE_kubectl_command)
+func (p *KlangParser) Call_stat() (localctx ICall_statContext) {
+	localctx = NewCall_statContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, KlangParserRULE_call_stat)
 
 	defer func() {
 		p.ExitRule()
@@ -4348,346 +4964,122 @@ func (p *KlangParser) Kubectl_command() (localctx IKubectl_commandContext) {
 		}
 	}()
 
-	var _alt int
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(410)
+		p.Func_call()
+	}
+	{
+		p.SetState(411)
+		p.Match(KlangParserSCOL)
+	}
 
-	p.SetState(362)
-	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 22, p.GetParserRuleContext()) {
-	case 1:
-		localctx = NewApplyKubectlCommandContext(p, localctx)
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(320)
-			p.Match(KlangParserKUBECTL)
-		}
-		{
-			p.SetState(321)
-			p.Match(KlangParserAPPLY)
-		}
-		p.SetState(327)
-		p.GetErrorHandler().Sync(p)
-		_alt = 1
-		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-			switch _alt {
-			case 1:
-				p.SetState(327)
-				p.GetErrorHandler().Sync(p)
+	return localctx
+}
 
-				switch p.GetTokenStream().LA(1) {
-				case KlangParserNAMESPACE:
-					{
-						p.SetState(322)
-						p.Match(KlangParserNAMESPACE)
-					}
-					{
-						p.SetState(323)
-						p.Ns()
-					}
+// ITry_statContext is an interface to support dynamic dispatch.
+type ITry_statContext interface {
+	antlr.ParserRuleContext
 
-				case KlangParserID, KlangParserRAW_STRING_LIT, KlangParserSTRING:
-					{
-						p.SetState(324)
-						p.String_or_id()
-					}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-				case KlangParserUPDATELOAD:
-					{
-						p.SetState(325)
-						p.Match(KlangParserUPDATELOAD)
-					}
-					{
-						p.SetState(326)
-						p.Kubernetes_object_config()
-					}
+	// IsTry_statContext differentiates from other interfaces.
+	IsTry_statContext()
+}
 
-				default:
-					panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-				}
+type Try_statContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
 
-			default:
-				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-			}
+func NewEmptyTry_statContext() *Try_statContext {
+	var p = new(Try_statContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_try_stat
+	return p
+}
 
-			p.SetState(329)
-			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 15, p.GetParserRuleContext())
-		}
+func (*Try_statContext) IsTry_statContext() {}
 
-	case 2:
-		localctx = NewPatchKubectlCommandContext(p, localctx)
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(331)
-			p.Match(KlangParserKUBECTL)
-		}
-		{
-			p.SetState(332)
-			p.Match(KlangParserPATCH)
-		}
-		p.SetState(340)
-		p.GetErrorHandler().Sync(p)
-		_alt = 1
-		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-			switch _alt {
-			case 1:
-				p.SetState(340)
-				p.GetErrorHandler().Sync(p)
+func NewTry_statContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Try_statContext {
+	var p = new(Try_statContext)
 
-				switch p.GetTokenStream().LA(1) {
-				case KlangParserNAMESPACE:
-					{
-						p.SetState(333)
-						p.Match(KlangParserNAMESPACE)
-					}
-					{
-						p.SetState(334)
-						p.Ns()
-					}
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-				case KlangParserID, KlangParserPATH, KlangParserRAW_STRING_LIT, KlangParserSTRING:
-					{
-						p.SetState(335)
-						p.Resource()
-					}
-
-				case KlangParserPATCHTYPE:
-					{
-						p.SetState(336)
-						p.Match(KlangParserPATCHTYPE)
-					}
-					{
-						p.SetState(337)
-						p.Patch_type()
-					}
-
-				case KlangParserPATCHLOAD:
-					{
-						p.SetState(338)
-						p.Match(KlangParserPATCHLOAD)
-					}
-					{
-						p.SetState(339)
-						p.String_or_id()
-					}
-
-				default:
-					panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-				}
-
-			default:
-				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-			}
-
-			p.SetState(342)
-			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 17, p.GetParserRuleContext())
-		}
-
-	case 3:
-		localctx = NewGetKubectlCommandContext(p, localctx)
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(344)
-			p.Match(KlangParserKUBECTL)
-		}
-		{
-			p.SetState(345)
-			p.Match(KlangParserGET)
-		}
-		p.SetState(349)
-		p.GetErrorHandler().Sync(p)
-		_alt = 1
-		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-			switch _alt {
-			case 1:
-				p.SetState(349)
-				p.GetErrorHandler().Sync(p)
-
-				switch p.GetTokenStream().LA(1) {
-				case KlangParserNAMESPACE:
-					{
-						p.SetState(346)
-						p.Match(KlangParserNAMESPACE)
-					}
-					{
-						p.SetState(347)
-						p.Ns()
-					}
-
-				case KlangParserID, KlangParserPATH, KlangParserRAW_STRING_LIT, KlangParserSTRING:
-					{
-						p.SetState(348)
-						p.Resource()
-					}
-
-				default:
-					panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-				}
-
-			default:
-				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-			}
-
-			p.SetState(351)
-			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 19, p.GetParserRuleContext())
-		}
-
-	case 4:
-		localctx = NewDeleteKubectlCommandContext(p, localctx)
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(353)
-			p.Match(KlangParserKUBECTL)
-		}
-		{
-			p.SetState(354)
-			p.Match(KlangParserDELETE)
-		}
-		p.SetState(358)
-		p.GetErrorHandler().Sync(p)
-		_alt = 1
-		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-			switch _alt {
-			case 1:
-				p.SetState(358)
-				p.GetErrorHandler().Sync(p)
-
-				switch p.GetTokenStream().LA(1) {
-				case KlangParserNAMESPACE:
-					{
-						p.SetState(355)
-						p.Match(KlangParserNAMESPACE)
-					}
-					{
-						p.SetState(356)
-						p.Ns()
-					}
-
-				case KlangParserID, KlangParserPATH, KlangParserRAW_STRING_LIT, KlangParserSTRING:
-					{
-						p.SetState(357)
-						p.Resource()
-					}
-
-				default:
-					panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-				}
-
-			default:
-				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-			}
-
-			p.SetState(360)
-			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 21, p.GetParserRuleContext())
-		}
-
-	}
+	p.parser = parser
+	p.RuleIndex = KlangParserRULE_try_stat
 
-	return localctx
+	return p
 }
 
-// IDownload_fnContext is an interface to support dynamic dispatch.
-type IDownload_fnContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// IsDownload_fnContext differentiates from other interfaces.
-	IsDownload_fnContext()
-}
+func (s *Try_statContext) GetParser() antlr.Parser { return s.parser }
 
-type Download_fnContext struct {
-	*antlr.BaseParserRuleContext
-	parser antlr.Parser
+func (s *Try_statContext) TRY() antlr.TerminalNode {
+	return s.GetToken(KlangParserTRY, 0)
 }
 
-func NewEmptyDownload_fnContext() *Download_fnContext {
-	var p = new(Download_fnContext)
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = KlangParserRULE_download_fn
-	return p
+func (s *Try_statContext) OBRACE() antlr.TerminalNode {
+	return s.GetToken(KlangParserOBRACE, 0)
 }
 
-func (*Download_fnContext) IsDownload_fnContext() {}
-
-func NewDownload_fnContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Download_fnContext {
-	var p = new(Download_fnContext)
-
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = KlangParserRULE_download_fn
-
-	return p
-}
+func (s *Try_statContext) Block() IBlockContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBlockContext)(nil)).Elem(), 0)
 
-func (s *Download_fnContext) GetParser() antlr.Parser { return s.parser }
+	if t == nil {
+		return nil
+	}
 
-func (s *Download_fnContext) DOWNLOAD() antlr.TerminalNode {
-	return s.GetToken(KlangParserDOWNLOAD, 0)
+	return t.(IBlockContext)
 }
 
-func (s *Download_fnContext) OPAR() antlr.TerminalNode {
-	return s.GetToken(KlangParserOPAR, 0)
+func (s *Try_statContext) CBRACE() antlr.TerminalNode {
+	return s.GetToken(KlangParserCBRACE, 0)
 }
 
-func (s *Download_fnContext) AllString_or_id() []IString_or_idContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IString_or_idContext)(nil)).Elem())
-	var tst = make([]IString_or_idContext, len(ts))
+func (s *Try_statContext) Catch_clause() ICatch_clauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICatch_clauseContext)(nil)).Elem(), 0)
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IString_or_idContext)
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(ICatch_clauseContext)
 }
 
-func (s *Download_fnContext) String_or_id(i int) IString_or_idContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), i)
+func (s *Try_statContext) Finally_clause() IFinally_clauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFinally_clauseContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IString_or_idContext)
-}
-
-func (s *Download_fnContext) CPAR() antlr.TerminalNode {
-	return s.GetToken(KlangParserCPAR, 0)
-}
-
-func (s *Download_fnContext) COMMA() antlr.TerminalNode {
-	return s.GetToken(KlangParserCOMMA, 0)
+	return t.(IFinally_clauseContext)
 }
 
-func (s *Download_fnContext) GetRuleContext() antlr.RuleContext {
+func (s *Try_statContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *Download_fnContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *Try_statContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *Download_fnContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *Try_statContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterDownload_fn(s)
+		listenerT.EnterTry_stat(s)
 	}
 }
 
-func (s *Download_fnContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *Try_statContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitDownload_fn(s)
+		listenerT.ExitTry_stat(s)
 	}
 }
 
-func (p *KlangParser) Download_fn() (localctx IDownload_fnContext) {
-	localctx = NewDownload_fnContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 42, KlangParserRULE_download_fn)
+func (p *KlangParser) Try_stat() (localctx ITry_statContext) {
+	localctx = NewTry_statContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 52, KlangParserRULE_try_stat)
 	var _la int
 
 	defer func() {
@@ -4708,131 +5100,150 @@ func (p *KlangParser) Download_fn() (localctx IDownload_fnContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(364)
-		p.Match(KlangParserDOWNLOAD)
+		p.SetState(413)
+		p.Match(KlangParserTRY)
 	}
 	{
-		p.SetState(365)
-		p.Match(KlangParserOPAR)
+		p.SetState(414)
+		p.Match(KlangParserOBRACE)
 	}
 	{
-		p.SetState(366)
-		p.String_or_id()
+		p.SetState(415)
+		p.Block()
+	}
+	{
+		p.SetState(416)
+		p.Match(KlangParserCBRACE)
 	}
-	p.SetState(369)
+	p.SetState(422)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == KlangParserCOMMA {
+	switch p.GetTokenStream().LA(1) {
+	case KlangParserCATCH:
 		{
-			p.SetState(367)
-			p.Match(KlangParserCOMMA)
+			p.SetState(417)
+			p.Catch_clause()
+		}
+		p.SetState(419)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == KlangParserFINALLY {
+			{
+				p.SetState(418)
+				p.Finally_clause()
+			}
+
 		}
+
+	case KlangParserFINALLY:
 		{
-			p.SetState(368)
-			p.String_or_id()
+			p.SetState(421)
+			p.Finally_clause()
 		}
 
-	}
-	{
-		p.SetState(371)
-		p.Match(KlangParserCPAR)
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IJson_select_fnContext is an interface to support dynamic dispatch.
-type IJson_select_fnContext interface {
+// ICatch_clauseContext is an interface to support dynamic dispatch.
+type ICatch_clauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsJson_select_fnContext differentiates from other interfaces.
-	IsJson_select_fnContext()
+	// IsCatch_clauseContext differentiates from other interfaces.
+	IsCatch_clauseContext()
 }
 
-type Json_select_fnContext struct {
+type Catch_clauseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyJson_select_fnContext() *Json_select_fnContext {
-	var p = new(Json_select_fnContext)
+func NewEmptyCatch_clauseContext() *Catch_clauseContext {
+	var p = new(Catch_clauseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = KlangParserRULE_json_select_fn
+	p.RuleIndex = KlangParserRULE_catch_clause
 	return p
 }
 
-func (*Json_select_fnContext) IsJson_select_fnContext() {}
+func (*Catch_clauseContext) IsCatch_clauseContext() {}
 
-func NewJson_select_fnContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Json_select_fnContext {
-	var p = new(Json_select_fnContext)
+func NewCatch_clauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Catch_clauseContext {
+	var p = new(Catch_clauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = KlangParserRULE_json_select_fn
+	p.RuleIndex = KlangParserRULE_catch_clause
 
 	return p
 }
 
-func (s *Json_select_fnContext) GetParser() antlr.Parser { return s.parser }
+func (s *Catch_clauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *Json_select_fnContext) JSONSELECT() antlr.TerminalNode {
-	return s.GetToken(KlangParserJSONSELECT, 0)
+func (s *Catch_clauseContext) CATCH() antlr.TerminalNode {
+	return s.GetToken(KlangParserCATCH, 0)
 }
 
-func (s *Json_select_fnContext) OPAR() antlr.TerminalNode {
+func (s *Catch_clauseContext) OPAR() antlr.TerminalNode {
 	return s.GetToken(KlangParserOPAR, 0)
 }
 
-func (s *Json_select_fnContext) ID() antlr.TerminalNode {
+func (s *Catch_clauseContext) ID() antlr.TerminalNode {
 	return s.GetToken(KlangParserID, 0)
 }
 
-func (s *Json_select_fnContext) COMMA() antlr.TerminalNode {
-	return s.GetToken(KlangParserCOMMA, 0)
+func (s *Catch_clauseContext) CPAR() antlr.TerminalNode {
+	return s.GetToken(KlangParserCPAR, 0)
 }
 
-func (s *Json_select_fnContext) String_or_id() IString_or_idContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), 0)
+func (s *Catch_clauseContext) OBRACE() antlr.TerminalNode {
+	return s.GetToken(KlangParserOBRACE, 0)
+}
+
+func (s *Catch_clauseContext) Block() IBlockContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBlockContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IString_or_idContext)
+	return t.(IBlockContext)
 }
 
-func (s *Json_select_fnContext) CPAR() antlr.TerminalNode {
-	return s.GetToken(KlangParserCPAR, 0)
+func (s *Catch_clauseContext) CBRACE() antlr.TerminalNode {
+	return s.GetToken(KlangParserCBRACE, 0)
 }
 
-func (s *Json_select_fnContext) GetRuleContext() antlr.RuleContext {
+func (s *Catch_clauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *Json_select_fnContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *Catch_clauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *Json_select_fnContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *Catch_clauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterJson_select_fn(s)
+		listenerT.EnterCatch_clause(s)
 	}
 }
 
-func (s *Json_select_fnContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *Catch_clauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitJson_select_fn(s)
+		listenerT.ExitCatch_clause(s)
 	}
 }
 
-func (p *KlangParser) Json_select_fn() (localctx IJson_select_fnContext) {
-	localctx = NewJson_select_fnContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 44, KlangParserRULE_json_select_fn)
+func (p *KlangParser) Catch_clause() (localctx ICatch_clauseContext) {
+	localctx = NewCatch_clauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 54, KlangParserRULE_catch_clause)
 
 	defer func() {
 		p.ExitRule()
@@ -4852,133 +5263,120 @@ func (p *KlangParser) Json_select_fn() (localctx IJson_select_fnContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(373)
-		p.Match(KlangParserJSONSELECT)
+		p.SetState(424)
+		p.Match(KlangParserCATCH)
 	}
 	{
-		p.SetState(374)
+		p.SetState(425)
 		p.Match(KlangParserOPAR)
 	}
 	{
-		p.SetState(375)
+		p.SetState(426)
 		p.Match(KlangParserID)
 	}
 	{
-		p.SetState(376)
-		p.Match(KlangParserCOMMA)
+		p.SetState(427)
+		p.Match(KlangParserCPAR)
 	}
 	{
-		p.SetState(377)
-		p.String_or_id()
+		p.SetState(428)
+		p.Match(KlangParserOBRACE)
 	}
 	{
-		p.SetState(378)
-		p.Match(KlangParserCPAR)
+		p.SetState(429)
+		p.Block()
+	}
+	{
+		p.SetState(430)
+		p.Match(KlangParserCBRACE)
 	}
 
 	return localctx
 }
 
-// IYaml_select_fnContext is an interface to support dynamic dispatch.
-type IYaml_select_fnContext interface {
+// IFinally_clauseContext is an interface to support dynamic dispatch.
+type IFinally_clauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsYaml_select_fnContext differentiates from other interfaces.
-	IsYaml_select_fnContext()
+	// IsFinally_clauseContext differentiates from other interfaces.
+	IsFinally_clauseContext()
 }
 
-type Yaml_select_fnContext struct {
+type Finally_clauseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyYaml_select_fnContext() *Yaml_select_fnContext {
-	var p = new(Yaml_select_fnContext)
+func NewEmptyFinally_clauseContext() *Finally_clauseContext {
+	var p = new(Finally_clauseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = KlangParserRULE_yaml_select_fn
+	p.RuleIndex = KlangParserRULE_finally_clause
 	return p
 }
 
-func (*Yaml_select_fnContext) IsYaml_select_fnContext() {}
+func (*Finally_clauseContext) IsFinally_clauseContext() {}
 
-func NewYaml_select_fnContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Yaml_select_fnContext {
-	var p = new(Yaml_select_fnContext)
+func NewFinally_clauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Finally_clauseContext {
+	var p = new(Finally_clauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = KlangParserRULE_yaml_select_fn
+	p.RuleIndex = KlangParserRULE_finally_clause
 
 	return p
 }
 
-func (s *Yaml_select_fnContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *Yaml_select_fnContext) YAMLSELECT() antlr.TerminalNode {
-	return s.GetToken(KlangParserYAMLSELECT, 0)
-}
-
-func (s *Yaml_select_fnContext) OPAR() antlr.TerminalNode {
-	return s.GetToken(KlangParserOPAR, 0)
-}
-
-func (s *Yaml_select_fnContext) ID() antlr.TerminalNode {
-	return s.GetToken(KlangParserID, 0)
-}
+func (s *Finally_clauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *Yaml_select_fnContext) AllCOMMA() []antlr.TerminalNode {
-	return s.GetTokens(KlangParserCOMMA)
+func (s *Finally_clauseContext) FINALLY() antlr.TerminalNode {
+	return s.GetToken(KlangParserFINALLY, 0)
 }
 
-func (s *Yaml_select_fnContext) COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(KlangParserCOMMA, i)
+func (s *Finally_clauseContext) OBRACE() antlr.TerminalNode {
+	return s.GetToken(KlangParserOBRACE, 0)
 }
 
-func (s *Yaml_select_fnContext) String_or_id() IString_or_idContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), 0)
+func (s *Finally_clauseContext) Block() IBlockContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBlockContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IString_or_idContext)
-}
-
-func (s *Yaml_select_fnContext) CPAR() antlr.TerminalNode {
-	return s.GetToken(KlangParserCPAR, 0)
+	return t.(IBlockContext)
 }
 
-func (s *Yaml_select_fnContext) NUMBER() antlr.TerminalNode {
-	return s.GetToken(KlangParserNUMBER, 0)
+func (s *Finally_clauseContext) CBRACE() antlr.TerminalNode {
+	return s.GetToken(KlangParserCBRACE, 0)
 }
 
-func (s *Yaml_select_fnContext) GetRuleContext() antlr.RuleContext {
+func (s *Finally_clauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *Yaml_select_fnContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *Finally_clauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *Yaml_select_fnContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *Finally_clauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterYaml_select_fn(s)
+		listenerT.EnterFinally_clause(s)
 	}
 }
 
-func (s *Yaml_select_fnContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *Finally_clauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitYaml_select_fn(s)
+		listenerT.ExitFinally_clause(s)
 	}
 }
 
-func (p *KlangParser) Yaml_select_fn() (localctx IYaml_select_fnContext) {
-	localctx = NewYaml_select_fnContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 46, KlangParserRULE_yaml_select_fn)
-	var _la int
+func (p *KlangParser) Finally_clause() (localctx IFinally_clauseContext) {
+	localctx = NewFinally_clauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, KlangParserRULE_finally_clause)
 
 	defer func() {
 		p.ExitRule()
@@ -4998,140 +5396,104 @@ func (p *KlangParser) Yaml_select_fn() (localctx IYaml_select_fnContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(380)
-		p.Match(KlangParserYAMLSELECT)
-	}
-	{
-		p.SetState(381)
-		p.Match(KlangParserOPAR)
-	}
-	{
-		p.SetState(382)
-		p.Match(KlangParserID)
+		p.SetState(432)
+		p.Match(KlangParserFINALLY)
 	}
 	{
-		p.SetState(383)
-		p.Match(KlangParserCOMMA)
+		p.SetState(433)
+		p.Match(KlangParserOBRACE)
 	}
 	{
-		p.SetState(384)
-		p.String_or_id()
-	}
-	p.SetState(387)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == KlangParserCOMMA {
-		{
-			p.SetState(385)
-			p.Match(KlangParserCOMMA)
-		}
-		{
-			p.SetState(386)
-			p.Match(KlangParserNUMBER)
-		}
-
+		p.SetState(434)
+		p.Block()
 	}
 	{
-		p.SetState(389)
-		p.Match(KlangParserCPAR)
+		p.SetState(435)
+		p.Match(KlangParserCBRACE)
 	}
 
 	return localctx
 }
 
-// ILoad_fnContext is an interface to support dynamic dispatch.
-type ILoad_fnContext interface {
+// IThrow_statContext is an interface to support dynamic dispatch.
+type IThrow_statContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsLoad_fnContext differentiates from other interfaces.
-	IsLoad_fnContext()
+	// IsThrow_statContext differentiates from other interfaces.
+	IsThrow_statContext()
 }
 
-type Load_fnContext struct {
+type Throw_statContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyLoad_fnContext() *Load_fnContext {
-	var p = new(Load_fnContext)
+func NewEmptyThrow_statContext() *Throw_statContext {
+	var p = new(Throw_statContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = KlangParserRULE_load_fn
+	p.RuleIndex = KlangParserRULE_throw_stat
 	return p
 }
 
-func (*Load_fnContext) IsLoad_fnContext() {}
+func (*Throw_statContext) IsThrow_statContext() {}
 
-func NewLoad_fnContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Load_fnContext {
-	var p = new(Load_fnContext)
+func NewThrow_statContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Throw_statContext {
+	var p = new(Throw_statContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = KlangParserRULE_load_fn
+	p.RuleIndex = KlangParserRULE_throw_stat
 
 	return p
 }
 
-func (s *Load_fnContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *Load_fnContext) LOAD() antlr.TerminalNode {
-	return s.GetToken(KlangParserLOAD, 0)
-}
+func (s *Throw_statContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *Load_fnContext) OPAR() antlr.TerminalNode {
-	return s.GetToken(KlangParserOPAR, 0)
+func (s *Throw_statContext) THROW() antlr.TerminalNode {
+	return s.GetToken(KlangParserTHROW, 0)
 }
 
-func (s *Load_fnContext) String_or_id() IString_or_idContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), 0)
+func (s *Throw_statContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IString_or_idContext)
-}
-
-func (s *Load_fnContext) CPAR() antlr.TerminalNode {
-	return s.GetToken(KlangParserCPAR, 0)
-}
-
-func (s *Load_fnContext) COMMA() antlr.TerminalNode {
-	return s.GetToken(KlangParserCOMMA, 0)
+	return t.(IExprContext)
 }
 
-func (s *Load_fnContext) STRING() antlr.TerminalNode {
-	return s.GetToken(KlangParserSTRING, 0)
+func (s *Throw_statContext) SCOL() antlr.TerminalNode {
+	return s.GetToken(KlangParserSCOL, 0)
 }
 
-func (s *Load_fnContext) GetRuleContext() antlr.RuleContext {
+func (s *Throw_statContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *Load_fnContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *Throw_statContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *Load_fnContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *Throw_statContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterLoad_fn(s)
+		listenerT.EnterThrow_stat(s)
 	}
 }
 
-func (s *Load_fnContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *Throw_statContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitLoad_fn(s)
+		listenerT.ExitThrow_stat(s)
 	}
 }
 
-func (p *KlangParser) Load_fn() (localctx ILoad_fnContext) {
-	localctx = NewLoad_fnContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 48, KlangParserRULE_load_fn)
-	var _la int
+func (p *KlangParser) Throw_stat() (localctx IThrow_statContext) {
+	localctx = NewThrow_statContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, KlangParserRULE_throw_stat)
 
 	defer func() {
 		p.ExitRule()
@@ -5151,117 +5513,108 @@ func (p *KlangParser) Load_fn() (localctx ILoad_fnContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(391)
-		p.Match(KlangParserLOAD)
-	}
-	{
-		p.SetState(392)
-		p.Match(KlangParserOPAR)
+		p.SetState(437)
+		p.Match(KlangParserTHROW)
 	}
 	{
-		p.SetState(393)
-		p.String_or_id()
-	}
-	p.SetState(396)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == KlangParserCOMMA {
-		{
-			p.SetState(394)
-			p.Match(KlangParserCOMMA)
-		}
-		{
-			p.SetState(395)
-			p.Match(KlangParserSTRING)
-		}
-
+		p.SetState(438)
+		p.expr(0)
 	}
 	{
-		p.SetState(398)
-		p.Match(KlangParserCPAR)
+		p.SetState(439)
+		p.Match(KlangParserSCOL)
 	}
 
 	return localctx
 }
 
-// IStepInfoContext is an interface to support dynamic dispatch.
-type IStepInfoContext interface {
+// IImport_statContext is an interface to support dynamic dispatch.
+type IImport_statContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsStepInfoContext differentiates from other interfaces.
-	IsStepInfoContext()
+	// IsImport_statContext differentiates from other interfaces.
+	IsImport_statContext()
 }
 
-type StepInfoContext struct {
+type Import_statContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyStepInfoContext() *StepInfoContext {
-	var p = new(StepInfoContext)
+func NewEmptyImport_statContext() *Import_statContext {
+	var p = new(Import_statContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = KlangParserRULE_stepInfo
+	p.RuleIndex = KlangParserRULE_import_stat
 	return p
 }
 
-func (*StepInfoContext) IsStepInfoContext() {}
+func (*Import_statContext) IsImport_statContext() {}
 
-func NewStepInfoContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StepInfoContext {
-	var p = new(StepInfoContext)
+func NewImport_statContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Import_statContext {
+	var p = new(Import_statContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = KlangParserRULE_stepInfo
+	p.RuleIndex = KlangParserRULE_import_stat
 
 	return p
 }
 
-func (s *StepInfoContext) GetParser() antlr.Parser { return s.parser }
+func (s *Import_statContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *StepInfoContext) STEPINFO() antlr.TerminalNode {
-	return s.GetToken(KlangParserSTEPINFO, 0)
+func (s *Import_statContext) IMPORT() antlr.TerminalNode {
+	return s.GetToken(KlangParserIMPORT, 0)
 }
 
-func (s *StepInfoContext) STRING() antlr.TerminalNode {
-	return s.GetToken(KlangParserSTRING, 0)
+func (s *Import_statContext) String_or_id() IString_or_idContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IString_or_idContext)
 }
 
-func (s *StepInfoContext) SCOL() antlr.TerminalNode {
-	return s.GetToken(KlangParserSCOL, 0)
+func (s *Import_statContext) AS() antlr.TerminalNode {
+	return s.GetToken(KlangParserAS, 0)
 }
 
-func (s *StepInfoContext) RAW_STRING_LIT() antlr.TerminalNode {
-	return s.GetToken(KlangParserRAW_STRING_LIT, 0)
+func (s *Import_statContext) ID() antlr.TerminalNode {
+	return s.GetToken(KlangParserID, 0)
 }
 
-func (s *StepInfoContext) GetRuleContext() antlr.RuleContext {
+func (s *Import_statContext) SCOL() antlr.TerminalNode {
+	return s.GetToken(KlangParserSCOL, 0)
+}
+
+func (s *Import_statContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StepInfoContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *Import_statContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StepInfoContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *Import_statContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterStepInfo(s)
+		listenerT.EnterImport_stat(s)
 	}
 }
 
-func (s *StepInfoContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *Import_statContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitStepInfo(s)
+		listenerT.ExitImport_stat(s)
 	}
 }
 
-func (p *KlangParser) StepInfo() (localctx IStepInfoContext) {
-	localctx = NewStepInfoContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 50, KlangParserRULE_stepInfo)
+func (p *KlangParser) Import_stat() (localctx IImport_statContext) {
+	localctx = NewImport_statContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, KlangParserRULE_import_stat)
 
 	defer func() {
 		p.ExitRule()
@@ -5279,119 +5632,124 @@ func (p *KlangParser) StepInfo() (localctx IStepInfoContext) {
 		}
 	}()
 
-	p.SetState(406)
-	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 26, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(400)
-			p.Match(KlangParserSTEPINFO)
-		}
-		{
-			p.SetState(401)
-			p.Match(KlangParserSTRING)
-		}
-		{
-			p.SetState(402)
-			p.Match(KlangParserSCOL)
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(403)
-			p.Match(KlangParserSTEPINFO)
-		}
-		{
-			p.SetState(404)
-			p.Match(KlangParserRAW_STRING_LIT)
-		}
-		{
-			p.SetState(405)
-			p.Match(KlangParserSCOL)
-		}
-
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(441)
+		p.Match(KlangParserIMPORT)
+	}
+	{
+		p.SetState(442)
+		p.String_or_id()
+	}
+	{
+		p.SetState(443)
+		p.Match(KlangParserAS)
+	}
+	{
+		p.SetState(444)
+		p.Match(KlangParserID)
+	}
+	{
+		p.SetState(445)
+		p.Match(KlangParserSCOL)
 	}
 
 	return localctx
 }
 
-// INsContext is an interface to support dynamic dispatch.
-type INsContext interface {
+// ITimeout_statContext is an interface to support dynamic dispatch.
+type ITimeout_statContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsNsContext differentiates from other interfaces.
-	IsNsContext()
+	// IsTimeout_statContext differentiates from other interfaces.
+	IsTimeout_statContext()
 }
 
-type NsContext struct {
+type Timeout_statContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyNsContext() *NsContext {
-	var p = new(NsContext)
+func NewEmptyTimeout_statContext() *Timeout_statContext {
+	var p = new(Timeout_statContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = KlangParserRULE_ns
+	p.RuleIndex = KlangParserRULE_timeout_stat
 	return p
 }
 
-func (*NsContext) IsNsContext() {}
+func (*Timeout_statContext) IsTimeout_statContext() {}
 
-func NewNsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NsContext {
-	var p = new(NsContext)
+func NewTimeout_statContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Timeout_statContext {
+	var p = new(Timeout_statContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = KlangParserRULE_ns
+	p.RuleIndex = KlangParserRULE_timeout_stat
 
 	return p
 }
 
-func (s *NsContext) GetParser() antlr.Parser { return s.parser }
+func (s *Timeout_statContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *NsContext) String_or_id() IString_or_idContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), 0)
+func (s *Timeout_statContext) TIMEOUT() antlr.TerminalNode {
+	return s.GetToken(KlangParserTIMEOUT, 0)
+}
+
+func (s *Timeout_statContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IString_or_idContext)
+	return t.(IExprContext)
 }
 
-func (s *NsContext) PATH() antlr.TerminalNode {
-	return s.GetToken(KlangParserPATH, 0)
+func (s *Timeout_statContext) OBRACE() antlr.TerminalNode {
+	return s.GetToken(KlangParserOBRACE, 0)
 }
 
-func (s *NsContext) GetRuleContext() antlr.RuleContext {
+func (s *Timeout_statContext) Block() IBlockContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBlockContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *Timeout_statContext) CBRACE() antlr.TerminalNode {
+	return s.GetToken(KlangParserCBRACE, 0)
+}
+
+func (s *Timeout_statContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *NsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *Timeout_statContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *NsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *Timeout_statContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterNs(s)
+		listenerT.EnterTimeout_stat(s)
 	}
 }
 
-func (s *NsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *Timeout_statContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitNs(s)
+		listenerT.ExitTimeout_stat(s)
 	}
 }
 
-func (p *KlangParser) Ns() (localctx INsContext) {
-	localctx = NewNsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 52, KlangParserRULE_ns)
+func (p *KlangParser) Timeout_stat() (localctx ITimeout_statContext) {
+	localctx = NewTimeout_statContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 62, KlangParserRULE_timeout_stat)
 
 	defer func() {
 		p.ExitRule()
@@ -5409,192 +5767,142 @@ func (p *KlangParser) Ns() (localctx INsContext) {
 		}
 	}()
 
-	p.SetState(410)
-	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case KlangParserID, KlangParserRAW_STRING_LIT, KlangParserSTRING:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(408)
-			p.String_or_id()
-		}
-
-	case KlangParserPATH:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(409)
-			p.Match(KlangParserPATH)
-		}
-
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(447)
+		p.Match(KlangParserTIMEOUT)
+	}
+	{
+		p.SetState(448)
+		p.expr(0)
+	}
+	{
+		p.SetState(449)
+		p.Match(KlangParserOBRACE)
+	}
+	{
+		p.SetState(450)
+		p.Block()
+	}
+	{
+		p.SetState(451)
+		p.Match(KlangParserCBRACE)
 	}
 
 	return localctx
 }
 
-// IAsObjectContext is an interface to support dynamic dispatch.
-type IAsObjectContext interface {
+// IFunc_callContext is an interface to support dynamic dispatch.
+type IFunc_callContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsAsObjectContext differentiates from other interfaces.
-	IsAsObjectContext()
+	// IsFunc_callContext differentiates from other interfaces.
+	IsFunc_callContext()
 }
 
-type AsObjectContext struct {
+type Func_callContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyAsObjectContext() *AsObjectContext {
-	var p = new(AsObjectContext)
+func NewEmptyFunc_callContext() *Func_callContext {
+	var p = new(Func_callContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = KlangParserRULE_asObject
+	p.RuleIndex = KlangParserRULE_func_call
 	return p
 }
 
-func (*AsObjectContext) IsAsObjectContext() {}
+func (*Func_callContext) IsFunc_callContext() {}
 
-func NewAsObjectContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AsObjectContext {
-	var p = new(AsObjectContext)
+func NewFunc_callContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Func_callContext {
+	var p = new(Func_callContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = KlangParserRULE_asObject
+	p.RuleIndex = KlangParserRULE_func_call
 
 	return p
 }
 
-func (s *AsObjectContext) GetParser() antlr.Parser { return s.parser }
-func (s *AsObjectContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *Func_callContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *AsObjectContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
+func (s *Func_callContext) Func_name() IFunc_nameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunc_nameContext)(nil)).Elem(), 0)
 
-func (s *AsObjectContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterAsObject(s)
+	if t == nil {
+		return nil
 	}
+
+	return t.(IFunc_nameContext)
 }
 
-func (s *AsObjectContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitAsObject(s)
-	}
+func (s *Func_callContext) OPAR() antlr.TerminalNode {
+	return s.GetToken(KlangParserOPAR, 0)
 }
 
-func (p *KlangParser) AsObject() (localctx IAsObjectContext) {
-	localctx = NewAsObjectContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 54, KlangParserRULE_asObject)
+func (s *Func_callContext) CPAR() antlr.TerminalNode {
+	return s.GetToken(KlangParserCPAR, 0)
+}
 
-	defer func() {
-		p.ExitRule()
-	}()
+func (s *Func_callContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
 
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
 		}
-	}()
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(412)
-		p.Match(KlangParserT__0)
 	}
 
-	return localctx
-}
-
-// IPatch_typeContext is an interface to support dynamic dispatch.
-type IPatch_typeContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// IsPatch_typeContext differentiates from other interfaces.
-	IsPatch_typeContext()
-}
-
-type Patch_typeContext struct {
-	*antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
-
-func NewEmptyPatch_typeContext() *Patch_typeContext {
-	var p = new(Patch_typeContext)
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = KlangParserRULE_patch_type
-	return p
+	return tst
 }
 
-func (*Patch_typeContext) IsPatch_typeContext() {}
-
-func NewPatch_typeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Patch_typeContext {
-	var p = new(Patch_typeContext)
-
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+func (s *Func_callContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
-	p.parser = parser
-	p.RuleIndex = KlangParserRULE_patch_type
+	if t == nil {
+		return nil
+	}
 
-	return p
+	return t.(IExprContext)
 }
 
-func (s *Patch_typeContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *Patch_typeContext) PATH() antlr.TerminalNode {
-	return s.GetToken(KlangParserPATH, 0)
+func (s *Func_callContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserCOMMA)
 }
 
-func (s *Patch_typeContext) String_or_id() IString_or_idContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IString_or_idContext)
+func (s *Func_callContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserCOMMA, i)
 }
 
-func (s *Patch_typeContext) GetRuleContext() antlr.RuleContext {
+func (s *Func_callContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *Patch_typeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *Func_callContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *Patch_typeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *Func_callContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterPatch_type(s)
+		listenerT.EnterFunc_call(s)
 	}
 }
 
-func (s *Patch_typeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *Func_callContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitPatch_type(s)
+		listenerT.ExitFunc_call(s)
 	}
 }
 
-func (p *KlangParser) Patch_type() (localctx IPatch_typeContext) {
-	localctx = NewPatch_typeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 56, KlangParserRULE_patch_type)
+func (p *KlangParser) Func_call() (localctx IFunc_callContext) {
+	localctx = NewFunc_callContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 64, KlangParserRULE_func_call)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -5612,104 +5920,125 @@ func (p *KlangParser) Patch_type() (localctx IPatch_typeContext) {
 		}
 	}()
 
-	p.SetState(416)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(453)
+		p.Func_name()
+	}
+	{
+		p.SetState(454)
+		p.Match(KlangParserOPAR)
+	}
+	p.SetState(463)
 	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case KlangParserPATH:
-		p.EnterOuterAlt(localctx, 1)
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<KlangParserT__1)|(1<<KlangParserT__4)|(1<<KlangParserMINUS)|(1<<KlangParserNOT)|(1<<KlangParserOPAR)|(1<<KlangParserOBRACE)|(1<<KlangParserTRUE)|(1<<KlangParserFALSE)|(1<<KlangParserNIL))) != 0) || (((_la-38)&-(0x1f+1)) == 0 && ((1<<uint((_la-38)))&((1<<(KlangParserKUBECTL-38))|(1<<(KlangParserREPLACE-38))|(1<<(KlangParserJSONSELECT-38)))) != 0) || (((_la-70)&-(0x1f+1)) == 0 && ((1<<uint((_la-70)))&((1<<(KlangParserYAMLSELECT-70))|(1<<(KlangParserSHELLSCRIPT-70))|(1<<(KlangParserDOWNLOAD-70))|(1<<(KlangParserDURATION-70))|(1<<(KlangParserID-70))|(1<<(KlangParserNUMBER-70))|(1<<(KlangParserPATH-70))|(1<<(KlangParserRAW_STRING_LIT-70))|(1<<(KlangParserSTRING-70)))) != 0) {
 		{
-			p.SetState(414)
-			p.Match(KlangParserPATH)
+			p.SetState(455)
+			p.expr(0)
 		}
+		p.SetState(460)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 
-	case KlangParserID, KlangParserRAW_STRING_LIT, KlangParserSTRING:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(415)
-			p.String_or_id()
+		for _la == KlangParserCOMMA {
+			{
+				p.SetState(456)
+				p.Match(KlangParserCOMMA)
+			}
+			{
+				p.SetState(457)
+				p.expr(0)
+			}
+
+			p.SetState(462)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+	{
+		p.SetState(465)
+		p.Match(KlangParserCPAR)
 	}
 
 	return localctx
 }
 
-// IString_or_idContext is an interface to support dynamic dispatch.
-type IString_or_idContext interface {
+// IFunc_nameContext is an interface to support dynamic dispatch.
+type IFunc_nameContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsString_or_idContext differentiates from other interfaces.
-	IsString_or_idContext()
+	// IsFunc_nameContext differentiates from other interfaces.
+	IsFunc_nameContext()
 }
 
-type String_or_idContext struct {
+type Func_nameContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyString_or_idContext() *String_or_idContext {
-	var p = new(String_or_idContext)
+func NewEmptyFunc_nameContext() *Func_nameContext {
+	var p = new(Func_nameContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = KlangParserRULE_string_or_id
+	p.RuleIndex = KlangParserRULE_func_name
 	return p
 }
 
-func (*String_or_idContext) IsString_or_idContext() {}
+func (*Func_nameContext) IsFunc_nameContext() {}
 
-func NewString_or_idContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *String_or_idContext {
-	var p = new(String_or_idContext)
+func NewFunc_nameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Func_nameContext {
+	var p = new(Func_nameContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = KlangParserRULE_string_or_id
+	p.RuleIndex = KlangParserRULE_func_name
 
 	return p
 }
 
-func (s *String_or_idContext) GetParser() antlr.Parser { return s.parser }
+func (s *Func_nameContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *String_or_idContext) ID() antlr.TerminalNode {
+func (s *Func_nameContext) ID() antlr.TerminalNode {
 	return s.GetToken(KlangParserID, 0)
 }
 
-func (s *String_or_idContext) STRING() antlr.TerminalNode {
-	return s.GetToken(KlangParserSTRING, 0)
+func (s *Func_nameContext) REPLACE() antlr.TerminalNode {
+	return s.GetToken(KlangParserREPLACE, 0)
 }
 
-func (s *String_or_idContext) RAW_STRING_LIT() antlr.TerminalNode {
-	return s.GetToken(KlangParserRAW_STRING_LIT, 0)
+func (s *Func_nameContext) PATH() antlr.TerminalNode {
+	return s.GetToken(KlangParserPATH, 0)
 }
 
-func (s *String_or_idContext) GetRuleContext() antlr.RuleContext {
+func (s *Func_nameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *String_or_idContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *Func_nameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *String_or_idContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *Func_nameContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterString_or_id(s)
+		listenerT.EnterFunc_name(s)
 	}
 }
 
-func (s *String_or_idContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *Func_nameContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitString_or_id(s)
+		listenerT.ExitFunc_name(s)
 	}
 }
 
-func (p *KlangParser) String_or_id() (localctx IString_or_idContext) {
-	localctx = NewString_or_idContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 58, KlangParserRULE_string_or_id)
+func (p *KlangParser) Func_name() (localctx IFunc_nameContext) {
+	localctx = NewFunc_nameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 66, KlangParserRULE_func_name)
 	var _la int
 
 	defer func() {
@@ -5729,10 +6058,10 @@ func (p *KlangParser) String_or_id() (localctx IString_or_idContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(418)
+	p.SetState(467)
 	_la = p.GetTokenStream().LA(1)
 
-	if !(((_la-64)&-(0x1f+1)) == 0 && ((1<<uint((_la-64)))&((1<<(KlangParserID-64))|(1<<(KlangParserRAW_STRING_LIT-64))|(1<<(KlangParserSTRING-64)))) != 0) {
+	if !(_la == KlangParserREPLACE || _la == KlangParserID || _la == KlangParserPATH) {
 		p.GetErrorHandler().RecoverInline(p)
 	} else {
 		p.GetErrorHandler().ReportMatch(p)
@@ -5742,737 +6071,707 @@ func (p *KlangParser) String_or_id() (localctx IString_or_idContext) {
 	return localctx
 }
 
-// IResourceContext is an interface to support dynamic dispatch.
-type IResourceContext interface {
+// IKubectl_commandContext is an interface to support dynamic dispatch.
+type IKubectl_commandContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsResourceContext differentiates from other interfaces.
-	IsResourceContext()
+	// IsKubectl_commandContext differentiates from other interfaces.
+	IsKubectl_commandContext()
 }
 
-type ResourceContext struct {
+type Kubectl_commandContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyResourceContext() *ResourceContext {
-	var p = new(ResourceContext)
+func NewEmptyKubectl_commandContext() *Kubectl_commandContext {
+	var p = new(Kubectl_commandContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = KlangParserRULE_resource
+	p.RuleIndex = KlangParserRULE_kubectl_command
 	return p
 }
 
-func (*ResourceContext) IsResourceContext() {}
+func (*Kubectl_commandContext) IsKubectl_commandContext() {}
 
-func NewResourceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ResourceContext {
-	var p = new(ResourceContext)
+func NewKubectl_commandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Kubectl_commandContext {
+	var p = new(Kubectl_commandContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = KlangParserRULE_resource
+	p.RuleIndex = KlangParserRULE_kubectl_command
 
 	return p
 }
 
-func (s *ResourceContext) GetParser() antlr.Parser { return s.parser }
+func (s *Kubectl_commandContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ResourceContext) PATH() antlr.TerminalNode {
-	return s.GetToken(KlangParserPATH, 0)
+func (s *Kubectl_commandContext) CopyFrom(ctx *Kubectl_commandContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
 }
 
-func (s *ResourceContext) String_or_id() IString_or_idContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), 0)
+func (s *Kubectl_commandContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *Kubectl_commandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
 
-	return t.(IString_or_idContext)
+type DeleteKubectlCommandContext struct {
+	*Kubectl_commandContext
 }
 
-func (s *ResourceContext) GetRuleContext() antlr.RuleContext {
+func NewDeleteKubectlCommandContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *DeleteKubectlCommandContext {
+	var p = new(DeleteKubectlCommandContext)
+
+	p.Kubectl_commandContext = NewEmptyKubectl_commandContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*Kubectl_commandContext))
+
+	return p
+}
+
+func (s *DeleteKubectlCommandContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ResourceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *DeleteKubectlCommandContext) KUBECTL() antlr.TerminalNode {
+	return s.GetToken(KlangParserKUBECTL, 0)
 }
 
-func (s *ResourceContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterResource(s)
-	}
+func (s *DeleteKubectlCommandContext) DELETE() antlr.TerminalNode {
+	return s.GetToken(KlangParserDELETE, 0)
 }
 
-func (s *ResourceContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitResource(s)
-	}
+func (s *DeleteKubectlCommandContext) AllNAMESPACE() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserNAMESPACE)
 }
 
-func (p *KlangParser) Resource() (localctx IResourceContext) {
-	localctx = NewResourceContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 60, KlangParserRULE_resource)
+func (s *DeleteKubectlCommandContext) NAMESPACE(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserNAMESPACE, i)
+}
 
-	defer func() {
-		p.ExitRule()
-	}()
+func (s *DeleteKubectlCommandContext) AllNs() []INsContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*INsContext)(nil)).Elem())
+	var tst = make([]INsContext, len(ts))
 
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(INsContext)
 		}
-	}()
-
-	p.SetState(422)
-	p.GetErrorHandler().Sync(p)
+	}
 
-	switch p.GetTokenStream().LA(1) {
-	case KlangParserPATH:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(420)
-			p.Match(KlangParserPATH)
-		}
+	return tst
+}
 
-	case KlangParserID, KlangParserRAW_STRING_LIT, KlangParserSTRING:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(421)
-			p.String_or_id()
-		}
+func (s *DeleteKubectlCommandContext) Ns(i int) INsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*INsContext)(nil)).Elem(), i)
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	if t == nil {
+		return nil
 	}
 
-	return localctx
+	return t.(INsContext)
 }
 
-// IKubernetes_object_configContext is an interface to support dynamic dispatch.
-type IKubernetes_object_configContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
+func (s *DeleteKubectlCommandContext) AllResource() []IResourceContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IResourceContext)(nil)).Elem())
+	var tst = make([]IResourceContext, len(ts))
 
-	// IsKubernetes_object_configContext differentiates from other interfaces.
-	IsKubernetes_object_configContext()
-}
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IResourceContext)
+		}
+	}
 
-type Kubernetes_object_configContext struct {
-	*antlr.BaseParserRuleContext
-	parser antlr.Parser
+	return tst
 }
 
-func NewEmptyKubernetes_object_configContext() *Kubernetes_object_configContext {
-	var p = new(Kubernetes_object_configContext)
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = KlangParserRULE_kubernetes_object_config
-	return p
-}
+func (s *DeleteKubectlCommandContext) Resource(i int) IResourceContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IResourceContext)(nil)).Elem(), i)
 
-func (*Kubernetes_object_configContext) IsKubernetes_object_configContext() {}
+	if t == nil {
+		return nil
+	}
 
-func NewKubernetes_object_configContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Kubernetes_object_configContext {
-	var p = new(Kubernetes_object_configContext)
+	return t.(IResourceContext)
+}
 
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+func (s *DeleteKubectlCommandContext) AllSelector() []ISelectorContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ISelectorContext)(nil)).Elem())
+	var tst = make([]ISelectorContext, len(ts))
 
-	p.parser = parser
-	p.RuleIndex = KlangParserRULE_kubernetes_object_config
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ISelectorContext)
+		}
+	}
 
-	return p
+	return tst
 }
 
-func (s *Kubernetes_object_configContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *Kubernetes_object_configContext) String_or_id() IString_or_idContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), 0)
+func (s *DeleteKubectlCommandContext) Selector(i int) ISelectorContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISelectorContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IString_or_idContext)
+	return t.(ISelectorContext)
 }
 
-func (s *Kubernetes_object_configContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *DeleteKubectlCommandContext) AllALL() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserALL)
 }
 
-func (s *Kubernetes_object_configContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *DeleteKubectlCommandContext) ALL(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserALL, i)
 }
 
-func (s *Kubernetes_object_configContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DeleteKubectlCommandContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterKubernetes_object_config(s)
+		listenerT.EnterDeleteKubectlCommand(s)
 	}
 }
 
-func (s *Kubernetes_object_configContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DeleteKubectlCommandContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitKubernetes_object_config(s)
+		listenerT.ExitDeleteKubectlCommand(s)
 	}
 }
 
-func (p *KlangParser) Kubernetes_object_config() (localctx IKubernetes_object_configContext) {
-	localctx = NewKubernetes_object_configContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 62, KlangParserRULE_kubernetes_object_config)
-
-	defer func() {
-		p.ExitRule()
-	}()
+type PatchKubectlCommandContext struct {
+	*Kubectl_commandContext
+}
 
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
-		}
-	}()
+func NewPatchKubectlCommandContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PatchKubectlCommandContext {
+	var p = new(PatchKubectlCommandContext)
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(424)
-		p.String_or_id()
-	}
+	p.Kubectl_commandContext = NewEmptyKubectl_commandContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*Kubectl_commandContext))
 
-	return localctx
+	return p
 }
 
-// IFilterContext is an interface to support dynamic dispatch.
-type IFilterContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// IsFilterContext differentiates from other interfaces.
-	IsFilterContext()
+func (s *PatchKubectlCommandContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-type FilterContext struct {
-	*antlr.BaseParserRuleContext
-	parser antlr.Parser
+func (s *PatchKubectlCommandContext) KUBECTL() antlr.TerminalNode {
+	return s.GetToken(KlangParserKUBECTL, 0)
 }
 
-func NewEmptyFilterContext() *FilterContext {
-	var p = new(FilterContext)
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = KlangParserRULE_filter
-	return p
+func (s *PatchKubectlCommandContext) PATCH() antlr.TerminalNode {
+	return s.GetToken(KlangParserPATCH, 0)
 }
 
-func (*FilterContext) IsFilterContext() {}
+func (s *PatchKubectlCommandContext) AllNAMESPACE() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserNAMESPACE)
+}
 
-func NewFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FilterContext {
-	var p = new(FilterContext)
+func (s *PatchKubectlCommandContext) NAMESPACE(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserNAMESPACE, i)
+}
 
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+func (s *PatchKubectlCommandContext) AllNs() []INsContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*INsContext)(nil)).Elem())
+	var tst = make([]INsContext, len(ts))
 
-	p.parser = parser
-	p.RuleIndex = KlangParserRULE_filter
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(INsContext)
+		}
+	}
 
-	return p
+	return tst
 }
 
-func (s *FilterContext) GetParser() antlr.Parser { return s.parser }
+func (s *PatchKubectlCommandContext) Ns(i int) INsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*INsContext)(nil)).Elem(), i)
 
-func (s *FilterContext) FILTER() antlr.TerminalNode {
-	return s.GetToken(KlangParserFILTER, 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(INsContext)
 }
 
-func (s *FilterContext) ASSIGN() antlr.TerminalNode {
-	return s.GetToken(KlangParserASSIGN, 0)
+func (s *PatchKubectlCommandContext) AllResource() []IResourceContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IResourceContext)(nil)).Elem())
+	var tst = make([]IResourceContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IResourceContext)
+		}
+	}
+
+	return tst
 }
 
-func (s *FilterContext) String_or_id() IString_or_idContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), 0)
+func (s *PatchKubectlCommandContext) Resource(i int) IResourceContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IResourceContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IString_or_idContext)
+	return t.(IResourceContext)
 }
 
-func (s *FilterContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *PatchKubectlCommandContext) AllPATCHTYPE() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserPATCHTYPE)
 }
 
-func (s *FilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *PatchKubectlCommandContext) PATCHTYPE(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserPATCHTYPE, i)
 }
 
-func (s *FilterContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterFilter(s)
+func (s *PatchKubectlCommandContext) AllPatch_type() []IPatch_typeContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IPatch_typeContext)(nil)).Elem())
+	var tst = make([]IPatch_typeContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IPatch_typeContext)
+		}
 	}
+
+	return tst
 }
 
-func (s *FilterContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitFilter(s)
+func (s *PatchKubectlCommandContext) Patch_type(i int) IPatch_typeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPatch_typeContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
 	}
+
+	return t.(IPatch_typeContext)
 }
 
-func (p *KlangParser) Filter() (localctx IFilterContext) {
-	localctx = NewFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 64, KlangParserRULE_filter)
+func (s *PatchKubectlCommandContext) AllPATCHLOAD() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserPATCHLOAD)
+}
 
-	defer func() {
-		p.ExitRule()
-	}()
+func (s *PatchKubectlCommandContext) PATCHLOAD(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserPATCHLOAD, i)
+}
 
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
-		}
-	}()
+func (s *PatchKubectlCommandContext) AllString_or_id() []IString_or_idContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IString_or_idContext)(nil)).Elem())
+	var tst = make([]IString_or_idContext, len(ts))
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(426)
-		p.Match(KlangParserFILTER)
-	}
-	{
-		p.SetState(427)
-		p.Match(KlangParserASSIGN)
-	}
-	{
-		p.SetState(428)
-		p.String_or_id()
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IString_or_idContext)
+		}
 	}
 
-	return localctx
+	return tst
 }
 
-// IPatternContext is an interface to support dynamic dispatch.
-type IPatternContext interface {
-	antlr.ParserRuleContext
+func (s *PatchKubectlCommandContext) String_or_id(i int) IString_or_idContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), i)
 
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
+	if t == nil {
+		return nil
+	}
 
-	// IsPatternContext differentiates from other interfaces.
-	IsPatternContext()
+	return t.(IString_or_idContext)
 }
 
-type PatternContext struct {
-	*antlr.BaseParserRuleContext
-	parser antlr.Parser
+func (s *PatchKubectlCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterPatchKubectlCommand(s)
+	}
 }
 
-func NewEmptyPatternContext() *PatternContext {
-	var p = new(PatternContext)
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = KlangParserRULE_pattern
-	return p
+func (s *PatchKubectlCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitPatchKubectlCommand(s)
+	}
 }
 
-func (*PatternContext) IsPatternContext() {}
-
-func NewPatternContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PatternContext {
-	var p = new(PatternContext)
+type CreateKubectlCommandContext struct {
+	*Kubectl_commandContext
+}
 
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+func NewCreateKubectlCommandContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *CreateKubectlCommandContext {
+	var p = new(CreateKubectlCommandContext)
 
+	p.Kubectl_commandContext = NewEmptyKubectl_commandContext()
 	p.parser = parser
-	p.RuleIndex = KlangParserRULE_pattern
+	p.CopyFrom(ctx.(*Kubectl_commandContext))
 
 	return p
 }
 
-func (s *PatternContext) GetParser() antlr.Parser { return s.parser }
+func (s *CreateKubectlCommandContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-func (s *PatternContext) PATTERN() antlr.TerminalNode {
-	return s.GetToken(KlangParserPATTERN, 0)
+func (s *CreateKubectlCommandContext) KUBECTL() antlr.TerminalNode {
+	return s.GetToken(KlangParserKUBECTL, 0)
 }
 
-func (s *PatternContext) ASSIGN() antlr.TerminalNode {
-	return s.GetToken(KlangParserASSIGN, 0)
+func (s *CreateKubectlCommandContext) CREATE() antlr.TerminalNode {
+	return s.GetToken(KlangParserCREATE, 0)
 }
 
-func (s *PatternContext) String_or_id() IString_or_idContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), 0)
+func (s *CreateKubectlCommandContext) AllNAMESPACE() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserNAMESPACE)
+}
+
+func (s *CreateKubectlCommandContext) NAMESPACE(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserNAMESPACE, i)
+}
+
+func (s *CreateKubectlCommandContext) AllNs() []INsContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*INsContext)(nil)).Elem())
+	var tst = make([]INsContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(INsContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *CreateKubectlCommandContext) Ns(i int) INsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*INsContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IString_or_idContext)
+	return t.(INsContext)
 }
 
-func (s *PatternContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *CreateKubectlCommandContext) AllString_or_id() []IString_or_idContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IString_or_idContext)(nil)).Elem())
+	var tst = make([]IString_or_idContext, len(ts))
 
-func (s *PatternContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IString_or_idContext)
+		}
+	}
+
+	return tst
 }
 
-func (s *PatternContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterPattern(s)
+func (s *CreateKubectlCommandContext) String_or_id(i int) IString_or_idContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
 	}
+
+	return t.(IString_or_idContext)
 }
 
-func (s *PatternContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitPattern(s)
-	}
+func (s *CreateKubectlCommandContext) AllUPDATELOAD() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserUPDATELOAD)
 }
 
-func (p *KlangParser) Pattern() (localctx IPatternContext) {
-	localctx = NewPatternContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 66, KlangParserRULE_pattern)
+func (s *CreateKubectlCommandContext) UPDATELOAD(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserUPDATELOAD, i)
+}
 
-	defer func() {
-		p.ExitRule()
-	}()
+func (s *CreateKubectlCommandContext) AllKubernetes_object_config() []IKubernetes_object_configContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IKubernetes_object_configContext)(nil)).Elem())
+	var tst = make([]IKubernetes_object_configContext, len(ts))
 
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IKubernetes_object_configContext)
 		}
-	}()
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(430)
-		p.Match(KlangParserPATTERN)
-	}
-	{
-		p.SetState(431)
-		p.Match(KlangParserASSIGN)
-	}
-	{
-		p.SetState(432)
-		p.String_or_id()
 	}
 
-	return localctx
+	return tst
 }
 
-// IExprContext is an interface to support dynamic dispatch.
-type IExprContext interface {
-	antlr.ParserRuleContext
+func (s *CreateKubectlCommandContext) Kubernetes_object_config(i int) IKubernetes_object_configContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IKubernetes_object_configContext)(nil)).Elem(), i)
 
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
+	if t == nil {
+		return nil
+	}
 
-	// IsExprContext differentiates from other interfaces.
-	IsExprContext()
+	return t.(IKubernetes_object_configContext)
 }
 
-type ExprContext struct {
-	*antlr.BaseParserRuleContext
-	parser antlr.Parser
+func (s *CreateKubectlCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterCreateKubectlCommand(s)
+	}
 }
 
-func NewEmptyExprContext() *ExprContext {
-	var p = new(ExprContext)
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = KlangParserRULE_expr
-	return p
+func (s *CreateKubectlCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitCreateKubectlCommand(s)
+	}
 }
 
-func (*ExprContext) IsExprContext() {}
+type ReplaceKubectlCommandContext struct {
+	*Kubectl_commandContext
+}
 
-func NewExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprContext {
-	var p = new(ExprContext)
-
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+func NewReplaceKubectlCommandContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ReplaceKubectlCommandContext {
+	var p = new(ReplaceKubectlCommandContext)
 
+	p.Kubectl_commandContext = NewEmptyKubectl_commandContext()
 	p.parser = parser
-	p.RuleIndex = KlangParserRULE_expr
+	p.CopyFrom(ctx.(*Kubectl_commandContext))
 
 	return p
 }
 
-func (s *ExprContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ExprContext) CopyFrom(ctx *ExprContext) {
-	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
-}
-
-func (s *ExprContext) GetRuleContext() antlr.RuleContext {
+func (s *ReplaceKubectlCommandContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *ReplaceKubectlCommandContext) KUBECTL() antlr.TerminalNode {
+	return s.GetToken(KlangParserKUBECTL, 0)
 }
 
-type JsonSelectFnContext struct {
-	*ExprContext
+func (s *ReplaceKubectlCommandContext) REPLACE() antlr.TerminalNode {
+	return s.GetToken(KlangParserREPLACE, 0)
 }
 
-func NewJsonSelectFnContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *JsonSelectFnContext {
-	var p = new(JsonSelectFnContext)
-
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
-
-	return p
+func (s *ReplaceKubectlCommandContext) AllNAMESPACE() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserNAMESPACE)
 }
 
-func (s *JsonSelectFnContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *ReplaceKubectlCommandContext) NAMESPACE(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserNAMESPACE, i)
 }
 
-func (s *JsonSelectFnContext) Json_select_fn() IJson_select_fnContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IJson_select_fnContext)(nil)).Elem(), 0)
+func (s *ReplaceKubectlCommandContext) AllNs() []INsContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*INsContext)(nil)).Elem())
+	var tst = make([]INsContext, len(ts))
 
-	if t == nil {
-		return nil
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(INsContext)
+		}
 	}
 
-	return t.(IJson_select_fnContext)
+	return tst
 }
 
-func (s *JsonSelectFnContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterJsonSelectFn(s)
-	}
-}
+func (s *ReplaceKubectlCommandContext) Ns(i int) INsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*INsContext)(nil)).Elem(), i)
 
-func (s *JsonSelectFnContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitJsonSelectFn(s)
+	if t == nil {
+		return nil
 	}
-}
 
-type ShellScriptContext struct {
-	*ExprContext
+	return t.(INsContext)
 }
 
-func NewShellScriptContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ShellScriptContext {
-	var p = new(ShellScriptContext)
-
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+func (s *ReplaceKubectlCommandContext) AllString_or_id() []IString_or_idContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IString_or_idContext)(nil)).Elem())
+	var tst = make([]IString_or_idContext, len(ts))
 
-	return p
-}
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IString_or_idContext)
+		}
+	}
 
-func (s *ShellScriptContext) GetRuleContext() antlr.RuleContext {
-	return s
+	return tst
 }
 
-func (s *ShellScriptContext) Shell_script() IShell_scriptContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IShell_scriptContext)(nil)).Elem(), 0)
+func (s *ReplaceKubectlCommandContext) String_or_id(i int) IString_or_idContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IShell_scriptContext)
-}
-
-func (s *ShellScriptContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterShellScript(s)
-	}
+	return t.(IString_or_idContext)
 }
 
-func (s *ShellScriptContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitShellScript(s)
-	}
+func (s *ReplaceKubectlCommandContext) AllUPDATELOAD() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserUPDATELOAD)
 }
 
-type YamlSelectFnContext struct {
-	*ExprContext
+func (s *ReplaceKubectlCommandContext) UPDATELOAD(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserUPDATELOAD, i)
 }
 
-func NewYamlSelectFnContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *YamlSelectFnContext {
-	var p = new(YamlSelectFnContext)
-
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+func (s *ReplaceKubectlCommandContext) AllKubernetes_object_config() []IKubernetes_object_configContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IKubernetes_object_configContext)(nil)).Elem())
+	var tst = make([]IKubernetes_object_configContext, len(ts))
 
-	return p
-}
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IKubernetes_object_configContext)
+		}
+	}
 
-func (s *YamlSelectFnContext) GetRuleContext() antlr.RuleContext {
-	return s
+	return tst
 }
 
-func (s *YamlSelectFnContext) Yaml_select_fn() IYaml_select_fnContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IYaml_select_fnContext)(nil)).Elem(), 0)
+func (s *ReplaceKubectlCommandContext) Kubernetes_object_config(i int) IKubernetes_object_configContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IKubernetes_object_configContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IYaml_select_fnContext)
+	return t.(IKubernetes_object_configContext)
 }
 
-func (s *YamlSelectFnContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ReplaceKubectlCommandContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterYamlSelectFn(s)
+		listenerT.EnterReplaceKubectlCommand(s)
 	}
 }
 
-func (s *YamlSelectFnContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ReplaceKubectlCommandContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitYamlSelectFn(s)
+		listenerT.ExitReplaceKubectlCommand(s)
 	}
 }
 
-type AtomExprContext struct {
-	*ExprContext
+type WaitKubectlCommandContext struct {
+	*Kubectl_commandContext
 }
 
-func NewAtomExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AtomExprContext {
-	var p = new(AtomExprContext)
+func NewWaitKubectlCommandContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *WaitKubectlCommandContext {
+	var p = new(WaitKubectlCommandContext)
 
-	p.ExprContext = NewEmptyExprContext()
+	p.Kubectl_commandContext = NewEmptyKubectl_commandContext()
 	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+	p.CopyFrom(ctx.(*Kubectl_commandContext))
 
 	return p
 }
 
-func (s *AtomExprContext) GetRuleContext() antlr.RuleContext {
+func (s *WaitKubectlCommandContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *AtomExprContext) Atom() IAtomContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAtomContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IAtomContext)
+func (s *WaitKubectlCommandContext) KUBECTL() antlr.TerminalNode {
+	return s.GetToken(KlangParserKUBECTL, 0)
 }
 
-func (s *AtomExprContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterAtomExpr(s)
-	}
+func (s *WaitKubectlCommandContext) WAIT() antlr.TerminalNode {
+	return s.GetToken(KlangParserWAIT, 0)
 }
 
-func (s *AtomExprContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitAtomExpr(s)
-	}
+func (s *WaitKubectlCommandContext) AllNAMESPACE() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserNAMESPACE)
 }
 
-type OrExprContext struct {
-	*ExprContext
+func (s *WaitKubectlCommandContext) NAMESPACE(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserNAMESPACE, i)
 }
 
-func NewOrExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *OrExprContext {
-	var p = new(OrExprContext)
+func (s *WaitKubectlCommandContext) AllNs() []INsContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*INsContext)(nil)).Elem())
+	var tst = make([]INsContext, len(ts))
 
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(INsContext)
+		}
+	}
 
-	return p
+	return tst
 }
 
-func (s *OrExprContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *WaitKubectlCommandContext) Ns(i int) INsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*INsContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INsContext)
 }
 
-func (s *OrExprContext) AllExpr() []IExprContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
-	var tst = make([]IExprContext, len(ts))
+func (s *WaitKubectlCommandContext) AllResource() []IResourceContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IResourceContext)(nil)).Elem())
+	var tst = make([]IResourceContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(IExprContext)
+			tst[i] = t.(IResourceContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *OrExprContext) Expr(i int) IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+func (s *WaitKubectlCommandContext) Resource(i int) IResourceContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IResourceContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExprContext)
+	return t.(IResourceContext)
 }
 
-func (s *OrExprContext) OR() antlr.TerminalNode {
-	return s.GetToken(KlangParserOR, 0)
+func (s *WaitKubectlCommandContext) AllFORCONDITION() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserFORCONDITION)
 }
 
-func (s *OrExprContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterOrExpr(s)
-	}
+func (s *WaitKubectlCommandContext) FORCONDITION(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserFORCONDITION, i)
 }
 
-func (s *OrExprContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitOrExpr(s)
-	}
+func (s *WaitKubectlCommandContext) AllWAITFOR() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserWAITFOR)
 }
 
-type AdditiveExprContext struct {
-	*ExprContext
-	op antlr.Token
+func (s *WaitKubectlCommandContext) WAITFOR(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserWAITFOR, i)
 }
 
-func NewAdditiveExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AdditiveExprContext {
-	var p = new(AdditiveExprContext)
+func (s *WaitKubectlCommandContext) AllString_or_id() []IString_or_idContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IString_or_idContext)(nil)).Elem())
+	var tst = make([]IString_or_idContext, len(ts))
 
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IString_or_idContext)
+		}
+	}
 
-	return p
+	return tst
 }
 
-func (s *AdditiveExprContext) GetOp() antlr.Token { return s.op }
+func (s *WaitKubectlCommandContext) String_or_id(i int) IString_or_idContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), i)
 
-func (s *AdditiveExprContext) SetOp(v antlr.Token) { s.op = v }
+	if t == nil {
+		return nil
+	}
 
-func (s *AdditiveExprContext) GetRuleContext() antlr.RuleContext {
-	return s
+	return t.(IString_or_idContext)
 }
 
-func (s *AdditiveExprContext) AllExpr() []IExprContext {
+func (s *WaitKubectlCommandContext) AllWAITTIMEOUT() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserWAITTIMEOUT)
+}
+
+func (s *WaitKubectlCommandContext) WAITTIMEOUT(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserWAITTIMEOUT, i)
+}
+
+func (s *WaitKubectlCommandContext) AllExpr() []IExprContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
 	var tst = make([]IExprContext, len(ts))
 
@@ -6485,7 +6784,7 @@ func (s *AdditiveExprContext) AllExpr() []IExprContext {
 	return tst
 }
 
-func (s *AdditiveExprContext) Expr(i int) IExprContext {
+func (s *WaitKubectlCommandContext) Expr(i int) IExprContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
@@ -6495,480 +6794,519 @@ func (s *AdditiveExprContext) Expr(i int) IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *AdditiveExprContext) PLUS() antlr.TerminalNode {
-	return s.GetToken(KlangParserPLUS, 0)
+func (s *WaitKubectlCommandContext) AllASSIGN() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserASSIGN)
 }
 
-func (s *AdditiveExprContext) MINUS() antlr.TerminalNode {
-	return s.GetToken(KlangParserMINUS, 0)
+func (s *WaitKubectlCommandContext) ASSIGN(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserASSIGN, i)
 }
 
-func (s *AdditiveExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *WaitKubectlCommandContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterAdditiveExpr(s)
+		listenerT.EnterWaitKubectlCommand(s)
 	}
 }
 
-func (s *AdditiveExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *WaitKubectlCommandContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitAdditiveExpr(s)
+		listenerT.ExitWaitKubectlCommand(s)
 	}
 }
 
-type RelationalExprContext struct {
-	*ExprContext
-	op antlr.Token
+type ApplyKubectlCommandContext struct {
+	*Kubectl_commandContext
 }
 
-func NewRelationalExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *RelationalExprContext {
-	var p = new(RelationalExprContext)
+func NewApplyKubectlCommandContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ApplyKubectlCommandContext {
+	var p = new(ApplyKubectlCommandContext)
 
-	p.ExprContext = NewEmptyExprContext()
+	p.Kubectl_commandContext = NewEmptyKubectl_commandContext()
 	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+	p.CopyFrom(ctx.(*Kubectl_commandContext))
 
 	return p
 }
 
-func (s *RelationalExprContext) GetOp() antlr.Token { return s.op }
-
-func (s *RelationalExprContext) SetOp(v antlr.Token) { s.op = v }
-
-func (s *RelationalExprContext) GetRuleContext() antlr.RuleContext {
+func (s *ApplyKubectlCommandContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *RelationalExprContext) AllExpr() []IExprContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
-	var tst = make([]IExprContext, len(ts))
+func (s *ApplyKubectlCommandContext) KUBECTL() antlr.TerminalNode {
+	return s.GetToken(KlangParserKUBECTL, 0)
+}
+
+func (s *ApplyKubectlCommandContext) APPLY() antlr.TerminalNode {
+	return s.GetToken(KlangParserAPPLY, 0)
+}
+
+func (s *ApplyKubectlCommandContext) AllNAMESPACE() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserNAMESPACE)
+}
+
+func (s *ApplyKubectlCommandContext) NAMESPACE(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserNAMESPACE, i)
+}
+
+func (s *ApplyKubectlCommandContext) AllNs() []INsContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*INsContext)(nil)).Elem())
+	var tst = make([]INsContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(IExprContext)
+			tst[i] = t.(INsContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *RelationalExprContext) Expr(i int) IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+func (s *ApplyKubectlCommandContext) Ns(i int) INsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*INsContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExprContext)
+	return t.(INsContext)
 }
 
-func (s *RelationalExprContext) LTEQ() antlr.TerminalNode {
-	return s.GetToken(KlangParserLTEQ, 0)
-}
+func (s *ApplyKubectlCommandContext) AllString_or_id() []IString_or_idContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IString_or_idContext)(nil)).Elem())
+	var tst = make([]IString_or_idContext, len(ts))
 
-func (s *RelationalExprContext) GTEQ() antlr.TerminalNode {
-	return s.GetToken(KlangParserGTEQ, 0)
-}
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IString_or_idContext)
+		}
+	}
 
-func (s *RelationalExprContext) LT() antlr.TerminalNode {
-	return s.GetToken(KlangParserLT, 0)
+	return tst
 }
 
-func (s *RelationalExprContext) GT() antlr.TerminalNode {
-	return s.GetToken(KlangParserGT, 0)
-}
+func (s *ApplyKubectlCommandContext) String_or_id(i int) IString_or_idContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), i)
 
-func (s *RelationalExprContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterRelationalExpr(s)
+	if t == nil {
+		return nil
 	}
-}
 
-func (s *RelationalExprContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitRelationalExpr(s)
-	}
+	return t.(IString_or_idContext)
 }
 
-type NotExprContext struct {
-	*ExprContext
+func (s *ApplyKubectlCommandContext) AllUPDATELOAD() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserUPDATELOAD)
 }
 
-func NewNotExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NotExprContext {
-	var p = new(NotExprContext)
-
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
-
-	return p
+func (s *ApplyKubectlCommandContext) UPDATELOAD(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserUPDATELOAD, i)
 }
 
-func (s *NotExprContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *ApplyKubectlCommandContext) AllKubernetes_object_config() []IKubernetes_object_configContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IKubernetes_object_configContext)(nil)).Elem())
+	var tst = make([]IKubernetes_object_configContext, len(ts))
 
-func (s *NotExprContext) NOT() antlr.TerminalNode {
-	return s.GetToken(KlangParserNOT, 0)
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IKubernetes_object_configContext)
+		}
+	}
+
+	return tst
 }
 
-func (s *NotExprContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+func (s *ApplyKubectlCommandContext) Kubernetes_object_config(i int) IKubernetes_object_configContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IKubernetes_object_configContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExprContext)
+	return t.(IKubernetes_object_configContext)
 }
 
-func (s *NotExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ApplyKubectlCommandContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterNotExpr(s)
+		listenerT.EnterApplyKubectlCommand(s)
 	}
 }
 
-func (s *NotExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ApplyKubectlCommandContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitNotExpr(s)
+		listenerT.ExitApplyKubectlCommand(s)
 	}
 }
 
-type UnaryMinusExprContext struct {
-	*ExprContext
+type GetKubectlCommandContext struct {
+	*Kubectl_commandContext
 }
 
-func NewUnaryMinusExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *UnaryMinusExprContext {
-	var p = new(UnaryMinusExprContext)
+func NewGetKubectlCommandContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *GetKubectlCommandContext {
+	var p = new(GetKubectlCommandContext)
 
-	p.ExprContext = NewEmptyExprContext()
+	p.Kubectl_commandContext = NewEmptyKubectl_commandContext()
 	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+	p.CopyFrom(ctx.(*Kubectl_commandContext))
 
 	return p
 }
 
-func (s *UnaryMinusExprContext) GetRuleContext() antlr.RuleContext {
+func (s *GetKubectlCommandContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *UnaryMinusExprContext) MINUS() antlr.TerminalNode {
-	return s.GetToken(KlangParserMINUS, 0)
-}
-
-func (s *UnaryMinusExprContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IExprContext)
+func (s *GetKubectlCommandContext) KUBECTL() antlr.TerminalNode {
+	return s.GetToken(KlangParserKUBECTL, 0)
 }
 
-func (s *UnaryMinusExprContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterUnaryMinusExpr(s)
-	}
+func (s *GetKubectlCommandContext) GET() antlr.TerminalNode {
+	return s.GetToken(KlangParserGET, 0)
 }
 
-func (s *UnaryMinusExprContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitUnaryMinusExpr(s)
-	}
+func (s *GetKubectlCommandContext) AllNAMESPACE() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserNAMESPACE)
 }
 
-type MultiplicationExprContext struct {
-	*ExprContext
-	op antlr.Token
+func (s *GetKubectlCommandContext) NAMESPACE(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserNAMESPACE, i)
 }
 
-func NewMultiplicationExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MultiplicationExprContext {
-	var p = new(MultiplicationExprContext)
+func (s *GetKubectlCommandContext) AllNs() []INsContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*INsContext)(nil)).Elem())
+	var tst = make([]INsContext, len(ts))
 
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(INsContext)
+		}
+	}
 
-	return p
+	return tst
 }
 
-func (s *MultiplicationExprContext) GetOp() antlr.Token { return s.op }
+func (s *GetKubectlCommandContext) Ns(i int) INsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*INsContext)(nil)).Elem(), i)
 
-func (s *MultiplicationExprContext) SetOp(v antlr.Token) { s.op = v }
+	if t == nil {
+		return nil
+	}
 
-func (s *MultiplicationExprContext) GetRuleContext() antlr.RuleContext {
-	return s
+	return t.(INsContext)
 }
 
-func (s *MultiplicationExprContext) AllExpr() []IExprContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
-	var tst = make([]IExprContext, len(ts))
+func (s *GetKubectlCommandContext) AllResource() []IResourceContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IResourceContext)(nil)).Elem())
+	var tst = make([]IResourceContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(IExprContext)
+			tst[i] = t.(IResourceContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *MultiplicationExprContext) Expr(i int) IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+func (s *GetKubectlCommandContext) Resource(i int) IResourceContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IResourceContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExprContext)
+	return t.(IResourceContext)
 }
 
-func (s *MultiplicationExprContext) MULT() antlr.TerminalNode {
-	return s.GetToken(KlangParserMULT, 0)
-}
+func (s *GetKubectlCommandContext) AllSelector() []ISelectorContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ISelectorContext)(nil)).Elem())
+	var tst = make([]ISelectorContext, len(ts))
 
-func (s *MultiplicationExprContext) DIV() antlr.TerminalNode {
-	return s.GetToken(KlangParserDIV, 0)
-}
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ISelectorContext)
+		}
+	}
 
-func (s *MultiplicationExprContext) MOD() antlr.TerminalNode {
-	return s.GetToken(KlangParserMOD, 0)
+	return tst
 }
 
-func (s *MultiplicationExprContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterMultiplicationExpr(s)
-	}
-}
+func (s *GetKubectlCommandContext) Selector(i int) ISelectorContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISelectorContext)(nil)).Elem(), i)
 
-func (s *MultiplicationExprContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitMultiplicationExpr(s)
+	if t == nil {
+		return nil
 	}
+
+	return t.(ISelectorContext)
 }
 
-type DownloadFnContext struct {
-	*ExprContext
+func (s *GetKubectlCommandContext) AllALLNAMESPACES() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserALLNAMESPACES)
 }
 
-func NewDownloadFnContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *DownloadFnContext {
-	var p = new(DownloadFnContext)
+func (s *GetKubectlCommandContext) ALLNAMESPACES(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserALLNAMESPACES, i)
+}
 
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+func (s *GetKubectlCommandContext) AllOutput() []IOutputContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IOutputContext)(nil)).Elem())
+	var tst = make([]IOutputContext, len(ts))
 
-	return p
-}
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IOutputContext)
+		}
+	}
 
-func (s *DownloadFnContext) GetRuleContext() antlr.RuleContext {
-	return s
+	return tst
 }
 
-func (s *DownloadFnContext) Download_fn() IDownload_fnContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDownload_fnContext)(nil)).Elem(), 0)
+func (s *GetKubectlCommandContext) Output(i int) IOutputContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOutputContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IDownload_fnContext)
+	return t.(IOutputContext)
 }
 
-func (s *DownloadFnContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *GetKubectlCommandContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterDownloadFn(s)
+		listenerT.EnterGetKubectlCommand(s)
 	}
 }
 
-func (s *DownloadFnContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *GetKubectlCommandContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitDownloadFn(s)
+		listenerT.ExitGetKubectlCommand(s)
 	}
 }
 
-type PowExprContext struct {
-	*ExprContext
+type RolloutKubectlCommandContext struct {
+	*Kubectl_commandContext
 }
 
-func NewPowExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PowExprContext {
-	var p = new(PowExprContext)
+func NewRolloutKubectlCommandContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *RolloutKubectlCommandContext {
+	var p = new(RolloutKubectlCommandContext)
 
-	p.ExprContext = NewEmptyExprContext()
+	p.Kubectl_commandContext = NewEmptyKubectl_commandContext()
 	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+	p.CopyFrom(ctx.(*Kubectl_commandContext))
 
 	return p
 }
 
-func (s *PowExprContext) GetRuleContext() antlr.RuleContext {
+func (s *RolloutKubectlCommandContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PowExprContext) AllExpr() []IExprContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
-	var tst = make([]IExprContext, len(ts))
+func (s *RolloutKubectlCommandContext) KUBECTL() antlr.TerminalNode {
+	return s.GetToken(KlangParserKUBECTL, 0)
+}
+
+func (s *RolloutKubectlCommandContext) ROLLOUT() antlr.TerminalNode {
+	return s.GetToken(KlangParserROLLOUT, 0)
+}
+
+func (s *RolloutKubectlCommandContext) ID() antlr.TerminalNode {
+	return s.GetToken(KlangParserID, 0)
+}
+
+func (s *RolloutKubectlCommandContext) AllNAMESPACE() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserNAMESPACE)
+}
+
+func (s *RolloutKubectlCommandContext) NAMESPACE(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserNAMESPACE, i)
+}
+
+func (s *RolloutKubectlCommandContext) AllNs() []INsContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*INsContext)(nil)).Elem())
+	var tst = make([]INsContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(IExprContext)
+			tst[i] = t.(INsContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *PowExprContext) Expr(i int) IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+func (s *RolloutKubectlCommandContext) Ns(i int) INsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*INsContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExprContext)
+	return t.(INsContext)
 }
 
-func (s *PowExprContext) POW() antlr.TerminalNode {
-	return s.GetToken(KlangParserPOW, 0)
-}
+func (s *RolloutKubectlCommandContext) AllResource() []IResourceContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IResourceContext)(nil)).Elem())
+	var tst = make([]IResourceContext, len(ts))
 
-func (s *PowExprContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterPowExpr(s)
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IResourceContext)
+		}
 	}
+
+	return tst
 }
 
-func (s *PowExprContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitPowExpr(s)
+func (s *RolloutKubectlCommandContext) Resource(i int) IResourceContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IResourceContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
 	}
+
+	return t.(IResourceContext)
 }
 
-type KubectlExprContext struct {
-	*ExprContext
+func (s *RolloutKubectlCommandContext) AllWAITTIMEOUT() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserWAITTIMEOUT)
 }
 
-func NewKubectlExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *KubectlExprContext {
-	var p = new(KubectlExprContext)
+func (s *RolloutKubectlCommandContext) WAITTIMEOUT(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserWAITTIMEOUT, i)
+}
 
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+func (s *RolloutKubectlCommandContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
 
-	return p
-}
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
 
-func (s *KubectlExprContext) GetRuleContext() antlr.RuleContext {
-	return s
+	return tst
 }
 
-func (s *KubectlExprContext) Kubectl_command() IKubectl_commandContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IKubectl_commandContext)(nil)).Elem(), 0)
+func (s *RolloutKubectlCommandContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IKubectl_commandContext)
+	return t.(IExprContext)
 }
 
-func (s *KubectlExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *RolloutKubectlCommandContext) AllASSIGN() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserASSIGN)
+}
+
+func (s *RolloutKubectlCommandContext) ASSIGN(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserASSIGN, i)
+}
+
+func (s *RolloutKubectlCommandContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterKubectlExpr(s)
+		listenerT.EnterRolloutKubectlCommand(s)
 	}
 }
 
-func (s *KubectlExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *RolloutKubectlCommandContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitKubectlExpr(s)
+		listenerT.ExitRolloutKubectlCommand(s)
 	}
 }
 
-type EqualityExprContext struct {
-	*ExprContext
-	op antlr.Token
+type ScaleKubectlCommandContext struct {
+	*Kubectl_commandContext
 }
 
-func NewEqualityExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *EqualityExprContext {
-	var p = new(EqualityExprContext)
+func NewScaleKubectlCommandContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ScaleKubectlCommandContext {
+	var p = new(ScaleKubectlCommandContext)
 
-	p.ExprContext = NewEmptyExprContext()
+	p.Kubectl_commandContext = NewEmptyKubectl_commandContext()
 	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+	p.CopyFrom(ctx.(*Kubectl_commandContext))
 
 	return p
 }
 
-func (s *EqualityExprContext) GetOp() antlr.Token { return s.op }
+func (s *ScaleKubectlCommandContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-func (s *EqualityExprContext) SetOp(v antlr.Token) { s.op = v }
+func (s *ScaleKubectlCommandContext) KUBECTL() antlr.TerminalNode {
+	return s.GetToken(KlangParserKUBECTL, 0)
+}
 
-func (s *EqualityExprContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *ScaleKubectlCommandContext) SCALE() antlr.TerminalNode {
+	return s.GetToken(KlangParserSCALE, 0)
 }
 
-func (s *EqualityExprContext) AllExpr() []IExprContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
-	var tst = make([]IExprContext, len(ts))
+func (s *ScaleKubectlCommandContext) AllNAMESPACE() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserNAMESPACE)
+}
+
+func (s *ScaleKubectlCommandContext) NAMESPACE(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserNAMESPACE, i)
+}
+
+func (s *ScaleKubectlCommandContext) AllNs() []INsContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*INsContext)(nil)).Elem())
+	var tst = make([]INsContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(IExprContext)
+			tst[i] = t.(INsContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *EqualityExprContext) Expr(i int) IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+func (s *ScaleKubectlCommandContext) Ns(i int) INsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*INsContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExprContext)
-}
-
-func (s *EqualityExprContext) EQ() antlr.TerminalNode {
-	return s.GetToken(KlangParserEQ, 0)
+	return t.(INsContext)
 }
 
-func (s *EqualityExprContext) NEQ() antlr.TerminalNode {
-	return s.GetToken(KlangParserNEQ, 0)
-}
+func (s *ScaleKubectlCommandContext) AllResource() []IResourceContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IResourceContext)(nil)).Elem())
+	var tst = make([]IResourceContext, len(ts))
 
-func (s *EqualityExprContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterEqualityExpr(s)
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IResourceContext)
+		}
 	}
-}
 
-func (s *EqualityExprContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitEqualityExpr(s)
-	}
+	return tst
 }
 
-type AndExprContext struct {
-	*ExprContext
-}
+func (s *ScaleKubectlCommandContext) Resource(i int) IResourceContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IResourceContext)(nil)).Elem(), i)
 
-func NewAndExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AndExprContext {
-	var p = new(AndExprContext)
+	if t == nil {
+		return nil
+	}
 
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+	return t.(IResourceContext)
+}
 
-	return p
+func (s *ScaleKubectlCommandContext) AllREPLICAS() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserREPLICAS)
 }
 
-func (s *AndExprContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *ScaleKubectlCommandContext) REPLICAS(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserREPLICAS, i)
 }
 
-func (s *AndExprContext) AllExpr() []IExprContext {
+func (s *ScaleKubectlCommandContext) AllExpr() []IExprContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
 	var tst = make([]IExprContext, len(ts))
 
@@ -6981,7 +7319,7 @@ func (s *AndExprContext) AllExpr() []IExprContext {
 	return tst
 }
 
-func (s *AndExprContext) Expr(i int) IExprContext {
+func (s *ScaleKubectlCommandContext) Expr(i int) IExprContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
@@ -6991,38 +7329,33 @@ func (s *AndExprContext) Expr(i int) IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *AndExprContext) AND() antlr.TerminalNode {
-	return s.GetToken(KlangParserAND, 0)
+func (s *ScaleKubectlCommandContext) AllASSIGN() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserASSIGN)
 }
 
-func (s *AndExprContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterAndExpr(s)
-	}
+func (s *ScaleKubectlCommandContext) ASSIGN(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserASSIGN, i)
 }
 
-func (s *AndExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ScaleKubectlCommandContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitAndExpr(s)
+		listenerT.EnterScaleKubectlCommand(s)
 	}
 }
 
-func (p *KlangParser) Expr() (localctx IExprContext) {
-	return p.expr(0)
+func (s *ScaleKubectlCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitScaleKubectlCommand(s)
+	}
 }
 
-func (p *KlangParser) expr(_p int) (localctx IExprContext) {
-	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
-	_parentState := p.GetState()
-	localctx = NewExprContext(p, p.GetParserRuleContext(), _parentState)
-	var _prevctx IExprContext = localctx
-	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 68
-	p.EnterRecursionRule(localctx, 68, KlangParserRULE_expr, _p)
+func (p *KlangParser) Kubectl_command() (localctx IKubectl_commandContext) {
+	localctx = NewKubectl_commandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, KlangParserRULE_kubectl_command)
 	var _la int
 
 	defer func() {
-		p.UnrollRecursionContexts(_parentctx)
+		p.ExitRule()
 	}()
 
 	defer func() {
@@ -7039,361 +7372,4583 @@ func (p *KlangParser) expr(_p int) (localctx IExprContext) {
 
 	var _alt int
 
-	p.EnterOuterAlt(localctx, 1)
-	p.SetState(445)
+	p.SetState(584)
 	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case KlangParserMINUS:
-		localctx = NewUnaryMinusExprContext(p, localctx)
-		p.SetParserRuleContext(localctx)
-		_prevctx = localctx
-
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 45, p.GetParserRuleContext()) {
+	case 1:
+		localctx = NewApplyKubectlCommandContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(435)
-			p.Match(KlangParserMINUS)
+			p.SetState(469)
+			p.Match(KlangParserKUBECTL)
 		}
 		{
-			p.SetState(436)
-			p.expr(14)
+			p.SetState(470)
+			p.Match(KlangParserAPPLY)
 		}
+		p.SetState(476)
+		p.GetErrorHandler().Sync(p)
+		_alt = 1
+		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			switch _alt {
+			case 1:
+				p.SetState(476)
+				p.GetErrorHandler().Sync(p)
 
-	case KlangParserNOT:
-		localctx = NewNotExprContext(p, localctx)
-		p.SetParserRuleContext(localctx)
-		_prevctx = localctx
-		{
-			p.SetState(437)
-			p.Match(KlangParserNOT)
-		}
-		{
-			p.SetState(438)
-			p.expr(13)
-		}
+				switch p.GetTokenStream().LA(1) {
+				case KlangParserNAMESPACE:
+					{
+						p.SetState(471)
+						p.Match(KlangParserNAMESPACE)
+					}
+					{
+						p.SetState(472)
+						p.Ns()
+					}
 
-	case KlangParserKUBECTL:
-		localctx = NewKubectlExprContext(p, localctx)
-		p.SetParserRuleContext(localctx)
-		_prevctx = localctx
-		{
-			p.SetState(439)
-			p.Kubectl_command()
+				case KlangParserID, KlangParserRAW_STRING_LIT, KlangParserSTRING:
+					{
+						p.SetState(473)
+						p.String_or_id()
+					}
+
+				case KlangParserUPDATELOAD:
+					{
+						p.SetState(474)
+						p.Match(KlangParserUPDATELOAD)
+					}
+					{
+						p.SetState(475)
+						p.Kubernetes_object_config()
+					}
+
+				default:
+					panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+				}
+
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
+
+			p.SetState(478)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 25, p.GetParserRuleContext())
 		}
 
-	case KlangParserJSONSELECT:
-		localctx = NewJsonSelectFnContext(p, localctx)
-		p.SetParserRuleContext(localctx)
-		_prevctx = localctx
+	case 2:
+		localctx = NewPatchKubectlCommandContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(440)
-			p.Json_select_fn()
+			p.SetState(480)
+			p.Match(KlangParserKUBECTL)
 		}
-
-	case KlangParserYAMLSELECT:
-		localctx = NewYamlSelectFnContext(p, localctx)
-		p.SetParserRuleContext(localctx)
-		_prevctx = localctx
 		{
-			p.SetState(441)
-			p.Yaml_select_fn()
-		}
-
-	case KlangParserSHELLSCRIPT:
-		localctx = NewShellScriptContext(p, localctx)
-		p.SetParserRuleContext(localctx)
-		_prevctx = localctx
-		{
-			p.SetState(442)
-			p.Shell_script()
+			p.SetState(481)
+			p.Match(KlangParserPATCH)
 		}
+		p.SetState(489)
+		p.GetErrorHandler().Sync(p)
+		_alt = 1
+		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			switch _alt {
+			case 1:
+				p.SetState(489)
+				p.GetErrorHandler().Sync(p)
 
-	case KlangParserDOWNLOAD:
-		localctx = NewDownloadFnContext(p, localctx)
-		p.SetParserRuleContext(localctx)
-		_prevctx = localctx
-		{
-			p.SetState(443)
-			p.Download_fn()
-		}
+				switch p.GetTokenStream().LA(1) {
+				case KlangParserNAMESPACE:
+					{
+						p.SetState(482)
+						p.Match(KlangParserNAMESPACE)
+					}
+					{
+						p.SetState(483)
+						p.Ns()
+					}
 
-	case KlangParserT__2, KlangParserT__4, KlangParserOPAR, KlangParserOBRACE, KlangParserTRUE, KlangParserFALSE, KlangParserNIL, KlangParserID, KlangParserNUMBER, KlangParserRAW_STRING_LIT, KlangParserSTRING:
-		localctx = NewAtomExprContext(p, localctx)
-		p.SetParserRuleContext(localctx)
-		_prevctx = localctx
-		{
-			p.SetState(444)
-			p.Atom()
-		}
+				case KlangParserID, KlangParserPATH, KlangParserRAW_STRING_LIT, KlangParserSTRING:
+					{
+						p.SetState(484)
+						p.Resource()
+					}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-	}
-	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(470)
-	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 32, p.GetParserRuleContext())
+				case KlangParserPATCHTYPE:
+					{
+						p.SetState(485)
+						p.Match(KlangParserPATCHTYPE)
+					}
+					{
+						p.SetState(486)
+						p.Patch_type()
+					}
 
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			if p.GetParseListeners() != nil {
-				p.TriggerExitRuleEvent()
-			}
-			_prevctx = localctx
-			p.SetState(468)
-			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 31, p.GetParserRuleContext()) {
-			case 1:
-				localctx = NewPowExprContext(p, NewExprContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, KlangParserRULE_expr)
-				p.SetState(447)
+				case KlangParserPATCHLOAD:
+					{
+						p.SetState(487)
+						p.Match(KlangParserPATCHLOAD)
+					}
+					{
+						p.SetState(488)
+						p.String_or_id()
+					}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 15)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 15)", ""))
-				}
-				{
-					p.SetState(448)
-					p.Match(KlangParserPOW)
-				}
-				{
-					p.SetState(449)
-					p.expr(15)
+				default:
+					panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 				}
 
-			case 2:
-				localctx = NewMultiplicationExprContext(p, NewExprContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, KlangParserRULE_expr)
-				p.SetState(450)
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 12)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 12)", ""))
-				}
-				p.SetState(451)
+			p.SetState(491)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 27, p.GetParserRuleContext())
+		}
 
-				var _lt = p.GetTokenStream().LT(1)
+	case 3:
+		localctx = NewGetKubectlCommandContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(493)
+			p.Match(KlangParserKUBECTL)
+		}
+		{
+			p.SetState(494)
+			p.Match(KlangParserGET)
+		}
+		p.SetState(501)
+		p.GetErrorHandler().Sync(p)
+		_alt = 1
+		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			switch _alt {
+			case 1:
+				p.SetState(501)
+				p.GetErrorHandler().Sync(p)
 
-				localctx.(*MultiplicationExprContext).op = _lt
+				switch p.GetTokenStream().LA(1) {
+				case KlangParserNAMESPACE:
+					{
+						p.SetState(495)
+						p.Match(KlangParserNAMESPACE)
+					}
+					{
+						p.SetState(496)
+						p.Ns()
+					}
 
-				_la = p.GetTokenStream().LA(1)
+				case KlangParserID, KlangParserPATH, KlangParserRAW_STRING_LIT, KlangParserSTRING:
+					{
+						p.SetState(497)
+						p.Resource()
+					}
 
-				if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<KlangParserMULT)|(1<<KlangParserDIV)|(1<<KlangParserMOD))) != 0) {
-					var _ri = p.GetErrorHandler().RecoverInline(p)
+				case KlangParserLABELSELECTOR, KlangParserFIELDSELECTOR, KlangParserSELECTOR, KlangParserFIELDSELECTORFLAG:
+					{
+						p.SetState(498)
+						p.Selector()
+					}
 
-					localctx.(*MultiplicationExprContext).op = _ri
-				} else {
-					p.GetErrorHandler().ReportMatch(p)
-					p.Consume()
-				}
-				{
-					p.SetState(452)
-					p.expr(13)
-				}
+				case KlangParserALLNAMESPACES:
+					{
+						p.SetState(499)
+						p.Match(KlangParserALLNAMESPACES)
+					}
 
-			case 3:
-				localctx = NewAdditiveExprContext(p, NewExprContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, KlangParserRULE_expr)
-				p.SetState(453)
+				case KlangParserOUTPUTFORMAT, KlangParserOUTPUT, KlangParserJSONPATH:
+					{
+						p.SetState(500)
+						p.Output()
+					}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 11)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 11)", ""))
+				default:
+					panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 				}
-				p.SetState(454)
 
-				var _lt = p.GetTokenStream().LT(1)
-
-				localctx.(*AdditiveExprContext).op = _lt
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
 
-				_la = p.GetTokenStream().LA(1)
+			p.SetState(503)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 29, p.GetParserRuleContext())
+		}
 
-				if !(_la == KlangParserPLUS || _la == KlangParserMINUS) {
-					var _ri = p.GetErrorHandler().RecoverInline(p)
+	case 4:
+		localctx = NewWaitKubectlCommandContext(p, localctx)
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(505)
+			p.Match(KlangParserKUBECTL)
+		}
+		{
+			p.SetState(506)
+			p.Match(KlangParserWAIT)
+		}
+		p.SetState(518)
+		p.GetErrorHandler().Sync(p)
+		_alt = 1
+		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			switch _alt {
+			case 1:
+				p.SetState(518)
+				p.GetErrorHandler().Sync(p)
 
-					localctx.(*AdditiveExprContext).op = _ri
-				} else {
-					p.GetErrorHandler().ReportMatch(p)
-					p.Consume()
-				}
-				{
-					p.SetState(455)
-					p.expr(12)
-				}
+				switch p.GetTokenStream().LA(1) {
+				case KlangParserNAMESPACE:
+					{
+						p.SetState(507)
+						p.Match(KlangParserNAMESPACE)
+					}
+					{
+						p.SetState(508)
+						p.Ns()
+					}
 
-			case 4:
-				localctx = NewRelationalExprContext(p, NewExprContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, KlangParserRULE_expr)
-				p.SetState(456)
+				case KlangParserID, KlangParserPATH, KlangParserRAW_STRING_LIT, KlangParserSTRING:
+					{
+						p.SetState(509)
+						p.Resource()
+					}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 10)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 10)", ""))
-				}
-				p.SetState(457)
+				case KlangParserFORCONDITION:
+					{
+						p.SetState(510)
+						p.Match(KlangParserFORCONDITION)
+					}
 
-				var _lt = p.GetTokenStream().LT(1)
+				case KlangParserWAITFOR:
+					{
+						p.SetState(511)
+						p.Match(KlangParserWAITFOR)
+					}
+					{
+						p.SetState(512)
+						p.String_or_id()
+					}
 
-				localctx.(*RelationalExprContext).op = _lt
+				case KlangParserWAITTIMEOUT:
+					{
+						p.SetState(513)
+						p.Match(KlangParserWAITTIMEOUT)
+					}
+					p.SetState(515)
+					p.GetErrorHandler().Sync(p)
+					_la = p.GetTokenStream().LA(1)
 
-				_la = p.GetTokenStream().LA(1)
+					if _la == KlangParserASSIGN {
+						{
+							p.SetState(514)
+							p.Match(KlangParserASSIGN)
+						}
 
-				if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<KlangParserGT)|(1<<KlangParserLT)|(1<<KlangParserGTEQ)|(1<<KlangParserLTEQ))) != 0) {
-					var _ri = p.GetErrorHandler().RecoverInline(p)
+					}
+					{
+						p.SetState(517)
+						p.expr(0)
+					}
 
-					localctx.(*RelationalExprContext).op = _ri
-				} else {
-					p.GetErrorHandler().ReportMatch(p)
-					p.Consume()
-				}
-				{
-					p.SetState(458)
-					p.expr(11)
+				default:
+					panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 				}
 
-			case 5:
-				localctx = NewEqualityExprContext(p, NewExprContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, KlangParserRULE_expr)
-				p.SetState(459)
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
-				}
-				p.SetState(460)
+			p.SetState(520)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 32, p.GetParserRuleContext())
+		}
 
-				var _lt = p.GetTokenStream().LT(1)
+	case 5:
+		localctx = NewDeleteKubectlCommandContext(p, localctx)
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(522)
+			p.Match(KlangParserKUBECTL)
+		}
+		{
+			p.SetState(523)
+			p.Match(KlangParserDELETE)
+		}
+		p.SetState(529)
+		p.GetErrorHandler().Sync(p)
+		_alt = 1
+		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			switch _alt {
+			case 1:
+				p.SetState(529)
+				p.GetErrorHandler().Sync(p)
 
-				localctx.(*EqualityExprContext).op = _lt
+				switch p.GetTokenStream().LA(1) {
+				case KlangParserNAMESPACE:
+					{
+						p.SetState(524)
+						p.Match(KlangParserNAMESPACE)
+					}
+					{
+						p.SetState(525)
+						p.Ns()
+					}
 
-				_la = p.GetTokenStream().LA(1)
+				case KlangParserID, KlangParserPATH, KlangParserRAW_STRING_LIT, KlangParserSTRING:
+					{
+						p.SetState(526)
+						p.Resource()
+					}
+
+				case KlangParserLABELSELECTOR, KlangParserFIELDSELECTOR, KlangParserSELECTOR, KlangParserFIELDSELECTORFLAG:
+					{
+						p.SetState(527)
+						p.Selector()
+					}
+
+				case KlangParserALL:
+					{
+						p.SetState(528)
+						p.Match(KlangParserALL)
+					}
+
+				default:
+					panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+				}
+
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
+
+			p.SetState(531)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 34, p.GetParserRuleContext())
+		}
+
+	case 6:
+		localctx = NewCreateKubectlCommandContext(p, localctx)
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(533)
+			p.Match(KlangParserKUBECTL)
+		}
+		{
+			p.SetState(534)
+			p.Match(KlangParserCREATE)
+		}
+		p.SetState(540)
+		p.GetErrorHandler().Sync(p)
+		_alt = 1
+		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			switch _alt {
+			case 1:
+				p.SetState(540)
+				p.GetErrorHandler().Sync(p)
+
+				switch p.GetTokenStream().LA(1) {
+				case KlangParserNAMESPACE:
+					{
+						p.SetState(535)
+						p.Match(KlangParserNAMESPACE)
+					}
+					{
+						p.SetState(536)
+						p.Ns()
+					}
+
+				case KlangParserID, KlangParserRAW_STRING_LIT, KlangParserSTRING:
+					{
+						p.SetState(537)
+						p.String_or_id()
+					}
+
+				case KlangParserUPDATELOAD:
+					{
+						p.SetState(538)
+						p.Match(KlangParserUPDATELOAD)
+					}
+					{
+						p.SetState(539)
+						p.Kubernetes_object_config()
+					}
+
+				default:
+					panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+				}
+
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
+
+			p.SetState(542)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 36, p.GetParserRuleContext())
+		}
+
+	case 7:
+		localctx = NewReplaceKubectlCommandContext(p, localctx)
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(544)
+			p.Match(KlangParserKUBECTL)
+		}
+		{
+			p.SetState(545)
+			p.Match(KlangParserREPLACE)
+		}
+		p.SetState(551)
+		p.GetErrorHandler().Sync(p)
+		_alt = 1
+		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			switch _alt {
+			case 1:
+				p.SetState(551)
+				p.GetErrorHandler().Sync(p)
+
+				switch p.GetTokenStream().LA(1) {
+				case KlangParserNAMESPACE:
+					{
+						p.SetState(546)
+						p.Match(KlangParserNAMESPACE)
+					}
+					{
+						p.SetState(547)
+						p.Ns()
+					}
+
+				case KlangParserID, KlangParserRAW_STRING_LIT, KlangParserSTRING:
+					{
+						p.SetState(548)
+						p.String_or_id()
+					}
+
+				case KlangParserUPDATELOAD:
+					{
+						p.SetState(549)
+						p.Match(KlangParserUPDATELOAD)
+					}
+					{
+						p.SetState(550)
+						p.Kubernetes_object_config()
+					}
+
+				default:
+					panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+				}
+
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
+
+			p.SetState(553)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 38, p.GetParserRuleContext())
+		}
+
+	case 8:
+		localctx = NewScaleKubectlCommandContext(p, localctx)
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(555)
+			p.Match(KlangParserKUBECTL)
+		}
+		{
+			p.SetState(556)
+			p.Match(KlangParserSCALE)
+		}
+		p.SetState(565)
+		p.GetErrorHandler().Sync(p)
+		_alt = 1
+		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			switch _alt {
+			case 1:
+				p.SetState(565)
+				p.GetErrorHandler().Sync(p)
+
+				switch p.GetTokenStream().LA(1) {
+				case KlangParserNAMESPACE:
+					{
+						p.SetState(557)
+						p.Match(KlangParserNAMESPACE)
+					}
+					{
+						p.SetState(558)
+						p.Ns()
+					}
+
+				case KlangParserID, KlangParserPATH, KlangParserRAW_STRING_LIT, KlangParserSTRING:
+					{
+						p.SetState(559)
+						p.Resource()
+					}
+
+				case KlangParserREPLICAS:
+					{
+						p.SetState(560)
+						p.Match(KlangParserREPLICAS)
+					}
+					p.SetState(562)
+					p.GetErrorHandler().Sync(p)
+					_la = p.GetTokenStream().LA(1)
+
+					if _la == KlangParserASSIGN {
+						{
+							p.SetState(561)
+							p.Match(KlangParserASSIGN)
+						}
+
+					}
+					{
+						p.SetState(564)
+						p.expr(0)
+					}
+
+				default:
+					panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+				}
+
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
+
+			p.SetState(567)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 41, p.GetParserRuleContext())
+		}
+
+	case 9:
+		localctx = NewRolloutKubectlCommandContext(p, localctx)
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(569)
+			p.Match(KlangParserKUBECTL)
+		}
+		{
+			p.SetState(570)
+			p.Match(KlangParserROLLOUT)
+		}
+		{
+			p.SetState(571)
+			p.Match(KlangParserID)
+		}
+		p.SetState(580)
+		p.GetErrorHandler().Sync(p)
+		_alt = 1
+		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			switch _alt {
+			case 1:
+				p.SetState(580)
+				p.GetErrorHandler().Sync(p)
+
+				switch p.GetTokenStream().LA(1) {
+				case KlangParserNAMESPACE:
+					{
+						p.SetState(572)
+						p.Match(KlangParserNAMESPACE)
+					}
+					{
+						p.SetState(573)
+						p.Ns()
+					}
+
+				case KlangParserID, KlangParserPATH, KlangParserRAW_STRING_LIT, KlangParserSTRING:
+					{
+						p.SetState(574)
+						p.Resource()
+					}
+
+				case KlangParserWAITTIMEOUT:
+					{
+						p.SetState(575)
+						p.Match(KlangParserWAITTIMEOUT)
+					}
+					p.SetState(577)
+					p.GetErrorHandler().Sync(p)
+					_la = p.GetTokenStream().LA(1)
+
+					if _la == KlangParserASSIGN {
+						{
+							p.SetState(576)
+							p.Match(KlangParserASSIGN)
+						}
+
+					}
+					{
+						p.SetState(579)
+						p.expr(0)
+					}
+
+				default:
+					panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+				}
+
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
+
+			p.SetState(582)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 44, p.GetParserRuleContext())
+		}
+
+	}
+
+	return localctx
+}
+
+// IDownload_fnContext is an interface to support dynamic dispatch.
+type IDownload_fnContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDownload_fnContext differentiates from other interfaces.
+	IsDownload_fnContext()
+}
+
+type Download_fnContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDownload_fnContext() *Download_fnContext {
+	var p = new(Download_fnContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_download_fn
+	return p
+}
+
+func (*Download_fnContext) IsDownload_fnContext() {}
+
+func NewDownload_fnContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Download_fnContext {
+	var p = new(Download_fnContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KlangParserRULE_download_fn
+
+	return p
+}
+
+func (s *Download_fnContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Download_fnContext) DOWNLOAD() antlr.TerminalNode {
+	return s.GetToken(KlangParserDOWNLOAD, 0)
+}
+
+func (s *Download_fnContext) OPAR() antlr.TerminalNode {
+	return s.GetToken(KlangParserOPAR, 0)
+}
+
+func (s *Download_fnContext) AllString_or_id() []IString_or_idContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IString_or_idContext)(nil)).Elem())
+	var tst = make([]IString_or_idContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IString_or_idContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *Download_fnContext) String_or_id(i int) IString_or_idContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IString_or_idContext)
+}
+
+func (s *Download_fnContext) CPAR() antlr.TerminalNode {
+	return s.GetToken(KlangParserCPAR, 0)
+}
+
+func (s *Download_fnContext) COMMA() antlr.TerminalNode {
+	return s.GetToken(KlangParserCOMMA, 0)
+}
+
+func (s *Download_fnContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Download_fnContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Download_fnContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterDownload_fn(s)
+	}
+}
+
+func (s *Download_fnContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitDownload_fn(s)
+	}
+}
+
+func (p *KlangParser) Download_fn() (localctx IDownload_fnContext) {
+	localctx = NewDownload_fnContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 70, KlangParserRULE_download_fn)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(586)
+		p.Match(KlangParserDOWNLOAD)
+	}
+	{
+		p.SetState(587)
+		p.Match(KlangParserOPAR)
+	}
+	{
+		p.SetState(588)
+		p.String_or_id()
+	}
+	p.SetState(591)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == KlangParserCOMMA {
+		{
+			p.SetState(589)
+			p.Match(KlangParserCOMMA)
+		}
+		{
+			p.SetState(590)
+			p.String_or_id()
+		}
+
+	}
+	{
+		p.SetState(593)
+		p.Match(KlangParserCPAR)
+	}
+
+	return localctx
+}
+
+// IJson_select_fnContext is an interface to support dynamic dispatch.
+type IJson_select_fnContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsJson_select_fnContext differentiates from other interfaces.
+	IsJson_select_fnContext()
+}
+
+type Json_select_fnContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyJson_select_fnContext() *Json_select_fnContext {
+	var p = new(Json_select_fnContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_json_select_fn
+	return p
+}
+
+func (*Json_select_fnContext) IsJson_select_fnContext() {}
+
+func NewJson_select_fnContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Json_select_fnContext {
+	var p = new(Json_select_fnContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KlangParserRULE_json_select_fn
+
+	return p
+}
+
+func (s *Json_select_fnContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Json_select_fnContext) JSONSELECT() antlr.TerminalNode {
+	return s.GetToken(KlangParserJSONSELECT, 0)
+}
+
+func (s *Json_select_fnContext) OPAR() antlr.TerminalNode {
+	return s.GetToken(KlangParserOPAR, 0)
+}
+
+func (s *Json_select_fnContext) ID() antlr.TerminalNode {
+	return s.GetToken(KlangParserID, 0)
+}
+
+func (s *Json_select_fnContext) COMMA() antlr.TerminalNode {
+	return s.GetToken(KlangParserCOMMA, 0)
+}
+
+func (s *Json_select_fnContext) String_or_id() IString_or_idContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IString_or_idContext)
+}
+
+func (s *Json_select_fnContext) CPAR() antlr.TerminalNode {
+	return s.GetToken(KlangParserCPAR, 0)
+}
+
+func (s *Json_select_fnContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Json_select_fnContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Json_select_fnContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterJson_select_fn(s)
+	}
+}
+
+func (s *Json_select_fnContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitJson_select_fn(s)
+	}
+}
+
+func (p *KlangParser) Json_select_fn() (localctx IJson_select_fnContext) {
+	localctx = NewJson_select_fnContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 72, KlangParserRULE_json_select_fn)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(595)
+		p.Match(KlangParserJSONSELECT)
+	}
+	{
+		p.SetState(596)
+		p.Match(KlangParserOPAR)
+	}
+	{
+		p.SetState(597)
+		p.Match(KlangParserID)
+	}
+	{
+		p.SetState(598)
+		p.Match(KlangParserCOMMA)
+	}
+	{
+		p.SetState(599)
+		p.String_or_id()
+	}
+	{
+		p.SetState(600)
+		p.Match(KlangParserCPAR)
+	}
+
+	return localctx
+}
+
+// IYaml_select_fnContext is an interface to support dynamic dispatch.
+type IYaml_select_fnContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsYaml_select_fnContext differentiates from other interfaces.
+	IsYaml_select_fnContext()
+}
+
+type Yaml_select_fnContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyYaml_select_fnContext() *Yaml_select_fnContext {
+	var p = new(Yaml_select_fnContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_yaml_select_fn
+	return p
+}
+
+func (*Yaml_select_fnContext) IsYaml_select_fnContext() {}
+
+func NewYaml_select_fnContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Yaml_select_fnContext {
+	var p = new(Yaml_select_fnContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KlangParserRULE_yaml_select_fn
+
+	return p
+}
+
+func (s *Yaml_select_fnContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Yaml_select_fnContext) YAMLSELECT() antlr.TerminalNode {
+	return s.GetToken(KlangParserYAMLSELECT, 0)
+}
+
+func (s *Yaml_select_fnContext) OPAR() antlr.TerminalNode {
+	return s.GetToken(KlangParserOPAR, 0)
+}
+
+func (s *Yaml_select_fnContext) ID() antlr.TerminalNode {
+	return s.GetToken(KlangParserID, 0)
+}
+
+func (s *Yaml_select_fnContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(KlangParserCOMMA)
+}
+
+func (s *Yaml_select_fnContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(KlangParserCOMMA, i)
+}
+
+func (s *Yaml_select_fnContext) String_or_id() IString_or_idContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IString_or_idContext)
+}
+
+func (s *Yaml_select_fnContext) CPAR() antlr.TerminalNode {
+	return s.GetToken(KlangParserCPAR, 0)
+}
+
+func (s *Yaml_select_fnContext) NUMBER() antlr.TerminalNode {
+	return s.GetToken(KlangParserNUMBER, 0)
+}
+
+func (s *Yaml_select_fnContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Yaml_select_fnContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Yaml_select_fnContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterYaml_select_fn(s)
+	}
+}
+
+func (s *Yaml_select_fnContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitYaml_select_fn(s)
+	}
+}
+
+func (p *KlangParser) Yaml_select_fn() (localctx IYaml_select_fnContext) {
+	localctx = NewYaml_select_fnContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, KlangParserRULE_yaml_select_fn)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(602)
+		p.Match(KlangParserYAMLSELECT)
+	}
+	{
+		p.SetState(603)
+		p.Match(KlangParserOPAR)
+	}
+	{
+		p.SetState(604)
+		p.Match(KlangParserID)
+	}
+	{
+		p.SetState(605)
+		p.Match(KlangParserCOMMA)
+	}
+	{
+		p.SetState(606)
+		p.String_or_id()
+	}
+	p.SetState(609)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == KlangParserCOMMA {
+		{
+			p.SetState(607)
+			p.Match(KlangParserCOMMA)
+		}
+		{
+			p.SetState(608)
+			p.Match(KlangParserNUMBER)
+		}
+
+	}
+	{
+		p.SetState(611)
+		p.Match(KlangParserCPAR)
+	}
+
+	return localctx
+}
+
+// ILoad_fnContext is an interface to support dynamic dispatch.
+type ILoad_fnContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsLoad_fnContext differentiates from other interfaces.
+	IsLoad_fnContext()
+}
+
+type Load_fnContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyLoad_fnContext() *Load_fnContext {
+	var p = new(Load_fnContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_load_fn
+	return p
+}
+
+func (*Load_fnContext) IsLoad_fnContext() {}
+
+func NewLoad_fnContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Load_fnContext {
+	var p = new(Load_fnContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KlangParserRULE_load_fn
+
+	return p
+}
+
+func (s *Load_fnContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Load_fnContext) LOAD() antlr.TerminalNode {
+	return s.GetToken(KlangParserLOAD, 0)
+}
+
+func (s *Load_fnContext) OPAR() antlr.TerminalNode {
+	return s.GetToken(KlangParserOPAR, 0)
+}
+
+func (s *Load_fnContext) String_or_id() IString_or_idContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IString_or_idContext)
+}
+
+func (s *Load_fnContext) CPAR() antlr.TerminalNode {
+	return s.GetToken(KlangParserCPAR, 0)
+}
+
+func (s *Load_fnContext) COMMA() antlr.TerminalNode {
+	return s.GetToken(KlangParserCOMMA, 0)
+}
+
+func (s *Load_fnContext) STRING() antlr.TerminalNode {
+	return s.GetToken(KlangParserSTRING, 0)
+}
+
+func (s *Load_fnContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Load_fnContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Load_fnContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterLoad_fn(s)
+	}
+}
+
+func (s *Load_fnContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitLoad_fn(s)
+	}
+}
+
+func (p *KlangParser) Load_fn() (localctx ILoad_fnContext) {
+	localctx = NewLoad_fnContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 76, KlangParserRULE_load_fn)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(613)
+		p.Match(KlangParserLOAD)
+	}
+	{
+		p.SetState(614)
+		p.Match(KlangParserOPAR)
+	}
+	{
+		p.SetState(615)
+		p.String_or_id()
+	}
+	p.SetState(618)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == KlangParserCOMMA {
+		{
+			p.SetState(616)
+			p.Match(KlangParserCOMMA)
+		}
+		{
+			p.SetState(617)
+			p.Match(KlangParserSTRING)
+		}
+
+	}
+	{
+		p.SetState(620)
+		p.Match(KlangParserCPAR)
+	}
+
+	return localctx
+}
+
+// IStepInfoContext is an interface to support dynamic dispatch.
+type IStepInfoContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsStepInfoContext differentiates from other interfaces.
+	IsStepInfoContext()
+}
+
+type StepInfoContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyStepInfoContext() *StepInfoContext {
+	var p = new(StepInfoContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_stepInfo
+	return p
+}
+
+func (*StepInfoContext) IsStepInfoContext() {}
+
+func NewStepInfoContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StepInfoContext {
+	var p = new(StepInfoContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KlangParserRULE_stepInfo
+
+	return p
+}
+
+func (s *StepInfoContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *StepInfoContext) STEPINFO() antlr.TerminalNode {
+	return s.GetToken(KlangParserSTEPINFO, 0)
+}
+
+func (s *StepInfoContext) STRING() antlr.TerminalNode {
+	return s.GetToken(KlangParserSTRING, 0)
+}
+
+func (s *StepInfoContext) SCOL() antlr.TerminalNode {
+	return s.GetToken(KlangParserSCOL, 0)
+}
+
+func (s *StepInfoContext) RAW_STRING_LIT() antlr.TerminalNode {
+	return s.GetToken(KlangParserRAW_STRING_LIT, 0)
+}
+
+func (s *StepInfoContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StepInfoContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *StepInfoContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterStepInfo(s)
+	}
+}
+
+func (s *StepInfoContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitStepInfo(s)
+	}
+}
+
+func (p *KlangParser) StepInfo() (localctx IStepInfoContext) {
+	localctx = NewStepInfoContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 78, KlangParserRULE_stepInfo)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(628)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 49, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(622)
+			p.Match(KlangParserSTEPINFO)
+		}
+		{
+			p.SetState(623)
+			p.Match(KlangParserSTRING)
+		}
+		{
+			p.SetState(624)
+			p.Match(KlangParserSCOL)
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(625)
+			p.Match(KlangParserSTEPINFO)
+		}
+		{
+			p.SetState(626)
+			p.Match(KlangParserRAW_STRING_LIT)
+		}
+		{
+			p.SetState(627)
+			p.Match(KlangParserSCOL)
+		}
+
+	}
+
+	return localctx
+}
+
+// INsContext is an interface to support dynamic dispatch.
+type INsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsNsContext differentiates from other interfaces.
+	IsNsContext()
+}
+
+type NsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyNsContext() *NsContext {
+	var p = new(NsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_ns
+	return p
+}
+
+func (*NsContext) IsNsContext() {}
+
+func NewNsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NsContext {
+	var p = new(NsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KlangParserRULE_ns
+
+	return p
+}
+
+func (s *NsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *NsContext) String_or_id() IString_or_idContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IString_or_idContext)
+}
+
+func (s *NsContext) PATH() antlr.TerminalNode {
+	return s.GetToken(KlangParserPATH, 0)
+}
+
+func (s *NsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *NsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterNs(s)
+	}
+}
+
+func (s *NsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitNs(s)
+	}
+}
+
+func (p *KlangParser) Ns() (localctx INsContext) {
+	localctx = NewNsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 80, KlangParserRULE_ns)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(632)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case KlangParserID, KlangParserRAW_STRING_LIT, KlangParserSTRING:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(630)
+			p.String_or_id()
+		}
+
+	case KlangParserPATH:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(631)
+			p.Match(KlangParserPATH)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IOutputContext is an interface to support dynamic dispatch.
+type IOutputContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsOutputContext differentiates from other interfaces.
+	IsOutputContext()
+}
+
+type OutputContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyOutputContext() *OutputContext {
+	var p = new(OutputContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_output
+	return p
+}
+
+func (*OutputContext) IsOutputContext() {}
+
+func NewOutputContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OutputContext {
+	var p = new(OutputContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KlangParserRULE_output
+
+	return p
+}
+
+func (s *OutputContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *OutputContext) OUTPUTFORMAT() antlr.TerminalNode {
+	return s.GetToken(KlangParserOUTPUTFORMAT, 0)
+}
+
+func (s *OutputContext) OUTPUT() antlr.TerminalNode {
+	return s.GetToken(KlangParserOUTPUT, 0)
+}
+
+func (s *OutputContext) String_or_id() IString_or_idContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IString_or_idContext)
+}
+
+func (s *OutputContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(KlangParserASSIGN, 0)
+}
+
+func (s *OutputContext) JSONPATH() antlr.TerminalNode {
+	return s.GetToken(KlangParserJSONPATH, 0)
+}
+
+func (s *OutputContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *OutputContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *OutputContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterOutput(s)
+	}
+}
+
+func (s *OutputContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitOutput(s)
+	}
+}
+
+func (p *KlangParser) Output() (localctx IOutputContext) {
+	localctx = NewOutputContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 82, KlangParserRULE_output)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(645)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case KlangParserOUTPUTFORMAT:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(634)
+			p.Match(KlangParserOUTPUTFORMAT)
+		}
+
+	case KlangParserOUTPUT:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(635)
+			p.Match(KlangParserOUTPUT)
+		}
+		p.SetState(637)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == KlangParserASSIGN {
+			{
+				p.SetState(636)
+				p.Match(KlangParserASSIGN)
+			}
+
+		}
+		{
+			p.SetState(639)
+			p.String_or_id()
+		}
+
+	case KlangParserJSONPATH:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(640)
+			p.Match(KlangParserJSONPATH)
+		}
+		p.SetState(642)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == KlangParserASSIGN {
+			{
+				p.SetState(641)
+				p.Match(KlangParserASSIGN)
+			}
+
+		}
+		{
+			p.SetState(644)
+			p.String_or_id()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// ISelectorContext is an interface to support dynamic dispatch.
+type ISelectorContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSelectorContext differentiates from other interfaces.
+	IsSelectorContext()
+}
+
+type SelectorContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySelectorContext() *SelectorContext {
+	var p = new(SelectorContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_selector
+	return p
+}
+
+func (*SelectorContext) IsSelectorContext() {}
+
+func NewSelectorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectorContext {
+	var p = new(SelectorContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KlangParserRULE_selector
+
+	return p
+}
+
+func (s *SelectorContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SelectorContext) LABELSELECTOR() antlr.TerminalNode {
+	return s.GetToken(KlangParserLABELSELECTOR, 0)
+}
+
+func (s *SelectorContext) FIELDSELECTOR() antlr.TerminalNode {
+	return s.GetToken(KlangParserFIELDSELECTOR, 0)
+}
+
+func (s *SelectorContext) SELECTOR() antlr.TerminalNode {
+	return s.GetToken(KlangParserSELECTOR, 0)
+}
+
+func (s *SelectorContext) String_or_id() IString_or_idContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IString_or_idContext)
+}
+
+func (s *SelectorContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(KlangParserASSIGN, 0)
+}
+
+func (s *SelectorContext) FIELDSELECTORFLAG() antlr.TerminalNode {
+	return s.GetToken(KlangParserFIELDSELECTORFLAG, 0)
+}
+
+func (s *SelectorContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SelectorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SelectorContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterSelector(s)
+	}
+}
+
+func (s *SelectorContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitSelector(s)
+	}
+}
+
+func (p *KlangParser) Selector() (localctx ISelectorContext) {
+	localctx = NewSelectorContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 84, KlangParserRULE_selector)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(659)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case KlangParserLABELSELECTOR:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(647)
+			p.Match(KlangParserLABELSELECTOR)
+		}
+
+	case KlangParserFIELDSELECTOR:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(648)
+			p.Match(KlangParserFIELDSELECTOR)
+		}
+
+	case KlangParserSELECTOR:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(649)
+			p.Match(KlangParserSELECTOR)
+		}
+		p.SetState(651)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == KlangParserASSIGN {
+			{
+				p.SetState(650)
+				p.Match(KlangParserASSIGN)
+			}
+
+		}
+		{
+			p.SetState(653)
+			p.String_or_id()
+		}
+
+	case KlangParserFIELDSELECTORFLAG:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(654)
+			p.Match(KlangParserFIELDSELECTORFLAG)
+		}
+		p.SetState(656)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == KlangParserASSIGN {
+			{
+				p.SetState(655)
+				p.Match(KlangParserASSIGN)
+			}
+
+		}
+		{
+			p.SetState(658)
+			p.String_or_id()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IAsObjectContext is an interface to support dynamic dispatch.
+type IAsObjectContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsAsObjectContext differentiates from other interfaces.
+	IsAsObjectContext()
+}
+
+type AsObjectContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAsObjectContext() *AsObjectContext {
+	var p = new(AsObjectContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_asObject
+	return p
+}
+
+func (*AsObjectContext) IsAsObjectContext() {}
+
+func NewAsObjectContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AsObjectContext {
+	var p = new(AsObjectContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KlangParserRULE_asObject
+
+	return p
+}
+
+func (s *AsObjectContext) GetParser() antlr.Parser { return s.parser }
+func (s *AsObjectContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AsObjectContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AsObjectContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterAsObject(s)
+	}
+}
+
+func (s *AsObjectContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitAsObject(s)
+	}
+}
+
+func (p *KlangParser) AsObject() (localctx IAsObjectContext) {
+	localctx = NewAsObjectContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 86, KlangParserRULE_asObject)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(661)
+		p.Match(KlangParserT__0)
+	}
+
+	return localctx
+}
+
+// IPatch_typeContext is an interface to support dynamic dispatch.
+type IPatch_typeContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsPatch_typeContext differentiates from other interfaces.
+	IsPatch_typeContext()
+}
+
+type Patch_typeContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPatch_typeContext() *Patch_typeContext {
+	var p = new(Patch_typeContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_patch_type
+	return p
+}
+
+func (*Patch_typeContext) IsPatch_typeContext() {}
+
+func NewPatch_typeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Patch_typeContext {
+	var p = new(Patch_typeContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KlangParserRULE_patch_type
+
+	return p
+}
+
+func (s *Patch_typeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Patch_typeContext) PATH() antlr.TerminalNode {
+	return s.GetToken(KlangParserPATH, 0)
+}
+
+func (s *Patch_typeContext) String_or_id() IString_or_idContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IString_or_idContext)
+}
+
+func (s *Patch_typeContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Patch_typeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Patch_typeContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterPatch_type(s)
+	}
+}
+
+func (s *Patch_typeContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitPatch_type(s)
+	}
+}
+
+func (p *KlangParser) Patch_type() (localctx IPatch_typeContext) {
+	localctx = NewPatch_typeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 88, KlangParserRULE_patch_type)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(665)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case KlangParserPATH:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(663)
+			p.Match(KlangParserPATH)
+		}
+
+	case KlangParserID, KlangParserRAW_STRING_LIT, KlangParserSTRING:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(664)
+			p.String_or_id()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IString_or_idContext is an interface to support dynamic dispatch.
+type IString_or_idContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsString_or_idContext differentiates from other interfaces.
+	IsString_or_idContext()
+}
+
+type String_or_idContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyString_or_idContext() *String_or_idContext {
+	var p = new(String_or_idContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_string_or_id
+	return p
+}
+
+func (*String_or_idContext) IsString_or_idContext() {}
+
+func NewString_or_idContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *String_or_idContext {
+	var p = new(String_or_idContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KlangParserRULE_string_or_id
+
+	return p
+}
+
+func (s *String_or_idContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *String_or_idContext) ID() antlr.TerminalNode {
+	return s.GetToken(KlangParserID, 0)
+}
+
+func (s *String_or_idContext) STRING() antlr.TerminalNode {
+	return s.GetToken(KlangParserSTRING, 0)
+}
+
+func (s *String_or_idContext) RAW_STRING_LIT() antlr.TerminalNode {
+	return s.GetToken(KlangParserRAW_STRING_LIT, 0)
+}
+
+func (s *String_or_idContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *String_or_idContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *String_or_idContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterString_or_id(s)
+	}
+}
+
+func (s *String_or_idContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitString_or_id(s)
+	}
+}
+
+func (p *KlangParser) String_or_id() (localctx IString_or_idContext) {
+	localctx = NewString_or_idContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 90, KlangParserRULE_string_or_id)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(667)
+	_la = p.GetTokenStream().LA(1)
+
+	if !(((_la-96)&-(0x1f+1)) == 0 && ((1<<uint((_la-96)))&((1<<(KlangParserID-96))|(1<<(KlangParserRAW_STRING_LIT-96))|(1<<(KlangParserSTRING-96)))) != 0) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
+	}
+
+	return localctx
+}
+
+// IResourceContext is an interface to support dynamic dispatch.
+type IResourceContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsResourceContext differentiates from other interfaces.
+	IsResourceContext()
+}
+
+type ResourceContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyResourceContext() *ResourceContext {
+	var p = new(ResourceContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_resource
+	return p
+}
+
+func (*ResourceContext) IsResourceContext() {}
+
+func NewResourceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ResourceContext {
+	var p = new(ResourceContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KlangParserRULE_resource
+
+	return p
+}
+
+func (s *ResourceContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ResourceContext) PATH() antlr.TerminalNode {
+	return s.GetToken(KlangParserPATH, 0)
+}
+
+func (s *ResourceContext) String_or_id() IString_or_idContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IString_or_idContext)
+}
+
+func (s *ResourceContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ResourceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ResourceContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterResource(s)
+	}
+}
+
+func (s *ResourceContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitResource(s)
+	}
+}
+
+func (p *KlangParser) Resource() (localctx IResourceContext) {
+	localctx = NewResourceContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 92, KlangParserRULE_resource)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(671)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case KlangParserPATH:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(669)
+			p.Match(KlangParserPATH)
+		}
+
+	case KlangParserID, KlangParserRAW_STRING_LIT, KlangParserSTRING:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(670)
+			p.String_or_id()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IKubernetes_object_configContext is an interface to support dynamic dispatch.
+type IKubernetes_object_configContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsKubernetes_object_configContext differentiates from other interfaces.
+	IsKubernetes_object_configContext()
+}
+
+type Kubernetes_object_configContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyKubernetes_object_configContext() *Kubernetes_object_configContext {
+	var p = new(Kubernetes_object_configContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_kubernetes_object_config
+	return p
+}
+
+func (*Kubernetes_object_configContext) IsKubernetes_object_configContext() {}
+
+func NewKubernetes_object_configContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Kubernetes_object_configContext {
+	var p = new(Kubernetes_object_configContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KlangParserRULE_kubernetes_object_config
+
+	return p
+}
+
+func (s *Kubernetes_object_configContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Kubernetes_object_configContext) String_or_id() IString_or_idContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IString_or_idContext)
+}
+
+func (s *Kubernetes_object_configContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Kubernetes_object_configContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Kubernetes_object_configContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterKubernetes_object_config(s)
+	}
+}
+
+func (s *Kubernetes_object_configContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitKubernetes_object_config(s)
+	}
+}
+
+func (p *KlangParser) Kubernetes_object_config() (localctx IKubernetes_object_configContext) {
+	localctx = NewKubernetes_object_configContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 94, KlangParserRULE_kubernetes_object_config)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(673)
+		p.String_or_id()
+	}
+
+	return localctx
+}
+
+// IFilterContext is an interface to support dynamic dispatch.
+type IFilterContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsFilterContext differentiates from other interfaces.
+	IsFilterContext()
+}
+
+type FilterContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFilterContext() *FilterContext {
+	var p = new(FilterContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_filter
+	return p
+}
+
+func (*FilterContext) IsFilterContext() {}
+
+func NewFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FilterContext {
+	var p = new(FilterContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KlangParserRULE_filter
+
+	return p
+}
+
+func (s *FilterContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FilterContext) FILTER() antlr.TerminalNode {
+	return s.GetToken(KlangParserFILTER, 0)
+}
+
+func (s *FilterContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(KlangParserASSIGN, 0)
+}
+
+func (s *FilterContext) String_or_id() IString_or_idContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IString_or_idContext)
+}
+
+func (s *FilterContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FilterContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterFilter(s)
+	}
+}
+
+func (s *FilterContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitFilter(s)
+	}
+}
+
+func (p *KlangParser) Filter() (localctx IFilterContext) {
+	localctx = NewFilterContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 96, KlangParserRULE_filter)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(675)
+		p.Match(KlangParserFILTER)
+	}
+	{
+		p.SetState(676)
+		p.Match(KlangParserASSIGN)
+	}
+	{
+		p.SetState(677)
+		p.String_or_id()
+	}
+
+	return localctx
+}
+
+// IPatternContext is an interface to support dynamic dispatch.
+type IPatternContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsPatternContext differentiates from other interfaces.
+	IsPatternContext()
+}
+
+type PatternContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPatternContext() *PatternContext {
+	var p = new(PatternContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_pattern
+	return p
+}
+
+func (*PatternContext) IsPatternContext() {}
+
+func NewPatternContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PatternContext {
+	var p = new(PatternContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KlangParserRULE_pattern
+
+	return p
+}
+
+func (s *PatternContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PatternContext) PATTERN() antlr.TerminalNode {
+	return s.GetToken(KlangParserPATTERN, 0)
+}
+
+func (s *PatternContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(KlangParserASSIGN, 0)
+}
+
+func (s *PatternContext) String_or_id() IString_or_idContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IString_or_idContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IString_or_idContext)
+}
+
+func (s *PatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PatternContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *PatternContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterPattern(s)
+	}
+}
+
+func (s *PatternContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitPattern(s)
+	}
+}
+
+func (p *KlangParser) Pattern() (localctx IPatternContext) {
+	localctx = NewPatternContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 98, KlangParserRULE_pattern)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(679)
+		p.Match(KlangParserPATTERN)
+	}
+	{
+		p.SetState(680)
+		p.Match(KlangParserASSIGN)
+	}
+	{
+		p.SetState(681)
+		p.String_or_id()
+	}
+
+	return localctx
+}
+
+// IExprContext is an interface to support dynamic dispatch.
+type IExprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsExprContext differentiates from other interfaces.
+	IsExprContext()
+}
+
+type ExprContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExprContext() *ExprContext {
+	var p = new(ExprContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_expr
+	return p
+}
+
+func (*ExprContext) IsExprContext() {}
+
+func NewExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprContext {
+	var p = new(ExprContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KlangParserRULE_expr
+
+	return p
+}
+
+func (s *ExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExprContext) CopyFrom(ctx *ExprContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
+}
+
+func (s *ExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type JsonSelectFnContext struct {
+	*ExprContext
+}
+
+func NewJsonSelectFnContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *JsonSelectFnContext {
+	var p = new(JsonSelectFnContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *JsonSelectFnContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *JsonSelectFnContext) Json_select_fn() IJson_select_fnContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IJson_select_fnContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IJson_select_fnContext)
+}
+
+func (s *JsonSelectFnContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterJsonSelectFn(s)
+	}
+}
+
+func (s *JsonSelectFnContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitJsonSelectFn(s)
+	}
+}
+
+type ShellScriptContext struct {
+	*ExprContext
+}
+
+func NewShellScriptContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ShellScriptContext {
+	var p = new(ShellScriptContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *ShellScriptContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShellScriptContext) Shell_script() IShell_scriptContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IShell_scriptContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShell_scriptContext)
+}
+
+func (s *ShellScriptContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterShellScript(s)
+	}
+}
+
+func (s *ShellScriptContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitShellScript(s)
+	}
+}
+
+type MemberExprContext struct {
+	*ExprContext
+}
+
+func NewMemberExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MemberExprContext {
+	var p = new(MemberExprContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *MemberExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MemberExprContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *MemberExprContext) DOT() antlr.TerminalNode {
+	return s.GetToken(KlangParserDOT, 0)
+}
+
+func (s *MemberExprContext) ID() antlr.TerminalNode {
+	return s.GetToken(KlangParserID, 0)
+}
+
+func (s *MemberExprContext) PATH() antlr.TerminalNode {
+	return s.GetToken(KlangParserPATH, 0)
+}
+
+func (s *MemberExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterMemberExpr(s)
+	}
+}
+
+func (s *MemberExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitMemberExpr(s)
+	}
+}
+
+type YamlSelectFnContext struct {
+	*ExprContext
+}
+
+func NewYamlSelectFnContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *YamlSelectFnContext {
+	var p = new(YamlSelectFnContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *YamlSelectFnContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *YamlSelectFnContext) Yaml_select_fn() IYaml_select_fnContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IYaml_select_fnContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IYaml_select_fnContext)
+}
+
+func (s *YamlSelectFnContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterYamlSelectFn(s)
+	}
+}
+
+func (s *YamlSelectFnContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitYamlSelectFn(s)
+	}
+}
+
+type AtomExprContext struct {
+	*ExprContext
+}
+
+func NewAtomExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AtomExprContext {
+	var p = new(AtomExprContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *AtomExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AtomExprContext) Atom() IAtomContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAtomContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAtomContext)
+}
+
+func (s *AtomExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterAtomExpr(s)
+	}
+}
+
+func (s *AtomExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitAtomExpr(s)
+	}
+}
+
+type OrExprContext struct {
+	*ExprContext
+}
+
+func NewOrExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *OrExprContext {
+	var p = new(OrExprContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *OrExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *OrExprContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *OrExprContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *OrExprContext) OR() antlr.TerminalNode {
+	return s.GetToken(KlangParserOR, 0)
+}
+
+func (s *OrExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterOrExpr(s)
+	}
+}
+
+func (s *OrExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitOrExpr(s)
+	}
+}
+
+type FuncCallExprContext struct {
+	*ExprContext
+}
+
+func NewFuncCallExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *FuncCallExprContext {
+	var p = new(FuncCallExprContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *FuncCallExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FuncCallExprContext) Func_call() IFunc_callContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunc_callContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunc_callContext)
+}
+
+func (s *FuncCallExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterFuncCallExpr(s)
+	}
+}
+
+func (s *FuncCallExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitFuncCallExpr(s)
+	}
+}
+
+type AdditiveExprContext struct {
+	*ExprContext
+	op antlr.Token
+}
+
+func NewAdditiveExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AdditiveExprContext {
+	var p = new(AdditiveExprContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *AdditiveExprContext) GetOp() antlr.Token { return s.op }
+
+func (s *AdditiveExprContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *AdditiveExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AdditiveExprContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *AdditiveExprContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *AdditiveExprContext) PLUS() antlr.TerminalNode {
+	return s.GetToken(KlangParserPLUS, 0)
+}
+
+func (s *AdditiveExprContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(KlangParserMINUS, 0)
+}
+
+func (s *AdditiveExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterAdditiveExpr(s)
+	}
+}
+
+func (s *AdditiveExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitAdditiveExpr(s)
+	}
+}
+
+type RelationalExprContext struct {
+	*ExprContext
+	op antlr.Token
+}
+
+func NewRelationalExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *RelationalExprContext {
+	var p = new(RelationalExprContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *RelationalExprContext) GetOp() antlr.Token { return s.op }
+
+func (s *RelationalExprContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *RelationalExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RelationalExprContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *RelationalExprContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *RelationalExprContext) LTEQ() antlr.TerminalNode {
+	return s.GetToken(KlangParserLTEQ, 0)
+}
+
+func (s *RelationalExprContext) GTEQ() antlr.TerminalNode {
+	return s.GetToken(KlangParserGTEQ, 0)
+}
+
+func (s *RelationalExprContext) LT() antlr.TerminalNode {
+	return s.GetToken(KlangParserLT, 0)
+}
+
+func (s *RelationalExprContext) GT() antlr.TerminalNode {
+	return s.GetToken(KlangParserGT, 0)
+}
+
+func (s *RelationalExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterRelationalExpr(s)
+	}
+}
+
+func (s *RelationalExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitRelationalExpr(s)
+	}
+}
+
+type IndexExprContext struct {
+	*ExprContext
+}
+
+func NewIndexExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IndexExprContext {
+	var p = new(IndexExprContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *IndexExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *IndexExprContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *IndexExprContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *IndexExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterIndexExpr(s)
+	}
+}
+
+func (s *IndexExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitIndexExpr(s)
+	}
+}
+
+type NotExprContext struct {
+	*ExprContext
+}
+
+func NewNotExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NotExprContext {
+	var p = new(NotExprContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *NotExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NotExprContext) NOT() antlr.TerminalNode {
+	return s.GetToken(KlangParserNOT, 0)
+}
+
+func (s *NotExprContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *NotExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterNotExpr(s)
+	}
+}
+
+func (s *NotExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitNotExpr(s)
+	}
+}
+
+type UnaryMinusExprContext struct {
+	*ExprContext
+}
+
+func NewUnaryMinusExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *UnaryMinusExprContext {
+	var p = new(UnaryMinusExprContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *UnaryMinusExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *UnaryMinusExprContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(KlangParserMINUS, 0)
+}
+
+func (s *UnaryMinusExprContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *UnaryMinusExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterUnaryMinusExpr(s)
+	}
+}
+
+func (s *UnaryMinusExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitUnaryMinusExpr(s)
+	}
+}
+
+type MultiplicationExprContext struct {
+	*ExprContext
+	op antlr.Token
+}
+
+func NewMultiplicationExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MultiplicationExprContext {
+	var p = new(MultiplicationExprContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *MultiplicationExprContext) GetOp() antlr.Token { return s.op }
+
+func (s *MultiplicationExprContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *MultiplicationExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MultiplicationExprContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *MultiplicationExprContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *MultiplicationExprContext) MULT() antlr.TerminalNode {
+	return s.GetToken(KlangParserMULT, 0)
+}
+
+func (s *MultiplicationExprContext) DIV() antlr.TerminalNode {
+	return s.GetToken(KlangParserDIV, 0)
+}
+
+func (s *MultiplicationExprContext) MOD() antlr.TerminalNode {
+	return s.GetToken(KlangParserMOD, 0)
+}
+
+func (s *MultiplicationExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterMultiplicationExpr(s)
+	}
+}
+
+func (s *MultiplicationExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitMultiplicationExpr(s)
+	}
+}
+
+type DownloadFnContext struct {
+	*ExprContext
+}
+
+func NewDownloadFnContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *DownloadFnContext {
+	var p = new(DownloadFnContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *DownloadFnContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DownloadFnContext) Download_fn() IDownload_fnContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDownload_fnContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDownload_fnContext)
+}
+
+func (s *DownloadFnContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterDownloadFn(s)
+	}
+}
+
+func (s *DownloadFnContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitDownloadFn(s)
+	}
+}
+
+type PowExprContext struct {
+	*ExprContext
+}
+
+func NewPowExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PowExprContext {
+	var p = new(PowExprContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *PowExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PowExprContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *PowExprContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *PowExprContext) POW() antlr.TerminalNode {
+	return s.GetToken(KlangParserPOW, 0)
+}
+
+func (s *PowExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterPowExpr(s)
+	}
+}
+
+func (s *PowExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitPowExpr(s)
+	}
+}
+
+type KubectlExprContext struct {
+	*ExprContext
+}
+
+func NewKubectlExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *KubectlExprContext {
+	var p = new(KubectlExprContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *KubectlExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *KubectlExprContext) Kubectl_command() IKubectl_commandContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IKubectl_commandContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IKubectl_commandContext)
+}
+
+func (s *KubectlExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterKubectlExpr(s)
+	}
+}
+
+func (s *KubectlExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitKubectlExpr(s)
+	}
+}
+
+type EqualityExprContext struct {
+	*ExprContext
+	op antlr.Token
+}
+
+func NewEqualityExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *EqualityExprContext {
+	var p = new(EqualityExprContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *EqualityExprContext) GetOp() antlr.Token { return s.op }
+
+func (s *EqualityExprContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *EqualityExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *EqualityExprContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *EqualityExprContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *EqualityExprContext) EQ() antlr.TerminalNode {
+	return s.GetToken(KlangParserEQ, 0)
+}
+
+func (s *EqualityExprContext) NEQ() antlr.TerminalNode {
+	return s.GetToken(KlangParserNEQ, 0)
+}
+
+func (s *EqualityExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterEqualityExpr(s)
+	}
+}
+
+func (s *EqualityExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitEqualityExpr(s)
+	}
+}
+
+type AndExprContext struct {
+	*ExprContext
+}
+
+func NewAndExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AndExprContext {
+	var p = new(AndExprContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *AndExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AndExprContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *AndExprContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *AndExprContext) AND() antlr.TerminalNode {
+	return s.GetToken(KlangParserAND, 0)
+}
+
+func (s *AndExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterAndExpr(s)
+	}
+}
+
+func (s *AndExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitAndExpr(s)
+	}
+}
+
+func (p *KlangParser) Expr() (localctx IExprContext) {
+	return p.expr(0)
+}
+
+func (p *KlangParser) expr(_p int) (localctx IExprContext) {
+	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
+	_parentState := p.GetState()
+	localctx = NewExprContext(p, p.GetParserRuleContext(), _parentState)
+	var _prevctx IExprContext = localctx
+	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
+	_startState := 100
+	p.EnterRecursionRule(localctx, 100, KlangParserRULE_expr, _p)
+	var _la int
+
+	defer func() {
+		p.UnrollRecursionContexts(_parentctx)
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(695)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext()) {
+	case 1:
+		localctx = NewUnaryMinusExprContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+
+		{
+			p.SetState(684)
+			p.Match(KlangParserMINUS)
+		}
+		{
+			p.SetState(685)
+			p.expr(15)
+		}
+
+	case 2:
+		localctx = NewNotExprContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(686)
+			p.Match(KlangParserNOT)
+		}
+		{
+			p.SetState(687)
+			p.expr(14)
+		}
+
+	case 3:
+		localctx = NewKubectlExprContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(688)
+			p.Kubectl_command()
+		}
+
+	case 4:
+		localctx = NewJsonSelectFnContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(689)
+			p.Json_select_fn()
+		}
+
+	case 5:
+		localctx = NewYamlSelectFnContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(690)
+			p.Yaml_select_fn()
+		}
+
+	case 6:
+		localctx = NewShellScriptContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(691)
+			p.Shell_script()
+		}
+
+	case 7:
+		localctx = NewDownloadFnContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(692)
+			p.Download_fn()
+		}
+
+	case 8:
+		localctx = NewFuncCallExprContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(693)
+			p.Func_call()
+		}
+
+	case 9:
+		localctx = NewAtomExprContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(694)
+			p.Atom()
+		}
+
+	}
+	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
+	p.SetState(728)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 61, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			if p.GetParseListeners() != nil {
+				p.TriggerExitRuleEvent()
+			}
+			_prevctx = localctx
+			p.SetState(726)
+			p.GetErrorHandler().Sync(p)
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 60, p.GetParserRuleContext()) {
+			case 1:
+				localctx = NewPowExprContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, KlangParserRULE_expr)
+				p.SetState(697)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 16)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 16)", ""))
+				}
+				{
+					p.SetState(698)
+					p.Match(KlangParserPOW)
+				}
+				{
+					p.SetState(699)
+					p.expr(16)
+				}
+
+			case 2:
+				localctx = NewMultiplicationExprContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, KlangParserRULE_expr)
+				p.SetState(700)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 13)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 13)", ""))
+				}
+				p.SetState(701)
+
+				var _lt = p.GetTokenStream().LT(1)
+
+				localctx.(*MultiplicationExprContext).op = _lt
+
+				_la = p.GetTokenStream().LA(1)
+
+				if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<KlangParserMULT)|(1<<KlangParserDIV)|(1<<KlangParserMOD))) != 0) {
+					var _ri = p.GetErrorHandler().RecoverInline(p)
+
+					localctx.(*MultiplicationExprContext).op = _ri
+				} else {
+					p.GetErrorHandler().ReportMatch(p)
+					p.Consume()
+				}
+				{
+					p.SetState(702)
+					p.expr(14)
+				}
+
+			case 3:
+				localctx = NewAdditiveExprContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, KlangParserRULE_expr)
+				p.SetState(703)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 12)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 12)", ""))
+				}
+				p.SetState(704)
+
+				var _lt = p.GetTokenStream().LT(1)
+
+				localctx.(*AdditiveExprContext).op = _lt
+
+				_la = p.GetTokenStream().LA(1)
+
+				if !(_la == KlangParserPLUS || _la == KlangParserMINUS) {
+					var _ri = p.GetErrorHandler().RecoverInline(p)
+
+					localctx.(*AdditiveExprContext).op = _ri
+				} else {
+					p.GetErrorHandler().ReportMatch(p)
+					p.Consume()
+				}
+				{
+					p.SetState(705)
+					p.expr(13)
+				}
+
+			case 4:
+				localctx = NewRelationalExprContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, KlangParserRULE_expr)
+				p.SetState(706)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 11)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 11)", ""))
+				}
+				p.SetState(707)
+
+				var _lt = p.GetTokenStream().LT(1)
+
+				localctx.(*RelationalExprContext).op = _lt
+
+				_la = p.GetTokenStream().LA(1)
+
+				if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<KlangParserGT)|(1<<KlangParserLT)|(1<<KlangParserGTEQ)|(1<<KlangParserLTEQ))) != 0) {
+					var _ri = p.GetErrorHandler().RecoverInline(p)
+
+					localctx.(*RelationalExprContext).op = _ri
+				} else {
+					p.GetErrorHandler().ReportMatch(p)
+					p.Consume()
+				}
+				{
+					p.SetState(708)
+					p.expr(12)
+				}
+
+			case 5:
+				localctx = NewEqualityExprContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, KlangParserRULE_expr)
+				p.SetState(709)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 10)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 10)", ""))
+				}
+				p.SetState(710)
+
+				var _lt = p.GetTokenStream().LT(1)
+
+				localctx.(*EqualityExprContext).op = _lt
+
+				_la = p.GetTokenStream().LA(1)
+
+				if !(_la == KlangParserEQ || _la == KlangParserNEQ) {
+					var _ri = p.GetErrorHandler().RecoverInline(p)
+
+					localctx.(*EqualityExprContext).op = _ri
+				} else {
+					p.GetErrorHandler().ReportMatch(p)
+					p.Consume()
+				}
+				{
+					p.SetState(711)
+					p.expr(11)
+				}
+
+			case 6:
+				localctx = NewAndExprContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, KlangParserRULE_expr)
+				p.SetState(712)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
+				}
+				{
+					p.SetState(713)
+					p.Match(KlangParserAND)
+				}
+				{
+					p.SetState(714)
+					p.expr(10)
+				}
+
+			case 7:
+				localctx = NewOrExprContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, KlangParserRULE_expr)
+				p.SetState(715)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
+				}
+				{
+					p.SetState(716)
+					p.Match(KlangParserOR)
+				}
+				{
+					p.SetState(717)
+					p.expr(9)
+				}
+
+			case 8:
+				localctx = NewIndexExprContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, KlangParserRULE_expr)
+				p.SetState(718)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 18)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 18)", ""))
+				}
+				{
+					p.SetState(719)
+					p.Match(KlangParserT__1)
+				}
+				{
+					p.SetState(720)
+					p.expr(0)
+				}
+				{
+					p.SetState(721)
+					p.Match(KlangParserT__2)
+				}
+
+			case 9:
+				localctx = NewMemberExprContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, KlangParserRULE_expr)
+				p.SetState(723)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 17)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 17)", ""))
+				}
+				{
+					p.SetState(724)
+					p.Match(KlangParserDOT)
+				}
+				p.SetState(725)
+				_la = p.GetTokenStream().LA(1)
+
+				if !(_la == KlangParserID || _la == KlangParserPATH) {
+					p.GetErrorHandler().RecoverInline(p)
+				} else {
+					p.GetErrorHandler().ReportMatch(p)
+					p.Consume()
+				}
+
+			}
+
+		}
+		p.SetState(730)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 61, p.GetParserRuleContext())
+	}
+
+	return localctx
+}
+
+// IAtomContext is an interface to support dynamic dispatch.
+type IAtomContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsAtomContext differentiates from other interfaces.
+	IsAtomContext()
+}
+
+type AtomContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAtomContext() *AtomContext {
+	var p = new(AtomContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_atom
+	return p
+}
+
+func (*AtomContext) IsAtomContext() {}
+
+func NewAtomContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AtomContext {
+	var p = new(AtomContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KlangParserRULE_atom
+
+	return p
+}
+
+func (s *AtomContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AtomContext) CopyFrom(ctx *AtomContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
+}
+
+func (s *AtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AtomContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type ParExprContext struct {
+	*AtomContext
+}
+
+func NewParExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ParExprContext {
+	var p = new(ParExprContext)
+
+	p.AtomContext = NewEmptyAtomContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AtomContext))
+
+	return p
+}
+
+func (s *ParExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ParExprContext) OPAR() antlr.TerminalNode {
+	return s.GetToken(KlangParserOPAR, 0)
+}
+
+func (s *ParExprContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *ParExprContext) CPAR() antlr.TerminalNode {
+	return s.GetToken(KlangParserCPAR, 0)
+}
+
+func (s *ParExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterParExpr(s)
+	}
+}
+
+func (s *ParExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitParExpr(s)
+	}
+}
+
+type BooleanAtomContext struct {
+	*AtomContext
+}
+
+func NewBooleanAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BooleanAtomContext {
+	var p = new(BooleanAtomContext)
+
+	p.AtomContext = NewEmptyAtomContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AtomContext))
+
+	return p
+}
+
+func (s *BooleanAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BooleanAtomContext) TRUE() antlr.TerminalNode {
+	return s.GetToken(KlangParserTRUE, 0)
+}
+
+func (s *BooleanAtomContext) FALSE() antlr.TerminalNode {
+	return s.GetToken(KlangParserFALSE, 0)
+}
+
+func (s *BooleanAtomContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterBooleanAtom(s)
+	}
+}
+
+func (s *BooleanAtomContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitBooleanAtom(s)
+	}
+}
+
+type IdAtomContext struct {
+	*AtomContext
+}
+
+func NewIdAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IdAtomContext {
+	var p = new(IdAtomContext)
+
+	p.AtomContext = NewEmptyAtomContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AtomContext))
+
+	return p
+}
+
+func (s *IdAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *IdAtomContext) ID() antlr.TerminalNode {
+	return s.GetToken(KlangParserID, 0)
+}
+
+func (s *IdAtomContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterIdAtom(s)
+	}
+}
+
+func (s *IdAtomContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitIdAtom(s)
+	}
+}
+
+type ListAtomContext struct {
+	*AtomContext
+}
+
+func NewListAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ListAtomContext {
+	var p = new(ListAtomContext)
+
+	p.AtomContext = NewEmptyAtomContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AtomContext))
+
+	return p
+}
+
+func (s *ListAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ListAtomContext) List_literal() IList_literalContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IList_literalContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IList_literalContext)
+}
+
+func (s *ListAtomContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterListAtom(s)
+	}
+}
+
+func (s *ListAtomContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitListAtom(s)
+	}
+}
+
+type StringAtomContext struct {
+	*AtomContext
+}
+
+func NewStringAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *StringAtomContext {
+	var p = new(StringAtomContext)
+
+	p.AtomContext = NewEmptyAtomContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AtomContext))
+
+	return p
+}
+
+func (s *StringAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StringAtomContext) STRING() antlr.TerminalNode {
+	return s.GetToken(KlangParserSTRING, 0)
+}
+
+func (s *StringAtomContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterStringAtom(s)
+	}
+}
+
+func (s *StringAtomContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitStringAtom(s)
+	}
+}
+
+type RawStringAtomContext struct {
+	*AtomContext
+}
+
+func NewRawStringAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *RawStringAtomContext {
+	var p = new(RawStringAtomContext)
+
+	p.AtomContext = NewEmptyAtomContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AtomContext))
+
+	return p
+}
+
+func (s *RawStringAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RawStringAtomContext) RAW_STRING_LIT() antlr.TerminalNode {
+	return s.GetToken(KlangParserRAW_STRING_LIT, 0)
+}
+
+func (s *RawStringAtomContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterRawStringAtom(s)
+	}
+}
+
+func (s *RawStringAtomContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitRawStringAtom(s)
+	}
+}
+
+type JsonAtomContext struct {
+	*AtomContext
+}
+
+func NewJsonAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *JsonAtomContext {
+	var p = new(JsonAtomContext)
+
+	p.AtomContext = NewEmptyAtomContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AtomContext))
+
+	return p
+}
+
+func (s *JsonAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *JsonAtomContext) Json() IJsonContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IJsonContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IJsonContext)
+}
+
+func (s *JsonAtomContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterJsonAtom(s)
+	}
+}
+
+func (s *JsonAtomContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitJsonAtom(s)
+	}
+}
+
+type NilAtomContext struct {
+	*AtomContext
+}
+
+func NewNilAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NilAtomContext {
+	var p = new(NilAtomContext)
+
+	p.AtomContext = NewEmptyAtomContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AtomContext))
+
+	return p
+}
+
+func (s *NilAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NilAtomContext) NIL() antlr.TerminalNode {
+	return s.GetToken(KlangParserNIL, 0)
+}
+
+func (s *NilAtomContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterNilAtom(s)
+	}
+}
+
+func (s *NilAtomContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitNilAtom(s)
+	}
+}
+
+type MapAtomContext struct {
+	*AtomContext
+}
+
+func NewMapAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MapAtomContext {
+	var p = new(MapAtomContext)
+
+	p.AtomContext = NewEmptyAtomContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AtomContext))
+
+	return p
+}
+
+func (s *MapAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MapAtomContext) Map_literal() IMap_literalContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMap_literalContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMap_literalContext)
+}
+
+func (s *MapAtomContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterMapAtom(s)
+	}
+}
+
+func (s *MapAtomContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitMapAtom(s)
+	}
+}
+
+type NumberAtomContext struct {
+	*AtomContext
+}
+
+func NewNumberAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NumberAtomContext {
+	var p = new(NumberAtomContext)
+
+	p.AtomContext = NewEmptyAtomContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AtomContext))
+
+	return p
+}
+
+func (s *NumberAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NumberAtomContext) NUMBER() antlr.TerminalNode {
+	return s.GetToken(KlangParserNUMBER, 0)
+}
+
+func (s *NumberAtomContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterNumberAtom(s)
+	}
+}
+
+func (s *NumberAtomContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitNumberAtom(s)
+	}
+}
+
+type PathAtomContext struct {
+	*AtomContext
+}
+
+func NewPathAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PathAtomContext {
+	var p = new(PathAtomContext)
+
+	p.AtomContext = NewEmptyAtomContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AtomContext))
+
+	return p
+}
+
+func (s *PathAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PathAtomContext) PATH() antlr.TerminalNode {
+	return s.GetToken(KlangParserPATH, 0)
+}
+
+func (s *PathAtomContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterPathAtom(s)
+	}
+}
+
+func (s *PathAtomContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitPathAtom(s)
+	}
+}
+
+type DurationAtomContext struct {
+	*AtomContext
+}
+
+func NewDurationAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *DurationAtomContext {
+	var p = new(DurationAtomContext)
+
+	p.AtomContext = NewEmptyAtomContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AtomContext))
+
+	return p
+}
+
+func (s *DurationAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DurationAtomContext) DURATION() antlr.TerminalNode {
+	return s.GetToken(KlangParserDURATION, 0)
+}
+
+func (s *DurationAtomContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.EnterDurationAtom(s)
+	}
+}
+
+func (s *DurationAtomContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(KlangListener); ok {
+		listenerT.ExitDurationAtom(s)
+	}
+}
+
+func (p *KlangParser) Atom() (localctx IAtomContext) {
+	localctx = NewAtomContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 102, KlangParserRULE_atom)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(746)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 62, p.GetParserRuleContext()) {
+	case 1:
+		localctx = NewParExprContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(731)
+			p.Match(KlangParserOPAR)
+		}
+		{
+			p.SetState(732)
+			p.expr(0)
+		}
+		{
+			p.SetState(733)
+			p.Match(KlangParserCPAR)
+		}
+
+	case 2:
+		localctx = NewNumberAtomContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(735)
+			p.Match(KlangParserNUMBER)
+		}
+
+	case 3:
+		localctx = NewDurationAtomContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(736)
+			p.Match(KlangParserDURATION)
+		}
+
+	case 4:
+		localctx = NewBooleanAtomContext(p, localctx)
+		p.EnterOuterAlt(localctx, 4)
+		p.SetState(737)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == KlangParserTRUE || _la == KlangParserFALSE) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
 
-				if !(_la == KlangParserEQ || _la == KlangParserNEQ) {
-					var _ri = p.GetErrorHandler().RecoverInline(p)
+	case 5:
+		localctx = NewRawStringAtomContext(p, localctx)
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(738)
+			p.Match(KlangParserRAW_STRING_LIT)
+		}
 
-					localctx.(*EqualityExprContext).op = _ri
-				} else {
-					p.GetErrorHandler().ReportMatch(p)
-					p.Consume()
-				}
-				{
-					p.SetState(461)
-					p.expr(10)
-				}
+	case 6:
+		localctx = NewIdAtomContext(p, localctx)
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(739)
+			p.Match(KlangParserID)
+		}
 
-			case 6:
-				localctx = NewAndExprContext(p, NewExprContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, KlangParserRULE_expr)
-				p.SetState(462)
+	case 7:
+		localctx = NewStringAtomContext(p, localctx)
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(740)
+			p.Match(KlangParserSTRING)
+		}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
-				}
-				{
-					p.SetState(463)
-					p.Match(KlangParserAND)
-				}
-				{
-					p.SetState(464)
-					p.expr(9)
-				}
+	case 8:
+		localctx = NewListAtomContext(p, localctx)
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(741)
+			p.List_literal()
+		}
 
-			case 7:
-				localctx = NewOrExprContext(p, NewExprContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, KlangParserRULE_expr)
-				p.SetState(465)
+	case 9:
+		localctx = NewMapAtomContext(p, localctx)
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(742)
+			p.Map_literal()
+		}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
-				}
-				{
-					p.SetState(466)
-					p.Match(KlangParserOR)
-				}
-				{
-					p.SetState(467)
-					p.expr(8)
-				}
+	case 10:
+		localctx = NewJsonAtomContext(p, localctx)
+		p.EnterOuterAlt(localctx, 10)
+		{
+			p.SetState(743)
+			p.Json()
+		}
 
-			}
+	case 11:
+		localctx = NewPathAtomContext(p, localctx)
+		p.EnterOuterAlt(localctx, 11)
+		{
+			p.SetState(744)
+			p.Match(KlangParserPATH)
+		}
 
+	case 12:
+		localctx = NewNilAtomContext(p, localctx)
+		p.EnterOuterAlt(localctx, 12)
+		{
+			p.SetState(745)
+			p.Match(KlangParserNIL)
 		}
-		p.SetState(472)
-		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 32, p.GetParserRuleContext())
+
 	}
 
 	return localctx
 }
 
-// IAtomContext is an interface to support dynamic dispatch.
-type IAtomContext interface {
+// IList_literalContext is an interface to support dynamic dispatch.
+type IList_literalContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsAtomContext differentiates from other interfaces.
-	IsAtomContext()
+	// IsList_literalContext differentiates from other interfaces.
+	IsList_literalContext()
 }
 
-type AtomContext struct {
+type List_literalContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyAtomContext() *AtomContext {
-	var p = new(AtomContext)
+func NewEmptyList_literalContext() *List_literalContext {
+	var p = new(List_literalContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = KlangParserRULE_atom
+	p.RuleIndex = KlangParserRULE_list_literal
 	return p
 }
 
-func (*AtomContext) IsAtomContext() {}
+func (*List_literalContext) IsList_literalContext() {}
 
-func NewAtomContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AtomContext {
-	var p = new(AtomContext)
+func NewList_literalContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *List_literalContext {
+	var p = new(List_literalContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = KlangParserRULE_atom
+	p.RuleIndex = KlangParserRULE_list_literal
 
 	return p
 }
 
-func (s *AtomContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *AtomContext) CopyFrom(ctx *AtomContext) {
-	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
-}
-
-func (s *AtomContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *AtomContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
-
-type ParExprContext struct {
-	*AtomContext
-}
-
-func NewParExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ParExprContext {
-	var p = new(ParExprContext)
-
-	p.AtomContext = NewEmptyAtomContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*AtomContext))
+func (s *List_literalContext) GetParser() antlr.Parser { return s.parser }
 
-	return p
-}
+func (s *List_literalContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
 
-func (s *ParExprContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
 
-func (s *ParExprContext) OPAR() antlr.TerminalNode {
-	return s.GetToken(KlangParserOPAR, 0)
+	return tst
 }
 
-func (s *ParExprContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+func (s *List_literalContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
@@ -7402,273 +11957,312 @@ func (s *ParExprContext) Expr() IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *ParExprContext) CPAR() antlr.TerminalNode {
-	return s.GetToken(KlangParserCPAR, 0)
+func (s *List_literalContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *ParExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *List_literalContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *List_literalContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterParExpr(s)
+		listenerT.EnterList_literal(s)
 	}
 }
 
-func (s *ParExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *List_literalContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitParExpr(s)
+		listenerT.ExitList_literal(s)
 	}
 }
 
-type BooleanAtomContext struct {
-	*AtomContext
-}
+func (p *KlangParser) List_literal() (localctx IList_literalContext) {
+	localctx = NewList_literalContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 104, KlangParserRULE_list_literal)
+	var _la int
 
-func NewBooleanAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BooleanAtomContext {
-	var p = new(BooleanAtomContext)
+	defer func() {
+		p.ExitRule()
+	}()
 
-	p.AtomContext = NewEmptyAtomContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*AtomContext))
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
 
-	return p
-}
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(748)
+		p.Match(KlangParserT__1)
+	}
+	p.SetState(757)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-func (s *BooleanAtomContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<KlangParserT__1)|(1<<KlangParserT__4)|(1<<KlangParserMINUS)|(1<<KlangParserNOT)|(1<<KlangParserOPAR)|(1<<KlangParserOBRACE)|(1<<KlangParserTRUE)|(1<<KlangParserFALSE)|(1<<KlangParserNIL))) != 0) || (((_la-38)&-(0x1f+1)) == 0 && ((1<<uint((_la-38)))&((1<<(KlangParserKUBECTL-38))|(1<<(KlangParserREPLACE-38))|(1<<(KlangParserJSONSELECT-38)))) != 0) || (((_la-70)&-(0x1f+1)) == 0 && ((1<<uint((_la-70)))&((1<<(KlangParserYAMLSELECT-70))|(1<<(KlangParserSHELLSCRIPT-70))|(1<<(KlangParserDOWNLOAD-70))|(1<<(KlangParserDURATION-70))|(1<<(KlangParserID-70))|(1<<(KlangParserNUMBER-70))|(1<<(KlangParserPATH-70))|(1<<(KlangParserRAW_STRING_LIT-70))|(1<<(KlangParserSTRING-70)))) != 0) {
+		{
+			p.SetState(749)
+			p.expr(0)
+		}
+		p.SetState(754)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 
-func (s *BooleanAtomContext) TRUE() antlr.TerminalNode {
-	return s.GetToken(KlangParserTRUE, 0)
-}
+		for _la == KlangParserCOMMA {
+			{
+				p.SetState(750)
+				p.Match(KlangParserCOMMA)
+			}
+			{
+				p.SetState(751)
+				p.expr(0)
+			}
 
-func (s *BooleanAtomContext) FALSE() antlr.TerminalNode {
-	return s.GetToken(KlangParserFALSE, 0)
-}
+			p.SetState(756)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
 
-func (s *BooleanAtomContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterBooleanAtom(s)
 	}
-}
-
-func (s *BooleanAtomContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitBooleanAtom(s)
+	{
+		p.SetState(759)
+		p.Match(KlangParserT__2)
 	}
-}
 
-type IdAtomContext struct {
-	*AtomContext
+	return localctx
 }
 
-func NewIdAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IdAtomContext {
-	var p = new(IdAtomContext)
-
-	p.AtomContext = NewEmptyAtomContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*AtomContext))
+// IMap_literalContext is an interface to support dynamic dispatch.
+type IMap_literalContext interface {
+	antlr.ParserRuleContext
 
-	return p
-}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-func (s *IdAtomContext) GetRuleContext() antlr.RuleContext {
-	return s
+	// IsMap_literalContext differentiates from other interfaces.
+	IsMap_literalContext()
 }
 
-func (s *IdAtomContext) ID() antlr.TerminalNode {
-	return s.GetToken(KlangParserID, 0)
+type Map_literalContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
 }
 
-func (s *IdAtomContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterIdAtom(s)
-	}
+func NewEmptyMap_literalContext() *Map_literalContext {
+	var p = new(Map_literalContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_map_literal
+	return p
 }
 
-func (s *IdAtomContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitIdAtom(s)
-	}
-}
+func (*Map_literalContext) IsMap_literalContext() {}
 
-type StringAtomContext struct {
-	*AtomContext
-}
+func NewMap_literalContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Map_literalContext {
+	var p = new(Map_literalContext)
 
-func NewStringAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *StringAtomContext {
-	var p = new(StringAtomContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-	p.AtomContext = NewEmptyAtomContext()
 	p.parser = parser
-	p.CopyFrom(ctx.(*AtomContext))
-
-	return p
-}
-
-func (s *StringAtomContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *StringAtomContext) STRING() antlr.TerminalNode {
-	return s.GetToken(KlangParserSTRING, 0)
-}
+	p.RuleIndex = KlangParserRULE_map_literal
 
-func (s *StringAtomContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterStringAtom(s)
-	}
+	return p
 }
 
-func (s *StringAtomContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitStringAtom(s)
+func (s *Map_literalContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Map_literalContext) AllMap_entry() []IMap_entryContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IMap_entryContext)(nil)).Elem())
+	var tst = make([]IMap_entryContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IMap_entryContext)
+		}
 	}
-}
 
-type RawStringAtomContext struct {
-	*AtomContext
+	return tst
 }
 
-func NewRawStringAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *RawStringAtomContext {
-	var p = new(RawStringAtomContext)
+func (s *Map_literalContext) Map_entry(i int) IMap_entryContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMap_entryContext)(nil)).Elem(), i)
 
-	p.AtomContext = NewEmptyAtomContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*AtomContext))
+	if t == nil {
+		return nil
+	}
 
-	return p
+	return t.(IMap_entryContext)
 }
 
-func (s *RawStringAtomContext) GetRuleContext() antlr.RuleContext {
+func (s *Map_literalContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *RawStringAtomContext) RAW_STRING_LIT() antlr.TerminalNode {
-	return s.GetToken(KlangParserRAW_STRING_LIT, 0)
+func (s *Map_literalContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *RawStringAtomContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *Map_literalContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterRawStringAtom(s)
+		listenerT.EnterMap_literal(s)
 	}
 }
 
-func (s *RawStringAtomContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *Map_literalContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitRawStringAtom(s)
+		listenerT.ExitMap_literal(s)
 	}
 }
 
-type JsonAtomContext struct {
-	*AtomContext
-}
+func (p *KlangParser) Map_literal() (localctx IMap_literalContext) {
+	localctx = NewMap_literalContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 106, KlangParserRULE_map_literal)
+	var _la int
 
-func NewJsonAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *JsonAtomContext {
-	var p = new(JsonAtomContext)
+	defer func() {
+		p.ExitRule()
+	}()
 
-	p.AtomContext = NewEmptyAtomContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*AtomContext))
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
 
-	return p
-}
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(761)
+		p.Match(KlangParserOBRACE)
+	}
+	p.SetState(770)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-func (s *JsonAtomContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+	if _la == KlangParserID || _la == KlangParserSTRING {
+		{
+			p.SetState(762)
+			p.Map_entry()
+		}
+		p.SetState(767)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 
-func (s *JsonAtomContext) Json() IJsonContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IJsonContext)(nil)).Elem(), 0)
+		for _la == KlangParserCOMMA {
+			{
+				p.SetState(763)
+				p.Match(KlangParserCOMMA)
+			}
+			{
+				p.SetState(764)
+				p.Map_entry()
+			}
 
-	if t == nil {
-		return nil
+			p.SetState(769)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	}
+	{
+		p.SetState(772)
+		p.Match(KlangParserCBRACE)
 	}
 
-	return t.(IJsonContext)
+	return localctx
 }
 
-func (s *JsonAtomContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterJsonAtom(s)
-	}
+// IMap_entryContext is an interface to support dynamic dispatch.
+type IMap_entryContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsMap_entryContext differentiates from other interfaces.
+	IsMap_entryContext()
 }
 
-func (s *JsonAtomContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitJsonAtom(s)
-	}
+type Map_entryContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
 }
 
-type NilAtomContext struct {
-	*AtomContext
+func NewEmptyMap_entryContext() *Map_entryContext {
+	var p = new(Map_entryContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = KlangParserRULE_map_entry
+	return p
 }
 
-func NewNilAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NilAtomContext {
-	var p = new(NilAtomContext)
+func (*Map_entryContext) IsMap_entryContext() {}
+
+func NewMap_entryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Map_entryContext {
+	var p = new(Map_entryContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-	p.AtomContext = NewEmptyAtomContext()
 	p.parser = parser
-	p.CopyFrom(ctx.(*AtomContext))
+	p.RuleIndex = KlangParserRULE_map_entry
 
 	return p
 }
 
-func (s *NilAtomContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *Map_entryContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *NilAtomContext) NIL() antlr.TerminalNode {
-	return s.GetToken(KlangParserNIL, 0)
-}
+func (s *Map_entryContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
 
-func (s *NilAtomContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterNilAtom(s)
+	if t == nil {
+		return nil
 	}
-}
 
-func (s *NilAtomContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitNilAtom(s)
-	}
+	return t.(IExprContext)
 }
 
-type NumberAtomContext struct {
-	*AtomContext
+func (s *Map_entryContext) STRING() antlr.TerminalNode {
+	return s.GetToken(KlangParserSTRING, 0)
 }
 
-func NewNumberAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NumberAtomContext {
-	var p = new(NumberAtomContext)
-
-	p.AtomContext = NewEmptyAtomContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*AtomContext))
-
-	return p
+func (s *Map_entryContext) ID() antlr.TerminalNode {
+	return s.GetToken(KlangParserID, 0)
 }
 
-func (s *NumberAtomContext) GetRuleContext() antlr.RuleContext {
+func (s *Map_entryContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *NumberAtomContext) NUMBER() antlr.TerminalNode {
-	return s.GetToken(KlangParserNUMBER, 0)
+func (s *Map_entryContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *NumberAtomContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *Map_entryContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.EnterNumberAtom(s)
+		listenerT.EnterMap_entry(s)
 	}
 }
 
-func (s *NumberAtomContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *Map_entryContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(KlangListener); ok {
-		listenerT.ExitNumberAtom(s)
+		listenerT.ExitMap_entry(s)
 	}
 }
 
-func (p *KlangParser) Atom() (localctx IAtomContext) {
-	localctx = NewAtomContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 70, KlangParserRULE_atom)
+func (p *KlangParser) Map_entry() (localctx IMap_entryContext) {
+	localctx = NewMap_entryContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 108, KlangParserRULE_map_entry)
 	var _la int
 
 	defer func() {
@@ -7687,86 +12281,23 @@ func (p *KlangParser) Atom() (localctx IAtomContext) {
 		}
 	}()
 
-	p.SetState(484)
-	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 33, p.GetParserRuleContext()) {
-	case 1:
-		localctx = NewParExprContext(p, localctx)
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(473)
-			p.Match(KlangParserOPAR)
-		}
-		{
-			p.SetState(474)
-			p.expr(0)
-		}
-		{
-			p.SetState(475)
-			p.Match(KlangParserCPAR)
-		}
-
-	case 2:
-		localctx = NewNumberAtomContext(p, localctx)
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(477)
-			p.Match(KlangParserNUMBER)
-		}
-
-	case 3:
-		localctx = NewBooleanAtomContext(p, localctx)
-		p.EnterOuterAlt(localctx, 3)
-		p.SetState(478)
-		_la = p.GetTokenStream().LA(1)
-
-		if !(_la == KlangParserTRUE || _la == KlangParserFALSE) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
-		}
-
-	case 4:
-		localctx = NewRawStringAtomContext(p, localctx)
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(479)
-			p.Match(KlangParserRAW_STRING_LIT)
-		}
-
-	case 5:
-		localctx = NewIdAtomContext(p, localctx)
-		p.EnterOuterAlt(localctx, 5)
-		{
-			p.SetState(480)
-			p.Match(KlangParserID)
-		}
-
-	case 6:
-		localctx = NewStringAtomContext(p, localctx)
-		p.EnterOuterAlt(localctx, 6)
-		{
-			p.SetState(481)
-			p.Match(KlangParserSTRING)
-		}
-
-	case 7:
-		localctx = NewJsonAtomContext(p, localctx)
-		p.EnterOuterAlt(localctx, 7)
-		{
-			p.SetState(482)
-			p.Json()
-		}
-
-	case 8:
-		localctx = NewNilAtomContext(p, localctx)
-		p.EnterOuterAlt(localctx, 8)
-		{
-			p.SetState(483)
-			p.Match(KlangParserNIL)
-		}
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(774)
+	_la = p.GetTokenStream().LA(1)
 
+	if !(_la == KlangParserID || _la == KlangParserSTRING) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
+	}
+	{
+		p.SetState(775)
+		p.Match(KlangParserT__3)
+	}
+	{
+		p.SetState(776)
+		p.expr(0)
 	}
 
 	return localctx
@@ -7842,7 +12373,7 @@ func (s *JsonContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *KlangParser) Json() (localctx IJsonContext) {
 	localctx = NewJsonContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 72, KlangParserRULE_json)
+	p.EnterRule(localctx, 110, KlangParserRULE_json)
 
 	defer func() {
 		p.ExitRule()
@@ -7862,7 +12393,7 @@ func (p *KlangParser) Json() (localctx IJsonContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(486)
+		p.SetState(778)
 		p.Value()
 	}
 
@@ -7952,7 +12483,7 @@ func (s *ObjContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *KlangParser) Obj() (localctx IObjContext) {
 	localctx = NewObjContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 74, KlangParserRULE_obj)
+	p.EnterRule(localctx, 112, KlangParserRULE_obj)
 	var _la int
 
 	defer func() {
@@ -7971,50 +12502,50 @@ func (p *KlangParser) Obj() (localctx IObjContext) {
 		}
 	}()
 
-	p.SetState(501)
+	p.SetState(793)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 35, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 68, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(488)
+			p.SetState(780)
 			p.Match(KlangParserOBRACE)
 		}
 		{
-			p.SetState(489)
+			p.SetState(781)
 			p.Pair()
 		}
-		p.SetState(494)
+		p.SetState(786)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == KlangParserCOMMA {
 			{
-				p.SetState(490)
+				p.SetState(782)
 				p.Match(KlangParserCOMMA)
 			}
 			{
-				p.SetState(491)
+				p.SetState(783)
 				p.Pair()
 			}
 
-			p.SetState(496)
+			p.SetState(788)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(497)
+			p.SetState(789)
 			p.Match(KlangParserCBRACE)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(499)
+			p.SetState(791)
 			p.Match(KlangParserOBRACE)
 		}
 		{
-			p.SetState(500)
+			p.SetState(792)
 			p.Match(KlangParserCBRACE)
 		}
 
@@ -8097,7 +12628,7 @@ func (s *PairContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *KlangParser) Pair() (localctx IPairContext) {
 	localctx = NewPairContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 76, KlangParserRULE_pair)
+	p.EnterRule(localctx, 114, KlangParserRULE_pair)
 
 	defer func() {
 		p.ExitRule()
@@ -8117,15 +12648,15 @@ func (p *KlangParser) Pair() (localctx IPairContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(503)
+		p.SetState(795)
 		p.Match(KlangParserSTRING)
 	}
 	{
-		p.SetState(504)
-		p.Match(KlangParserT__1)
+		p.SetState(796)
+		p.Match(KlangParserT__3)
 	}
 	{
-		p.SetState(505)
+		p.SetState(797)
 		p.Value()
 	}
 
@@ -8215,7 +12746,7 @@ func (s *ArrContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *KlangParser) Arr() (localctx IArrContext) {
 	localctx = NewArrContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 78, KlangParserRULE_arr)
+	p.EnterRule(localctx, 116, KlangParserRULE_arr)
 	var _la int
 
 	defer func() {
@@ -8234,51 +12765,51 @@ func (p *KlangParser) Arr() (localctx IArrContext) {
 		}
 	}()
 
-	p.SetState(520)
+	p.SetState(812)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 37, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 70, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(507)
-			p.Match(KlangParserT__2)
+			p.SetState(799)
+			p.Match(KlangParserT__1)
 		}
 		{
-			p.SetState(508)
+			p.SetState(800)
 			p.Value()
 		}
-		p.SetState(513)
+		p.SetState(805)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == KlangParserCOMMA {
 			{
-				p.SetState(509)
+				p.SetState(801)
 				p.Match(KlangParserCOMMA)
 			}
 			{
-				p.SetState(510)
+				p.SetState(802)
 				p.Value()
 			}
 
-			p.SetState(515)
+			p.SetState(807)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(516)
-			p.Match(KlangParserT__3)
+			p.SetState(808)
+			p.Match(KlangParserT__2)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(518)
-			p.Match(KlangParserT__2)
+			p.SetState(810)
+			p.Match(KlangParserT__1)
 		}
 		{
-			p.SetState(519)
-			p.Match(KlangParserT__3)
+			p.SetState(811)
+			p.Match(KlangParserT__2)
 		}
 
 	}
@@ -8374,7 +12905,7 @@ func (s *ValueContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *KlangParser) Value() (localctx IValueContext) {
 	localctx = NewValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 80, KlangParserRULE_value)
+	p.EnterRule(localctx, 118, KlangParserRULE_value)
 
 	defer func() {
 		p.ExitRule()
@@ -8392,56 +12923,56 @@ func (p *KlangParser) Value() (localctx IValueContext) {
 		}
 	}()
 
-	p.SetState(529)
+	p.SetState(821)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case KlangParserSTRING:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(522)
+			p.SetState(814)
 			p.Match(KlangParserSTRING)
 		}
 
 	case KlangParserNUMBER:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(523)
+			p.SetState(815)
 			p.Match(KlangParserNUMBER)
 		}
 
 	case KlangParserOBRACE:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(524)
+			p.SetState(816)
 			p.Obj()
 		}
 
-	case KlangParserT__2:
+	case KlangParserT__1:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(525)
+			p.SetState(817)
 			p.Arr()
 		}
 
 	case KlangParserTRUE:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(526)
+			p.SetState(818)
 			p.Match(KlangParserTRUE)
 		}
 
 	case KlangParserFALSE:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(527)
+			p.SetState(819)
 			p.Match(KlangParserFALSE)
 		}
 
 	case KlangParserT__4:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(528)
+			p.SetState(820)
 			p.Match(KlangParserT__4)
 		}
 
@@ -8454,7 +12985,7 @@ func (p *KlangParser) Value() (localctx IValueContext) {
 
 func (p *KlangParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
-	case 34:
+	case 50:
 		var t *ExprContext = nil
 		if localctx != nil {
 			t = localctx.(*ExprContext)
@@ -8469,25 +13000,31 @@ func (p *KlangParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex i
 func (p *KlangParser) Expr_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
 	case 0:
-		return p.Precpred(p.GetParserRuleContext(), 15)
+		return p.Precpred(p.GetParserRuleContext(), 16)
 
 	case 1:
-		return p.Precpred(p.GetParserRuleContext(), 12)
+		return p.Precpred(p.GetParserRuleContext(), 13)
 
 	case 2:
-		return p.Precpred(p.GetParserRuleContext(), 11)
+		return p.Precpred(p.GetParserRuleContext(), 12)
 
 	case 3:
-		return p.Precpred(p.GetParserRuleContext(), 10)
+		return p.Precpred(p.GetParserRuleContext(), 11)
 
 	case 4:
-		return p.Precpred(p.GetParserRuleContext(), 9)
+		return p.Precpred(p.GetParserRuleContext(), 10)
 
 	case 5:
-		return p.Precpred(p.GetParserRuleContext(), 8)
+		return p.Precpred(p.GetParserRuleContext(), 9)
 
 	case 6:
-		return p.Precpred(p.GetParserRuleContext(), 7)
+		return p.Precpred(p.GetParserRuleContext(), 8)
+
+	case 7:
+		return p.Precpred(p.GetParserRuleContext(), 18)
+
+	case 8:
+		return p.Precpred(p.GetParserRuleContext(), 17)
 
 	default:
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
@@ -0,0 +1,243 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+)
+
+const (
+	replPrompt         = "klang> "
+	replContinuePrompt = "...... "
+	replValueWidth     = 60
+)
+
+const replHelp = `statements are run and expressions print their value with its type, a statement continues on
+the next line until it is complete and an empty line ends it. Commands:
+  :vars         list the variables with their type and value
+  :load <file>  run a script, its variables and functions are kept
+  :reset        forget every variable, function and import
+  :help         print this help
+  :quit         leave, like the end of the input
+`
+
+// Repl evaluates statements and expressions typed line by line with one listener, variables, functions
+// and imports are kept from one line to the next
+type Repl struct {
+	mapper   *Mapper
+	dryRun   bool
+	out      io.Writer
+	listener *KlangListener
+}
+
+// NewRepl creates a repl writing to out, with dryRun kubectl commands are printed instead of being sent to the cluster
+func NewRepl(mapper *Mapper, dryRun bool, out io.Writer) *Repl {
+	r := &Repl{mapper: mapper, dryRun: dryRun, out: out}
+	r.reset()
+	return r
+}
+
+func (r *Repl) reset() {
+	r.listener = NewKlangListener(r.mapper)
+	r.listener.SetDryRun(r.dryRun)
+	r.listener.SetOutput(r.out)
+}
+
+// Run evaluates the lines of in until it ends, a :quit command or an exit statement. Prompts are written
+// if prompt is set, the result is the code of the exit statement.
+func (r *Repl) Run(in io.Reader, prompt bool) int {
+	scanner := bufio.NewScanner(in)
+	pending := ""
+	for {
+		if prompt && len(pending) == 0 {
+			fmt.Fprint(r.out, replPrompt)
+		} else if prompt {
+			fmt.Fprint(r.out, replContinuePrompt)
+		}
+		if !scanner.Scan() {
+			break
+		}
+		line := scanner.Text()
+		if len(pending) == 0 && strings.HasPrefix(strings.TrimSpace(line), ":") {
+			if !r.command(strings.Fields(line)) {
+				return 0
+			}
+		} else if len(pending) == 0 {
+			pending = r.eval(line, false)
+		} else {
+			pending = r.eval(pending+"\n"+line, len(strings.TrimSpace(line)) == 0)
+		}
+		if exit := r.listener.Exit(); exit != nil {
+			return exit.Code
+		}
+	}
+	if len(pending) != 0 {
+		r.eval(pending, true)
+	}
+	if exit := r.listener.Exit(); exit != nil {
+		return exit.Code
+	}
+	return 0
+}
+
+// command runs a repl command, it is false if the repl must stop
+func (r *Repl) command(fields []string) bool {
+	switch fields[0] {
+	case ":vars":
+		r.printVars()
+	case ":load":
+		if len(fields) != 2 {
+			fmt.Fprintln(r.out, "usage: :load <file>")
+			return true
+		}
+		content, err := ioutil.ReadFile(fields[1])
+		if err != nil {
+			fmt.Fprintf(r.out, "error: %v\n", err)
+			return true
+		}
+		tree, syntaxErrors := Parse(string(content))
+		for _, e := range syntaxErrors {
+			fmt.Fprintf(r.out, "%s: error: %s\n", fields[1], e)
+		}
+		if len(syntaxErrors) == 0 {
+			r.run(fields[1]+": ", tree)
+		}
+	case ":reset":
+		r.reset()
+	case ":help":
+		fmt.Fprint(r.out, replHelp)
+	case ":quit":
+		return false
+	default:
+		fmt.Fprintf(r.out, "unknown command %s, :help lists the commands\n", fields[0])
+	}
+	return true
+}
+
+// eval runs input as statements or prints its value if it is an expression, the semicolon ending a
+// statement may be left out. Input which ends too early is returned to be continued by the next line
+// unless final is set.
+func (r *Repl) eval(input string, final bool) string {
+	if len(strings.TrimSpace(input)) == 0 {
+		return ""
+	}
+	_, tree, syntaxErrors := parse(input)
+	if len(syntaxErrors) == 0 {
+		r.run("", tree)
+		return ""
+	}
+	if expr, err := parseInterpolation(strings.TrimSuffix(strings.TrimSpace(input), ";")); err == nil {
+		r.print(expr)
+		return ""
+	}
+	if _, tree, errs := parse(input + ";"); len(errs) == 0 {
+		r.run("", tree)
+		return ""
+	}
+	if !final && incomplete(syntaxErrors) {
+		return input
+	}
+	for _, e := range syntaxErrors {
+		fmt.Fprintf(r.out, "error: %s\n", e)
+	}
+	return ""
+}
+
+// run checks tree and walks it if it has no errors, prefix is written before the diagnostics
+func (r *Repl) run(prefix string, tree parser.IParseContext) {
+	diagnostics := r.listener.Check(tree)
+	for _, d := range diagnostics {
+		fmt.Fprintf(r.out, "%s%s\n", prefix, d)
+	}
+	if HasErrors(diagnostics) {
+		return
+	}
+	r.listener.Run(tree)
+	r.recover(prefix)
+}
+
+// print evaluates expr if it has no errors and writes its value
+func (r *Repl) print(expr parser.IExprContext) {
+	diagnostics := r.listener.checkExpr(expr)
+	for _, d := range diagnostics {
+		fmt.Fprintln(r.out, d)
+	}
+	if HasErrors(diagnostics) {
+		return
+	}
+	val := r.listener.getValIfID(r.listener.handleExpr(expr))
+	if !r.recover("") {
+		fmt.Fprintln(r.out, describeValue(val))
+	}
+}
+
+// recover prints an error which aborted the input and makes the listener usable again, it is true if there was one
+func (r *Repl) recover(prefix string) bool {
+	r.listener.abortIfThrown()
+	err := r.listener.Err()
+	if err == nil {
+		return false
+	}
+	fmt.Fprintf(r.out, "%serror: %v\n", prefix, err)
+	r.listener.err = nil
+	r.listener.shouldExit = false
+	return true
+}
+
+func (r *Repl) printVars() {
+	var names []string
+	for name := range r.listener.globals {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		val := r.listener.globals[name]
+		value := strings.Join(strings.Fields(describeValue(val)), " ")
+		if runes := []rune(value); len(runes) > replValueWidth {
+			value = string(runes[:replValueWidth]) + "..."
+		}
+		fmt.Fprintf(r.out, "%s %s\n", name, value)
+	}
+}
+
+// describeValue writes the type before the value, values of more than one line start on the next line
+func describeValue(val valHolder) string {
+	if val.dataType == NIL {
+		return string(NIL)
+	}
+	s := fmt.Sprint(toDocument(val).value)
+	if strings.Contains(s, "\n") {
+		return string(val.dataType) + "\n" + strings.TrimSuffix(s, "\n")
+	}
+	return string(val.dataType) + " " + s
+}
+
+// incomplete is true if parsing stopped at the end of the input, like in an unclosed block or string
+func incomplete(syntaxErrors []SyntaxError) bool {
+	for _, e := range syntaxErrors {
+		if strings.Contains(e.Message, "<EOF>") || strings.HasPrefix(e.Message, "token recognition error at: '`") {
+			return true
+		}
+	}
+	return false
+}
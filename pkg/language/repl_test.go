@@ -0,0 +1,101 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRepl_Run(t *testing.T) {
+	dir, err := ioutil.TempDir("", "klang-repl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	script := filepath.Join(dir, "double.k")
+	if err := ioutil.WriteFile(script, []byte("func double(a) {\n  return a * 2;\n}\nloaded = double(21);\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		input    string
+		want     string
+		wantCode int
+	}{
+		{
+			name:  "values are kept between lines",
+			input: "x = 1\ns = \"a\";\nx + 2\ns\nx > 0\n1.5\nnil\n",
+			want:  "INT 3\nSTRING a\nBOOLEAN true\nFLOAT 1.5\nNIL\n",
+		},
+		{
+			name:  "statements continue until they are complete",
+			input: "func f(a) {\n  return a + 1;\n}\nf(1)\ny = `a\nb`\ny\n",
+			want:  "INT 2\nSTRING\na\nb\n",
+		},
+		{
+			name:  "empty line ends an incomplete statement",
+			input: "x = (1\n\nx = 2\nx\n",
+			want:  "error: line 2:0 mismatched input '<EOF>' expecting ')'\nINT 2\n",
+		},
+		{
+			name:  "errors do not end the repl",
+			input: "z\nz = ;\nthrow \"failed\";\nz = 1\nz\n",
+			want:  "line 1:0 error: undefined variable z\nerror: line 1:4 no viable alternative at input 'z=;'\nerror: failed at line 1\nINT 1\n",
+		},
+		{
+			name:  "commands",
+			input: ":load " + script + "\ndouble(2)\n:vars\n:reset\n:vars\nloaded\n:unknown\n",
+			want:  "INT 4\nloaded INT 42\nline 1:0 error: undefined variable loaded\nunknown command :unknown, :help lists the commands\n",
+		},
+		{
+			name:  "long values are cut on characters",
+			input: "s = \"" + strings.Repeat("é", 70) + "\"\n:vars\n",
+			want:  "s STRING " + strings.Repeat("é", 53) + "...\n",
+		},
+		{
+			name:  "quit",
+			input: "x = 1\n:quit\nx\n",
+		},
+		{
+			name:     "exit",
+			input:    "x = 1\nexit 3;\nx\n",
+			wantCode: 3,
+		},
+		{
+			name:  "dry run",
+			input: "r = kubectl apply \"kind: Namespace\"\nr\nlog \"done\";\nkubectl get ns/apps\n",
+			want:  "dry run: kubectl apply \"kind: Namespace\"\nBOOLEAN true\ndone\nERROR kubectl get ns/apps is not available in dry run at line 1\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			code := NewRepl(nil, true, &out).Run(strings.NewReader(tt.input), false)
+			if code != tt.wantCode {
+				t.Errorf("Run() = %d, want %d", code, tt.wantCode)
+			}
+			if out.String() != tt.want {
+				t.Errorf("Run() output = %q, want %q", out.String(), tt.want)
+			}
+		})
+	}
+}
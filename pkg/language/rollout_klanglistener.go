@@ -0,0 +1,247 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"strings"
+	"time"
+)
+
+const (
+	rolloutStatus         = "status"
+	rolloutRestart        = "restart"
+	defaultRolloutTimeout = 10 * time.Minute
+	restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+)
+
+func (l *KlangListener) handleKubectlRollout(ctx *parser.RolloutKubectlCommandContext) valHolder {
+	action := ctx.ID().GetText()
+	if action != rolloutStatus && action != rolloutRestart {
+		return newErrHolder(fmt.Errorf("unsupported rollout command %s, expected status or restart", action))
+	}
+	timeout := defaultRolloutTimeout
+	if len(ctx.AllExpr()) != 0 {
+		r := l.handleExpr(ctx.Expr(len(ctx.AllExpr()) - 1))
+		r = l.getValIfID(r)
+		var err error
+		if timeout, err = toDuration(r); err != nil {
+			return newErrHolder(fmt.Errorf("rollout --timeout: %v", err))
+		}
+	}
+	timeout = l.withinDeadlines(timeout)
+	namespace := "default"
+	if len(ctx.AllNs()) > 0 {
+		namespace = l.resolveNs(ctx.Ns(len(ctx.AllNs()) - 1))
+	}
+	a := NewFactory(l.mapper)
+	var args []string
+	for _, resource := range ctx.AllResource() {
+		args = append(args, l.resolveResource(resource))
+	}
+	a.ResourceTypeOrNameArgs(args...)
+	if len(a.errs) != 0 {
+		var errs []string
+		for _, err := range a.errs {
+			errs = append(errs, err.Error())
+		}
+		return newErrHolder(fmt.Errorf(strings.Join(errs, "\n")))
+	}
+	if len(a.ResourceTuples()) != 1 {
+		return newErrHolder(fmt.Errorf("rollout %s expects one resource, found %d", action, len(a.ResourceTuples())))
+	}
+	rt := a.ResourceTuples()[0]
+	resource, err := a.mappingFor(rt.Resource)
+	if err != nil {
+		return newErrHolder(err)
+	}
+	if len(rt.Name) == 0 {
+		return newErrHolder(fmt.Errorf("name is mandatory for rollout %s", action))
+	}
+	if !isRolloutKind(resource.GroupVersionKind.Kind) {
+		return newErrHolder(fmt.Errorf("rollout is not supported for %s", resource.GroupVersionKind.Kind))
+	}
+	gr := GetRequest{
+		Name:             rt.Name,
+		Namespace:        namespace,
+		GroupVersionKind: resource.GroupVersionKind,
+	}
+	if action == rolloutRestart {
+		return l.rolloutRestart(&gr)
+	}
+	return l.rolloutStatus(&gr, timeout)
+}
+
+// rolloutStatus waits like kubectl rollout status, it returns true once the rollout is complete and ERR if the
+// rollout failed or did not complete within timeout
+func (l *KlangListener) rolloutStatus(gr *GetRequest, timeout time.Duration) valHolder {
+	k := NewKubectl()
+	get := func() (*unstructured.Unstructured, error) {
+		resp, err := k.GetResource(context.Background(), gr)
+		if err != nil {
+			return nil, err
+		}
+		obj := &unstructured.Unstructured{}
+		if err := json.Unmarshal([]byte(resp.Manifest), &obj.Object); err != nil {
+			return nil, err
+		}
+		return obj, nil
+	}
+	if err := waitForRollout(get, defaultWaitInterval, timeout); err != nil {
+		return newErrHolder(fmt.Errorf("rollout status %s/%s: %v", strings.ToLower(gr.GroupVersionKind.Kind), gr.Name, err))
+	}
+	return newBooleanValHolder(true)
+}
+
+// waitForRollout polls get until the rollout is complete, errors of get are retried until timeout
+func waitForRollout(get func() (*unstructured.Unstructured, error), interval, timeout time.Duration) error {
+	message := ""
+	err := wait.PollImmediate(interval, timeout, func() (bool, error) {
+		obj, err := get()
+		if err != nil {
+			message = err.Error()
+			return false, nil
+		}
+		var done bool
+		message, done, err = rolloutStatusOf(obj)
+		return done, err
+	})
+	if err == wait.ErrWaitTimeout {
+		return fmt.Errorf("timed out after %s: %s", timeout, message)
+	}
+	return err
+}
+
+// rolloutRestart changes the pod template annotation like kubectl does so that all pods are replaced
+func (l *KlangListener) rolloutRestart(gr *GetRequest) valHolder {
+	patch := fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{%q:%q}}}}}`, restartedAtAnnotation, time.Now().Format(time.RFC3339))
+	pr := PatchRequest{
+		Name:             gr.Name,
+		Namespace:        gr.Namespace,
+		GroupVersionKind: gr.GroupVersionKind,
+		Patch:            patch,
+		PatchType:        string(types.StrategicMergePatchType),
+	}
+	_, err := NewKubectl().PatchResource(context.Background(), &pr)
+	res := Resource{
+		Operation: PATCH,
+		Group:     pr.GroupVersionKind.Group,
+		Version:   pr.GroupVersionKind.Version,
+		Kind:      pr.GroupVersionKind.Kind,
+		Namespace: pr.Namespace,
+		Name:      pr.Name,
+		Message:   "restarted",
+		Status:    ResourceSyncStatusCodeSynced,
+	}
+	if err != nil {
+		res.Message = err.Error()
+		res.Status = ResourceSyncStatusCodeOutOfSync
+	}
+	l.recordResource(res)
+	if err != nil {
+		return newErrHolder(err)
+	}
+	return newBooleanValHolder(true)
+}
+
+func isRolloutKind(kind string) bool {
+	return kind == kube.DeploymentKind || kind == kube.StatefulSetKind || kind == kube.DaemonSetKind
+}
+
+// rolloutStatusOf follows kubectl rollout status, it returns the status message and whether the rollout is complete
+func rolloutStatusOf(obj *unstructured.Unstructured) (string, bool, error) {
+	generation := obj.GetGeneration()
+	observedGeneration, _, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	name := obj.GetName()
+	switch obj.GetKind() {
+	case kube.DeploymentKind:
+		if generation > observedGeneration {
+			return "Waiting for deployment spec update to be observed...", false, nil
+		}
+		conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+		for _, c := range conditions {
+			if m, ok := c.(map[string]interface{}); ok && m["type"] == "Progressing" && m["reason"] == "ProgressDeadlineExceeded" {
+				return "", false, fmt.Errorf("deployment %q exceeded its progress deadline", name)
+			}
+		}
+		replicas, hasReplicas, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+		statusReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "replicas")
+		updated, _, _ := unstructured.NestedInt64(obj.Object, "status", "updatedReplicas")
+		available, _, _ := unstructured.NestedInt64(obj.Object, "status", "availableReplicas")
+		if hasReplicas && updated < replicas {
+			return fmt.Sprintf("Waiting for deployment %q rollout to finish: %d out of %d new replicas have been updated...", name, updated, replicas), false, nil
+		}
+		if statusReplicas > updated {
+			return fmt.Sprintf("Waiting for deployment %q rollout to finish: %d old replicas are pending termination...", name, statusReplicas-updated), false, nil
+		}
+		if available < updated {
+			return fmt.Sprintf("Waiting for deployment %q rollout to finish: %d of %d updated replicas are available...", name, available, updated), false, nil
+		}
+		return fmt.Sprintf("deployment %q successfully rolled out", name), true, nil
+	case kube.DaemonSetKind:
+		if strategy, _, _ := unstructured.NestedString(obj.Object, "spec", "updateStrategy", "type"); strategy != "" && strategy != "RollingUpdate" {
+			return "", false, fmt.Errorf("rollout status is only available for RollingUpdate strategy type")
+		}
+		if generation > observedGeneration {
+			return "Waiting for daemon set spec update to be observed...", false, nil
+		}
+		desired, _, _ := unstructured.NestedInt64(obj.Object, "status", "desiredNumberScheduled")
+		updated, _, _ := unstructured.NestedInt64(obj.Object, "status", "updatedNumberScheduled")
+		available, _, _ := unstructured.NestedInt64(obj.Object, "status", "numberAvailable")
+		if updated < desired {
+			return fmt.Sprintf("Waiting for daemon set %q rollout to finish: %d out of %d new pods have been updated...", name, updated, desired), false, nil
+		}
+		if available < desired {
+			return fmt.Sprintf("Waiting for daemon set %q rollout to finish: %d of %d updated pods are available...", name, available, desired), false, nil
+		}
+		return fmt.Sprintf("daemon set %q successfully rolled out", name), true, nil
+	case kube.StatefulSetKind:
+		if strategy, _, _ := unstructured.NestedString(obj.Object, "spec", "updateStrategy", "type"); strategy != "" && strategy != "RollingUpdate" {
+			return "", false, fmt.Errorf("rollout status is only available for RollingUpdate strategy type")
+		}
+		if observedGeneration == 0 || generation > observedGeneration {
+			return "Waiting for statefulset spec update to be observed...", false, nil
+		}
+		replicas, hasReplicas, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+		ready, _, _ := unstructured.NestedInt64(obj.Object, "status", "readyReplicas")
+		updated, _, _ := unstructured.NestedInt64(obj.Object, "status", "updatedReplicas")
+		if hasReplicas && ready < replicas {
+			return fmt.Sprintf("Waiting for %d pods to be ready...", replicas-ready), false, nil
+		}
+		if partition, ok, _ := unstructured.NestedInt64(obj.Object, "spec", "updateStrategy", "rollingUpdate", "partition"); ok && hasReplicas {
+			if updated < replicas-partition {
+				return fmt.Sprintf("Waiting for partitioned roll out to finish: %d out of %d new pods have been updated...", updated, replicas-partition), false, nil
+			}
+			return fmt.Sprintf("partitioned roll out complete: %d new pods have been updated...", updated), true, nil
+		}
+		updateRevision, _, _ := unstructured.NestedString(obj.Object, "status", "updateRevision")
+		currentRevision, _, _ := unstructured.NestedString(obj.Object, "status", "currentRevision")
+		if updateRevision != currentRevision {
+			return fmt.Sprintf("waiting for statefulset rolling update to complete %d pods at revision %s...", updated, updateRevision), false, nil
+		}
+		return fmt.Sprintf("statefulset rolling update complete %d pods at revision %s...", ready, currentRevision), true, nil
+	default:
+		return "", false, fmt.Errorf("rollout is not supported for %s", obj.GetKind())
+	}
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"fmt"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_rolloutStatusOf(t *testing.T) {
+	object := func(kind string, generation int64, spec, status map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"kind":     kind,
+			"metadata": map[string]interface{}{"name": "web", "generation": generation},
+			"spec":     spec,
+			"status":   status,
+		}}
+	}
+	tests := []struct {
+		name    string
+		obj     *unstructured.Unstructured
+		message string
+		done    bool
+		wantErr bool
+	}{
+		{
+			name:    "deployment spec not observed",
+			obj:     object("Deployment", 2, map[string]interface{}{"replicas": int64(2)}, map[string]interface{}{"observedGeneration": int64(1)}),
+			message: "Waiting for deployment spec update to be observed...",
+		},
+		{
+			name: "deployment updating replicas",
+			obj: object("Deployment", 2, map[string]interface{}{"replicas": int64(3)}, map[string]interface{}{
+				"observedGeneration": int64(2), "replicas": int64(3), "updatedReplicas": int64(1), "availableReplicas": int64(2),
+			}),
+			message: `Waiting for deployment "web" rollout to finish: 1 out of 3 new replicas have been updated...`,
+		},
+		{
+			name: "deployment old replicas terminating",
+			obj: object("Deployment", 2, map[string]interface{}{"replicas": int64(3)}, map[string]interface{}{
+				"observedGeneration": int64(2), "replicas": int64(4), "updatedReplicas": int64(3), "availableReplicas": int64(3),
+			}),
+			message: `Waiting for deployment "web" rollout to finish: 1 old replicas are pending termination...`,
+		},
+		{
+			name: "deployment rolled out",
+			obj: object("Deployment", 2, map[string]interface{}{"replicas": int64(3)}, map[string]interface{}{
+				"observedGeneration": int64(2), "replicas": int64(3), "updatedReplicas": int64(3), "availableReplicas": int64(3),
+			}),
+			message: `deployment "web" successfully rolled out`,
+			done:    true,
+		},
+		{
+			name: "deployment progress deadline exceeded",
+			obj: object("Deployment", 2, map[string]interface{}{"replicas": int64(3)}, map[string]interface{}{
+				"observedGeneration": int64(2),
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Progressing", "status": "False", "reason": "ProgressDeadlineExceeded"},
+				},
+			}),
+			wantErr: true,
+		},
+		{
+			name: "daemon set pods unavailable",
+			obj: object("DaemonSet", 1, map[string]interface{}{}, map[string]interface{}{
+				"observedGeneration": int64(1), "desiredNumberScheduled": int64(3), "updatedNumberScheduled": int64(3), "numberAvailable": int64(2),
+			}),
+			message: `Waiting for daemon set "web" rollout to finish: 2 of 3 updated pods are available...`,
+		},
+		{
+			name: "daemon set on delete strategy",
+			obj: object("DaemonSet", 1, map[string]interface{}{"updateStrategy": map[string]interface{}{"type": "OnDelete"}}, map[string]interface{}{
+				"observedGeneration": int64(1),
+			}),
+			wantErr: true,
+		},
+		{
+			name: "statefulset revision pending",
+			obj: object("StatefulSet", 1, map[string]interface{}{"replicas": int64(2)}, map[string]interface{}{
+				"observedGeneration": int64(1), "readyReplicas": int64(2), "updatedReplicas": int64(1), "currentRevision": "web-1", "updateRevision": "web-2",
+			}),
+			message: "waiting for statefulset rolling update to complete 1 pods at revision web-2...",
+		},
+		{
+			name: "statefulset partitioned roll out",
+			obj: object("StatefulSet", 1, map[string]interface{}{
+				"replicas":       int64(3),
+				"updateStrategy": map[string]interface{}{"type": "RollingUpdate", "rollingUpdate": map[string]interface{}{"partition": int64(2)}},
+			}, map[string]interface{}{
+				"observedGeneration": int64(1), "readyReplicas": int64(3), "updatedReplicas": int64(1),
+			}),
+			message: "partitioned roll out complete: 1 new pods have been updated...",
+			done:    true,
+		},
+		{
+			name: "statefulset rolled out",
+			obj: object("StatefulSet", 1, map[string]interface{}{"replicas": int64(2)}, map[string]interface{}{
+				"observedGeneration": int64(1), "readyReplicas": int64(2), "updatedReplicas": int64(2), "currentRevision": "web-2", "updateRevision": "web-2",
+			}),
+			message: "statefulset rolling update complete 2 pods at revision web-2...",
+			done:    true,
+		},
+		{
+			name:    "unsupported kind",
+			obj:     object("ConfigMap", 1, map[string]interface{}{}, map[string]interface{}{}),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message, done, err := rolloutStatusOf(tt.obj)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("rolloutStatusOf() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if message != tt.message || done != tt.done {
+				t.Errorf("rolloutStatusOf() = %q, %v, want %q, %v", message, done, tt.message, tt.done)
+			}
+		})
+	}
+}
+
+func Test_waitForRollout(t *testing.T) {
+	deployment := func(updated int64, conditions ...interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"kind":     "Deployment",
+			"metadata": map[string]interface{}{"name": "web", "generation": int64(1)},
+			"spec":     map[string]interface{}{"replicas": int64(2)},
+			"status": map[string]interface{}{
+				"observedGeneration": int64(1), "replicas": int64(2), "updatedReplicas": updated, "availableReplicas": updated,
+				"conditions": conditions,
+			},
+		}}
+	}
+	tests := []struct {
+		name string
+		get  func() (*unstructured.Unstructured, error)
+		err  string
+	}{
+		{
+			name: "complete",
+			get: func() (*unstructured.Unstructured, error) {
+				return deployment(2), nil
+			},
+		},
+		{
+			name: "timeout",
+			get: func() (*unstructured.Unstructured, error) {
+				return deployment(1), nil
+			},
+			err: `timed out after 50ms: Waiting for deployment "web" rollout to finish: 1 out of 2 new replicas have been updated...`,
+		},
+		{
+			name: "progress deadline exceeded",
+			get: func() (*unstructured.Unstructured, error) {
+				return deployment(1, map[string]interface{}{"type": "Progressing", "reason": "ProgressDeadlineExceeded"}), nil
+			},
+			err: `deployment "web" exceeded its progress deadline`,
+		},
+		{
+			name: "resource not found",
+			get: func() (*unstructured.Unstructured, error) {
+				return nil, fmt.Errorf("deployments.apps \"web\" not found")
+			},
+			err: `timed out after 50ms: deployments.apps "web" not found`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := waitForRollout(tt.get, 10*time.Millisecond, 50*time.Millisecond)
+			if len(tt.err) == 0 && err != nil {
+				t.Errorf("waitForRollout() error = %v", err)
+			}
+			if len(tt.err) != 0 && (err == nil || err.Error() != tt.err) {
+				t.Errorf("waitForRollout() error = %v, want %s", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestKlangListener_handleKubectlRollout_invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "unsupported command",
+			input: `x = kubectl rollout undo deployment/web -n apps;`,
+			err:   "unsupported rollout command undo",
+		},
+		{
+			name:  "invalid timeout",
+			input: `x = kubectl rollout status deployment/web --timeout=true;`,
+			err:   "rollout --timeout",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := setup(tt.input)
+			x := r.values["x"]
+			if x.dataType != ERR || !strings.Contains(x.value.(error).Error(), tt.err) {
+				t.Errorf("expected error containing %q, found %+v", tt.err, x)
+			}
+		})
+	}
+}
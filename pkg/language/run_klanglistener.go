@@ -0,0 +1,77 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"fmt"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+)
+
+// Run runs the script of tree, as returned by Parse, with the values of earlier runs. Statements run
+// in order until the script ends, exits or an error which is not caught aborts it, see Err and Exit.
+func (l *KlangListener) Run(tree parser.IParseContext) {
+	block := tree.(*parser.ParseContext).Block().(*parser.BlockContext)
+	for _, stat := range block.AllStat() {
+		if l.shouldExit {
+			return
+		}
+		l.declareFuncs(stat)
+		l.handleStat(stat)
+		l.abortIfThrown()
+	}
+}
+
+// declareFuncs registers the functions declared in the blocks of a top level statement, functions declared
+// inside functions are not registered
+func (l *KlangListener) declareFuncs(tree antlr.Tree) {
+	switch v := tree.(type) {
+	case *parser.Func_declContext:
+		l.handleFunc_decl(v)
+		return
+	case *parser.StatContext, *parser.Stat_blockContext, *parser.BlockContext, *parser.If_statContext,
+		*parser.Condition_blockContext, *parser.While_statContext, *parser.For_statContext, *parser.Try_statContext,
+		*parser.Catch_clauseContext, *parser.Finally_clauseContext, *parser.Timeout_statContext:
+	default:
+		//expressions cannot declare functions
+		return
+	}
+	for _, child := range tree.GetChildren() {
+		l.declareFuncs(child)
+	}
+}
+
+// handleLoop_body runs an iteration of a while or for loop, it is false if the loop must stop
+func (l *KlangListener) handleLoop_body(ctx parser.IStat_blockContext) bool {
+	l.loops++
+	l.handleStat_block(ctx)
+	l.loops--
+	if l.breaking {
+		l.breaking = false
+		return false
+	}
+	return !l.unwinding()
+}
+
+// break ends the innermost loop of the current function, a break outside of loops is only logged
+func (l *KlangListener) handleBreak_stat(ctx *parser.Break_statContext) {
+	if l.loops == 0 {
+		newErrHolder(fmt.Errorf("break outside loop at line %d", ctx.GetStart().GetLine()))
+		return
+	}
+	l.breaking = true
+}
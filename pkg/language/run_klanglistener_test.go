@@ -0,0 +1,218 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestKlangListener_Run(t *testing.T) {
+	type fields struct {
+		input  string
+		values map[string]valHolder
+	}
+	tests := []struct {
+		name   string
+		fields fields
+	}{
+		{
+			name: "break ends the innermost loop",
+			fields: fields{
+				input: `
+n = 0;
+for i in [1, 2, 3] {
+  j = 0;
+  while true {
+    j = j + 1;
+    if j == 2 {
+      break;
+    }
+  }
+  n = n + j;
+  if i == 2 {
+    break;
+  }
+}
+`,
+				values: map[string]valHolder{
+					"n": {dataType: INT, name: "n", value: int64(4)},
+					"j": {dataType: INT, name: "j", value: int64(2)},
+				},
+			},
+		},
+		{
+			name: "finally runs before break",
+			fields: fields{
+				input: `
+i = 0;
+while i < 5 {
+  i = i + 1;
+  try {
+    break;
+  } finally {
+    done = i;
+  }
+}
+`,
+				values: map[string]valHolder{
+					"i":    {dataType: INT, name: "i", value: int64(1)},
+					"done": {dataType: INT, name: "done", value: int64(1)},
+				},
+			},
+		},
+		{
+			name: "break in a function does not end the loop of the caller",
+			fields: fields{
+				input: `
+func stop() {
+  break;
+  return 1;
+}
+n = 0;
+for i in [1, 2] {
+  stop();
+  n = n + 1;
+}
+`,
+				values: map[string]valHolder{
+					"n": {dataType: INT, name: "n", value: int64(2)},
+				},
+			},
+		},
+		{
+			name: "return from nested loops",
+			fields: fields{
+				input: `
+func find(rows, target) {
+  for i, row in rows {
+    for item in row {
+      if item == target {
+        return i;
+      }
+    }
+  }
+  return nil;
+}
+x = find([[1, 2], [3, 4]], 3);
+`,
+				values: map[string]valHolder{
+					"x": {dataType: INT, name: "x", value: int64(1)},
+				},
+			},
+		},
+		{
+			name: "exit in nested blocks ends the script",
+			fields: fields{
+				input: `
+x = 1;
+for i in [1, 2] {
+  while true {
+    if i == 1 {
+      try {
+        x = 2;
+        exit 0;
+      } catch (e) {
+        x = 3;
+      }
+    }
+  }
+}
+x = 4;
+`,
+				values: map[string]valHolder{
+					"x": {dataType: INT, name: "x", value: int64(2)},
+				},
+			},
+		},
+		{
+			name: "while condition is true unless it is empty",
+			fields: fields{
+				input: `
+n = 3;
+while n {
+  n = n - 1;
+}
+`,
+				values: map[string]valHolder{
+					"n": {dataType: INT, name: "n", value: int64(0)},
+				},
+			},
+		},
+		{
+			name: "functions declared in blocks",
+			fields: fields{
+				input: `
+if false {
+  func one() {
+    return 1;
+  }
+}
+x = one();
+`,
+				values: map[string]valHolder{
+					"x": {dataType: INT, name: "x", value: int64(1)},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := setup(tt.fields.input)
+			if !compare(r.Values(), tt.fields.values) {
+				t.Errorf("expected %+v, found %+v\n", tt.fields.values, r.Values())
+			}
+		})
+	}
+}
+
+func TestKlangListener_Run_once(t *testing.T) {
+	r := NewKlangListener(nil)
+	recorder := &stepRecorder{}
+	r.stepReceivers = append(r.stepReceivers, recorder)
+	tree, errs := Parse(`
+func mark() {
+  stepInfo "called";
+  return true;
+}
+if mark() {
+  while mark() {
+    break;
+  }
+}
+for i in [mark()] {
+  mark();
+}
+`)
+	if len(errs) != 0 {
+		t.Fatal(JoinSyntaxErrors(errs))
+	}
+	r.Run(tree)
+	if len(recorder.steps) != 4 {
+		t.Errorf("expected 4 calls, found %d", len(recorder.steps))
+	}
+}
+
+func TestKlangListener_Run_nestedBlocks(t *testing.T) {
+	depth := 100
+	input := "x = 0;\n" + strings.Repeat("if true {\n", depth) + "x = x + 1;\n" + strings.Repeat("}\n", depth)
+	input += strings.Repeat("x = x + 1;\n", 2000)
+	r := setup(input)
+	if x := r.Values()["x"]; x.value != int64(2001) {
+		t.Errorf("expected 2001, found %+v\n", x)
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"fmt"
+)
+
+// scope holds the variables of a block. The first scope of a call frame holds the globals or the
+// function's locals, block scopes above it only hold variables declared with let or const.
+type scope struct {
+	values map[string]valHolder
+	consts map[string]bool
+}
+
+func newScope(values map[string]valHolder) *scope {
+	return &scope{values: values, consts: make(map[string]bool, 0)}
+}
+
+func (l *KlangListener) pushScope() {
+	l.scopes = append(l.scopes, newScope(make(map[string]valHolder, 0)))
+}
+
+func (l *KlangListener) popScope() {
+	l.scopes = l.scopes[:len(l.scopes)-1]
+}
+
+func (l *KlangListener) currentScope() *scope {
+	return l.scopes[len(l.scopes)-1]
+}
+
+// lookupScope returns the innermost scope of the current frame in which name is defined
+func (l *KlangListener) lookupScope(name string) (*scope, bool) {
+	for i := len(l.scopes) - 1; i >= 0; i-- {
+		if _, ok := l.scopes[i].values[name]; ok {
+			return l.scopes[i], true
+		}
+	}
+	return nil, false
+}
+
+// declare binds val in the current scope, shadowing variables of the same name in outer scopes
+func (l *KlangListener) declare(val valHolder, constant bool) error {
+	s := l.currentScope()
+	if s.consts[val.name] {
+		return fmt.Errorf("const %s is already declared", val.name)
+	}
+	s.values[val.name] = val
+	if constant {
+		s.consts[val.name] = true
+	}
+	return nil
+}
+
+// assign updates val where it is defined, undeclared variables are created in the first scope of the frame
+func (l *KlangListener) assign(val valHolder) error {
+	s, ok := l.lookupScope(val.name)
+	if !ok {
+		s = l.scopes[0]
+	}
+	if s.consts[val.name] {
+		return fmt.Errorf("cannot assign to const %s", val.name)
+	}
+	s.values[val.name] = val
+	return nil
+}
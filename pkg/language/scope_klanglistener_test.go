@@ -0,0 +1,170 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"testing"
+)
+
+func TestKlangListener_scopes(t *testing.T) {
+	type fields struct {
+		input  string
+		values map[string]valHolder
+	}
+	tests := []struct {
+		name   string
+		fields fields
+	}{
+		{
+			name: "let shadows global in block",
+			fields: fields{
+				input: `
+x = 1;
+if true {
+  let x = 2;
+  y = x;
+}
+`,
+				values: map[string]valHolder{
+					"x": {
+						dataType: INT,
+						name:     "x",
+						value:    int64(1),
+					},
+					"y": {
+						dataType: INT,
+						name:     "y",
+						value:    int64(2),
+					},
+				},
+			},
+		},
+		{
+			name: "assignment updates declaring scope",
+			fields: fields{
+				input: `
+total = 0;
+i = 0;
+while i < 3 {
+  let step = i + 1;
+  if true {
+    step = step * 10;
+  }
+  total = total + step;
+  i = i + 1;
+}
+`,
+				values: map[string]valHolder{
+					"total": {
+						dataType: INT,
+						name:     "total",
+						value:    int64(60),
+					},
+					"i": {
+						dataType: INT,
+						name:     "i",
+						value:    int64(3),
+					},
+				},
+			},
+		},
+		{
+			name: "loop and catch variables are local",
+			fields: fields{
+				input: `
+s = "";
+for i, n in ["a", "b", "c"] {
+  s = s + n;
+}
+try {
+  throw "boom";
+} catch (e) {
+  msg = e.message;
+}
+`,
+				values: map[string]valHolder{
+					"s": {
+						dataType: STRING,
+						name:     "s",
+						value:    "abc",
+					},
+					"msg": {
+						dataType: STRING,
+						name:     "msg",
+						value:    "boom",
+					},
+				},
+			},
+		},
+		{
+			name: "const cannot be assigned",
+			fields: fields{
+				input: `
+const limit = 3;
+limit = 4;
+try {
+  limit = 5;
+} catch (e) {
+  msg = e.message;
+}
+`,
+				values: map[string]valHolder{
+					"limit": {
+						dataType: INT,
+						name:     "limit",
+						value:    int64(3),
+					},
+					"msg": {
+						dataType: STRING,
+						name:     "msg",
+						value:    "cannot assign to const limit at line 5",
+					},
+				},
+			},
+		},
+		{
+			name: "const in block and function",
+			fields: fields{
+				input: `
+func area(r) {
+  const pi = 3;
+  return pi * r * r;
+}
+if true {
+  const a = area(2);
+  b = a;
+}
+`,
+				values: map[string]valHolder{
+					"b": {
+						dataType: INT,
+						name:     "b",
+						value:    int64(12),
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := setup(tt.fields.input)
+			if !compare(r.Values(), tt.fields.values) {
+				t.Errorf("expected %+v, found %+v\n", tt.fields.values, r.Values())
+			}
+		})
+	}
+}
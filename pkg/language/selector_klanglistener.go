@@ -0,0 +1,81 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"context"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+	"github.com/tidwall/gjson"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"strings"
+)
+
+// listOptionsOf collects the label and field selectors of a kubectl command, repeated selectors are combined
+func (l *KlangListener) listOptionsOf(selectors []parser.ISelectorContext) metav1.ListOptions {
+	var labels, fields []string
+	for _, s := range selectors {
+		sc := s.(*parser.SelectorContext)
+		switch {
+		case sc.LABELSELECTOR() != nil:
+			labels = append(labels, flagValue(sc.LABELSELECTOR().GetText()))
+		case sc.FIELDSELECTOR() != nil:
+			fields = append(fields, flagValue(sc.FIELDSELECTOR().GetText()))
+		case sc.SELECTOR() != nil:
+			labels = append(labels, l.GetTextFromStringOrId(sc.String_or_id().(*parser.String_or_idContext)))
+		case sc.FIELDSELECTORFLAG() != nil:
+			fields = append(fields, l.GetTextFromStringOrId(sc.String_or_id().(*parser.String_or_idContext)))
+		}
+	}
+	return metav1.ListOptions{
+		LabelSelector: strings.Join(labels, ","),
+		FieldSelector: strings.Join(fields, ","),
+	}
+}
+
+// flagValue strips the flag from options written without quotes like -l app=web or --field-selector=status.phase=Running
+func flagValue(text string) string {
+	text = strings.TrimLeft(text, "-")
+	i := strings.IndexAny(text, " \t=")
+	return strings.TrimSpace(text[i+1:])
+}
+
+// listNamespace is the namespace to list a resource in, cluster scoped resources are listed without namespace
+func listNamespace(mapping *meta.RESTMapping, namespace string) string {
+	if mapping.Scope != nil && mapping.Scope.Name() == meta.RESTScopeNameRoot {
+		return ""
+	}
+	return namespace
+}
+
+// selectedNames lists the names of the resources matching the selectors, an empty ListOptions selects all of them
+func selectedNames(k *kubectl, mapping *meta.RESTMapping, namespace string, options metav1.ListOptions) ([]string, error) {
+	lr := ListRequest{
+		Namespace:            listNamespace(mapping, namespace),
+		GroupVersionResource: mapping.Resource,
+		ListOptions:          options,
+	}
+	resp, err := k.ListResources(context.Background(), &lr)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, manifest := range resp.Manifests {
+		names = append(names, gjson.Get(manifest, "metadata.name").String())
+	}
+	return names, nil
+}
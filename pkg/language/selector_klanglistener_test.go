@@ -0,0 +1,121 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	parser2 "github.com/devtron-labs/inception/pkg/language/parser"
+	"testing"
+)
+
+func TestKlangListener_listOptionsOf(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		labelSelector string
+		fieldSelector string
+		all           bool
+	}{
+		{
+			name:          "unquoted label selector",
+			input:         `kubectl delete deploy -l app=foo`,
+			labelSelector: "app=foo",
+		},
+		{
+			name:          "unquoted selectors with set based requirements",
+			input:         `kubectl get pods -n apps -l=app=web,tier!=db --field-selector status.phase=Running`,
+			labelSelector: "app=web,tier!=db",
+			fieldSelector: "status.phase=Running",
+		},
+		{
+			name:          "long flags",
+			input:         `kubectl get pods --selector app==web --field-selector=spec.nodeName=node-1 -A`,
+			labelSelector: "app==web",
+			fieldSelector: "spec.nodeName=node-1",
+		},
+		{
+			name:          "quoted selectors",
+			input:         `kubectl get deploy -l "app in (web, api)" --field-selector='metadata.name!=web'`,
+			labelSelector: "app in (web, api)",
+			fieldSelector: "metadata.name!=web",
+		},
+		{
+			name:          "selector from variable and repeated selectors",
+			input:         `kubectl delete deploy -l release -l tier=web`,
+			labelSelector: "app=foo,tier=web",
+		},
+		{
+			name:          "semicolon in a string of the command",
+			input:         `kubectl get pods -n "a;b" -l app=web -A`,
+			labelSelector: "app=web",
+		},
+		{
+			name:  "all",
+			input: `kubectl delete cm --all -n apps`,
+			all:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := parser2.NewKlangParser(antlr.NewCommonTokenStream(parser2.NewKlangLexer(antlr.NewInputStream(tt.input)), antlr.TokenDefaultChannel))
+			l := NewKlangListener(nil)
+			l.values["release"] = newStringValHolder("app=foo")
+			var selectors []parser2.ISelectorContext
+			all := false
+			switch v := p.Kubectl_command().(type) {
+			case *parser2.GetKubectlCommandContext:
+				selectors = v.AllSelector()
+			case *parser2.DeleteKubectlCommandContext:
+				selectors = v.AllSelector()
+				all = len(v.AllALL()) != 0
+			default:
+				t.Fatalf("unexpected command %T", v)
+			}
+			options := l.listOptionsOf(selectors)
+			if options.LabelSelector != tt.labelSelector || options.FieldSelector != tt.fieldSelector || all != tt.all {
+				t.Errorf("listOptionsOf() = %q, %q, all %v, want %q, %q, all %v", options.LabelSelector, options.FieldSelector, all, tt.labelSelector, tt.fieldSelector, tt.all)
+			}
+		})
+	}
+}
+
+func TestKlangListener_subtractionIsNotAFlag(t *testing.T) {
+	r := setup(`
+total = 10;
+limit = 2;
+Amount = 1;
+x = total -limit;
+y = -limit;
+z = total -Amount -limit;
+w = (total) -limit == 8;
+l = 3;
+A = 4;
+a = 10 -l;
+b = 10 -A;
+# kubectl get pods
+c = total -l -A;
+`)
+	expected := map[string]int64{"x": 8, "y": -2, "z": 7, "a": 7, "b": 6, "c": 3}
+	for k, v := range expected {
+		if found := r.values[k]; found.dataType != INT || found.value != v {
+			t.Errorf("expected %s = %d, found %+v", k, v, found)
+		}
+	}
+	if w := r.values["w"]; w.dataType != BOOLEAN || w.value != true {
+		t.Errorf("expected w = true, found %+v", w)
+	}
+}
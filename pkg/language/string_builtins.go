@@ -0,0 +1,296 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+func init() {
+	registerDefaultBuiltins(map[string]BuiltinFunc{
+		"len":          builtinLen,
+		"split":        builtinSplit,
+		"join":         builtinJoin,
+		"contains":     builtinContains,
+		"hasPrefix":    builtinHasPrefix,
+		"hasSuffix":    builtinHasSuffix,
+		"replace":      builtinReplace,
+		"regexMatch":   builtinRegexMatch,
+		"regexReplace": builtinRegexReplace,
+		"trim":         builtinTrim,
+		"upper":        builtinUpper,
+		"lower":        builtinLower,
+		"substr":       builtinSubstr,
+		"format":       builtinFormat,
+	})
+}
+
+// len(x) is the number of characters of a string or the number of items of a list or map
+func builtinLen(args ...interface{}) (interface{}, error) {
+	if err := checkArgCount(args, 1, 1); err != nil {
+		return nil, err
+	}
+	switch v := args[0].(type) {
+	case string:
+		return int64(utf8.RuneCountInString(v)), nil
+	case []interface{}:
+		return int64(len(v)), nil
+	case map[string]interface{}:
+		return int64(len(v)), nil
+	default:
+		return nil, fmt.Errorf("argument 1 should be a string, list or map, found %T", v)
+	}
+}
+
+// split(s, sep)
+func builtinSplit(args ...interface{}) (interface{}, error) {
+	if err := checkArgCount(args, 2, 2); err != nil {
+		return nil, err
+	}
+	s, err := stringArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	var out []interface{}
+	for _, part := range strings.Split(s[0], s[1]) {
+		out = append(out, part)
+	}
+	return out, nil
+}
+
+// join(list, sep), list can also be a json or yaml array
+func builtinJoin(args ...interface{}) (interface{}, error) {
+	if err := checkArgCount(args, 2, 2); err != nil {
+		return nil, err
+	}
+	sep, err := stringArg(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	list, err := listArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	parts := make([]string, 0, len(list))
+	for _, item := range list {
+		text, err := nativeText(item)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, text)
+	}
+	return strings.Join(parts, sep), nil
+}
+
+// contains(s, substr) or contains(list, item)
+func builtinContains(args ...interface{}) (interface{}, error) {
+	if err := checkArgCount(args, 2, 2); err != nil {
+		return nil, err
+	}
+	if list, ok := args[0].([]interface{}); ok {
+		for _, item := range list {
+			if reflect.DeepEqual(item, args[1]) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	s, err := stringArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Contains(s[0], s[1]), nil
+}
+
+// hasPrefix(s, prefix)
+func builtinHasPrefix(args ...interface{}) (interface{}, error) {
+	if err := checkArgCount(args, 2, 2); err != nil {
+		return nil, err
+	}
+	s, err := stringArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	return strings.HasPrefix(s[0], s[1]), nil
+}
+
+// hasSuffix(s, suffix)
+func builtinHasSuffix(args ...interface{}) (interface{}, error) {
+	if err := checkArgCount(args, 2, 2); err != nil {
+		return nil, err
+	}
+	s, err := stringArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	return strings.HasSuffix(s[0], s[1]), nil
+}
+
+// replace(s, old, new) replaces all occurrences of old
+func builtinReplace(args ...interface{}) (interface{}, error) {
+	if err := checkArgCount(args, 3, 3); err != nil {
+		return nil, err
+	}
+	s, err := stringArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	return strings.ReplaceAll(s[0], s[1], s[2]), nil
+}
+
+// regexMatch(s, pattern)
+func builtinRegexMatch(args ...interface{}) (interface{}, error) {
+	if err := checkArgCount(args, 2, 2); err != nil {
+		return nil, err
+	}
+	s, err := stringArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	return regexp.MatchString(s[1], s[0])
+}
+
+// regexReplace(s, pattern, replacement), replacement can refer to groups as $1 or ${name}
+func builtinRegexReplace(args ...interface{}) (interface{}, error) {
+	if err := checkArgCount(args, 3, 3); err != nil {
+		return nil, err
+	}
+	s, err := stringArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(s[1])
+	if err != nil {
+		return nil, err
+	}
+	return re.ReplaceAllString(s[0], s[2]), nil
+}
+
+// trim(s) removes leading and trailing white space, trim(s, cutset) removes characters in cutset
+func builtinTrim(args ...interface{}) (interface{}, error) {
+	if err := checkArgCount(args, 1, 2); err != nil {
+		return nil, err
+	}
+	s, err := stringArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	if len(s) == 2 {
+		return strings.Trim(s[0], s[1]), nil
+	}
+	return strings.TrimSpace(s[0]), nil
+}
+
+// upper(s)
+func builtinUpper(args ...interface{}) (interface{}, error) {
+	if err := checkArgCount(args, 1, 1); err != nil {
+		return nil, err
+	}
+	s, err := stringArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	return strings.ToUpper(s), nil
+}
+
+// lower(s)
+func builtinLower(args ...interface{}) (interface{}, error) {
+	if err := checkArgCount(args, 1, 1); err != nil {
+		return nil, err
+	}
+	s, err := stringArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	return strings.ToLower(s), nil
+}
+
+// substr(s, start) or substr(s, start, end), indexes are of characters and end is exclusive
+func builtinSubstr(args ...interface{}) (interface{}, error) {
+	if err := checkArgCount(args, 2, 3); err != nil {
+		return nil, err
+	}
+	s, err := stringArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	runes := []rune(s)
+	start, err := intArg(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	end := int64(len(runes))
+	if len(args) == 3 {
+		end, err = intArg(args, 2)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if start < 0 || end > int64(len(runes)) || start > end {
+		return nil, fmt.Errorf("slice bounds [%d:%d] out of range with length %d", start, end, len(runes))
+	}
+	return string(runes[start:end]), nil
+}
+
+// format(format, args...) formats like fmt.Sprintf
+func builtinFormat(args ...interface{}) (interface{}, error) {
+	if err := checkArgCount(args, 1, -1); err != nil {
+		return nil, err
+	}
+	f, err := stringArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	return fmt.Sprintf(f, args[1:]...), nil
+}
+
+func listArg(args []interface{}, i int) ([]interface{}, error) {
+	switch v := args[i].(type) {
+	case []interface{}:
+		return v, nil
+	case string:
+		r, err := collectionFromString(v)
+		if err != nil || r.dataType != LIST {
+			return nil, fmt.Errorf("argument %d should be a list", i+1)
+		}
+		return toNative(r).([]interface{}), nil
+	default:
+		return nil, fmt.Errorf("argument %d should be a list, found %T", i+1, v)
+	}
+}
+
+func nativeText(v interface{}) (string, error) {
+	switch t := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return t, nil
+	case []interface{}, map[string]interface{}:
+		data, err := json.Marshal(t)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	default:
+		return fmt.Sprint(t), nil
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"fmt"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+	"sort"
+	"strings"
+)
+
+// SyntaxError is an error of the lexer or the parser at a position of the script
+type SyntaxError struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e SyntaxError) Error() string {
+	return fmt.Sprintf("line %d:%d %s", e.Line, e.Column, e.Message)
+}
+
+// SyntaxErrorListener collects the errors of the lexer and the parser instead of printing them on the console
+type SyntaxErrorListener struct {
+	*antlr.DefaultErrorListener
+	Errors []SyntaxError
+}
+
+func (e *SyntaxErrorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, ex antlr.RecognitionException) {
+	e.Errors = append(e.Errors, SyntaxError{Line: line, Column: column, Message: msg})
+}
+
+// Parse parses script and returns its parse tree with all syntax errors, the tree must not be walked if there are errors
+func Parse(script string) (parser.IParseContext, []SyntaxError) {
+	_, tree, errs := parse(script)
+	return tree, errs
+}
+
+// ParseTokens is like Parse but also returns every token of script, comments are in the hidden channel.
+// It is meant for tools like editors which need the positions of the tokens.
+func ParseTokens(script string) ([]antlr.Token, parser.IParseContext, []SyntaxError) {
+	stream, tree, errs := parse(script)
+	return stream.GetAllTokens(), tree, errs
+}
+
+// parse also returns the tokens of script, comments are in the hidden channel
+func parse(script string) (*antlr.CommonTokenStream, parser.IParseContext, []SyntaxError) {
+	errs := &SyntaxErrorListener{}
+	lexer := parser.NewKlangLexer(antlr.NewInputStream(script))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errs)
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	p := parser.NewKlangParser(stream)
+	p.RemoveErrorListeners()
+	p.AddErrorListener(errs)
+	p.BuildParseTrees = true
+	tree := p.Parse()
+	//the lexer runs ahead of the parser, so its errors are reported out of order
+	sort.SliceStable(errs.Errors, func(i, j int) bool {
+		a, b := errs.Errors[i], errs.Errors[j]
+		return a.Line < b.Line || (a.Line == b.Line && a.Column < b.Column)
+	})
+	return stream, tree, errs.Errors
+}
+
+// JoinSyntaxErrors formats errors on one line for conditions and error messages
+func JoinSyntaxErrors(errs []SyntaxError) string {
+	var messages []string
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		want   []SyntaxError
+	}{
+		{
+			name:   "valid",
+			script: "x = 1;\nif x == 1 {\n  log(x);\n}\n",
+		},
+		{
+			name:   "unknown character",
+			script: "x = 1;\ny = 2 @ 3;",
+			want: []SyntaxError{
+				{Line: 2, Column: 6, Message: "token recognition error at: '@'"},
+				{Line: 2, Column: 8, Message: "extraneous input '3' expecting ';'"},
+			},
+		},
+		{
+			name:   "missing expression",
+			script: "x = ;",
+			want:   []SyntaxError{{Line: 1, Column: 4, Message: "no viable alternative at input 'x=;'"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, got := Parse(tt.script)
+			if tree == nil {
+				t.Fatalf("expected a parse tree")
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() errors = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_errorsInOrder(t *testing.T) {
+	_, errs := Parse("a = 1 +;\nb = $;\nc = (2;\n")
+	if len(errs) < 3 {
+		t.Fatalf("expected an error on every line, found %+v", errs)
+	}
+	for i := 1; i < len(errs); i++ {
+		if errs[i].Line < errs[i-1].Line || (errs[i].Line == errs[i-1].Line && errs[i].Column < errs[i-1].Column) {
+			t.Errorf("errors are not in order %+v", errs)
+		}
+	}
+	lines := map[int]bool{}
+	for _, err := range errs {
+		lines[err.Line] = true
+	}
+	if !lines[1] || !lines[2] || !lines[3] {
+		t.Errorf("expected errors on lines 1 to 3, found %+v", errs)
+	}
+}
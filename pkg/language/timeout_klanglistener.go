@@ -0,0 +1,101 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"fmt"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+	"time"
+)
+
+// deadline of an enclosing timeout block
+type deadline struct {
+	at      time.Time
+	timeout time.Duration
+	line    int
+}
+
+func newDurationValHolder(val time.Duration) valHolder {
+	return valHolder{dataType: DURATION, value: val}
+}
+
+// toDuration accepts durations, numbers as seconds and strings like 1m30s
+func toDuration(val valHolder) (time.Duration, error) {
+	switch val.dataType {
+	case DURATION:
+		return val.value.(time.Duration), nil
+	case INT:
+		return time.Duration(val.value.(int64)) * time.Second, nil
+	case FLOAT:
+		return time.Duration(val.value.(float64) * float64(time.Second)), nil
+	case STRING:
+		return time.ParseDuration(val.value.(string))
+	case ERR:
+		return 0, val.value.(error)
+	default:
+		return 0, fmt.Errorf("%s is not a duration", val.dataType)
+	}
+}
+
+func (l *KlangListener) handleTimeout_stat(ctx *parser.Timeout_statContext) {
+	line := ctx.GetStart().GetLine()
+	r := l.handleExpr(ctx.Expr())
+	r = l.getValIfID(r)
+	timeout, err := toDuration(r)
+	if err != nil {
+		l.raiseIfErr(newErrHolder(fmt.Errorf("invalid timeout at line %d: %v", line, err)), line)
+		return
+	}
+	l.deadlines = append(l.deadlines, deadline{at: time.Now().Add(timeout), timeout: timeout, line: line})
+	defer func() { l.deadlines = l.deadlines[:len(l.deadlines)-1] }()
+	l.handleBlock(ctx.Block().(*parser.BlockContext))
+}
+
+// deadlineExceeded raises a timeout error if the deadline of any enclosing timeout block has passed.
+// Statements are not interrupted, the error is raised before the next statement or loop iteration.
+func (l *KlangListener) deadlineExceeded() bool {
+	now := time.Now()
+	for _, d := range l.deadlines {
+		if now.After(d.at) {
+			l.raise(&klangError{message: fmt.Sprintf("timeout %s exceeded", d.timeout), line: d.line})
+			return true
+		}
+	}
+	return false
+}
+
+// sleep only waits until the nearest deadline and then raises the timeout error
+func (l *KlangListener) sleep(d time.Duration) {
+	wakeUp := time.Now().Add(d)
+	for _, dl := range l.deadlines {
+		if dl.at.Before(wakeUp) {
+			wakeUp = dl.at
+		}
+	}
+	time.Sleep(time.Until(wakeUp))
+	l.deadlineExceeded()
+}
+
+// withinDeadlines shortens timeout so that blocking calls return by the nearest deadline of enclosing timeout blocks
+func (l *KlangListener) withinDeadlines(timeout time.Duration) time.Duration {
+	for _, d := range l.deadlines {
+		if remaining := time.Until(d.at); remaining < timeout {
+			timeout = remaining
+		}
+	}
+	return timeout
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"testing"
+	"time"
+)
+
+func TestKlangListener_duration(t *testing.T) {
+	type fields struct {
+		input  string
+		values map[string]valHolder
+	}
+	tests := []struct {
+		name   string
+		fields fields
+	}{
+		{
+			name: "literals and arithmetic",
+			fields: fields{
+				input: `
+a = 500ms;
+b = 1m30s;
+c = b + 30s;
+d = 2h - 90m;
+e = 30s * 4;
+f = 1m > 59s;
+g = "waited ${c}";
+`,
+				values: map[string]valHolder{
+					"a": {dataType: DURATION, name: "a", value: 500 * time.Millisecond},
+					"b": {dataType: DURATION, name: "b", value: 90 * time.Second},
+					"c": {dataType: DURATION, name: "c", value: 2 * time.Minute},
+					"d": {dataType: DURATION, name: "d", value: 30 * time.Minute},
+					"e": {dataType: DURATION, name: "e", value: 2 * time.Minute},
+					"f": {dataType: BOOLEAN, name: "f", value: true},
+					"g": {dataType: STRING, name: "g", value: "waited 2m0s"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := setup(tt.fields.input)
+			if !compare(r.Values(), tt.fields.values) {
+				t.Errorf("expected %+v, found %+v\n", tt.fields.values, r.Values())
+			}
+		})
+	}
+}
+
+func Test_toDuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		val     valHolder
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "duration", val: newDurationValHolder(time.Second), want: time.Second},
+		{name: "int seconds", val: newIntValHolder(30), want: 30 * time.Second},
+		{name: "float seconds", val: newFloatValHolder(1.5), want: 1500 * time.Millisecond},
+		{name: "string", val: newStringValHolder("1m30s"), want: 90 * time.Second},
+		{name: "invalid string", val: newStringValHolder("soon"), wantErr: true},
+		{name: "boolean", val: newBooleanValHolder(true), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toDuration(tt.val)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("toDuration() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("toDuration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKlangListener_handleSleep_fn(t *testing.T) {
+	start := time.Now()
+	r := setup(`
+d = 20ms;
+sleep d;
+sleep "20ms";
+sleep 0.02;
+x = 1;
+`)
+	if elapsed := time.Since(start); elapsed < 60*time.Millisecond || elapsed > 5*time.Second {
+		t.Errorf("expected to sleep 60ms, slept %s\n", elapsed)
+	}
+	if x := r.values["x"]; x.dataType != INT || x.value != int64(1) {
+		t.Errorf("expected x to be set after sleep, found %+v\n", r.Values())
+	}
+}
+
+func TestKlangListener_handleTimeout_stat(t *testing.T) {
+	type fields struct {
+		input   string
+		values  map[string]valHolder
+		aborted bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+	}{
+		{
+			name: "completes within timeout",
+			fields: fields{
+				input: `
+timeout 1s {
+  x = 1;
+}
+`,
+				values: map[string]valHolder{
+					"x": {dataType: INT, name: "x", value: int64(1)},
+				},
+			},
+		},
+		{
+			name: "sleep interrupted and caught",
+			fields: fields{
+				input: `
+try {
+  timeout 50ms {
+    sleep 10s;
+    x = 1;
+  }
+} catch (e) {
+  msg = e.message;
+  line = e.line;
+}
+`,
+				values: map[string]valHolder{
+					"msg":  {dataType: STRING, name: "msg", value: "timeout 50ms exceeded"},
+					"line": {dataType: INT, name: "line", value: int64(3)},
+				},
+			},
+		},
+		{
+			name: "loop stopped at deadline",
+			fields: fields{
+				input: `
+i = 0;
+try {
+  timeout 30ms {
+    while true {
+      i = i + 1;
+    }
+  }
+} catch (e) {
+  msg = e.message;
+}
+`,
+				values: map[string]valHolder{
+					"msg": {dataType: STRING, name: "msg", value: "timeout 30ms exceeded"},
+				},
+			},
+		},
+		{
+			name: "uncaught timeout aborts",
+			fields: fields{
+				input: `
+timeout 10ms {
+  sleep 1s;
+}
+x = 1;
+`,
+				values:  map[string]valHolder{},
+				aborted: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+			r := setup(tt.fields.input)
+			if elapsed := time.Since(start); elapsed > 5*time.Second {
+				t.Errorf("timeout not enforced, took %s\n", elapsed)
+			}
+			if aborted := r.Err() != nil; aborted != tt.fields.aborted {
+				t.Errorf("expected aborted %t, found error %v\n", tt.fields.aborted, r.Err())
+			}
+			m := make(map[string]valHolder, len(tt.fields.values))
+			for k := range tt.fields.values {
+				if d, ok := r.values[k]; ok {
+					m[k] = d
+				}
+			}
+			if !compare(tt.fields.values, m) {
+				t.Errorf("expected %+v, found %+v\n", tt.fields.values, r.Values())
+			}
+			if _, ok := r.values["x"]; ok != (tt.name == "completes within timeout") {
+				t.Errorf("unexpected x in %+v\n", r.Values())
+			}
+		})
+	}
+}
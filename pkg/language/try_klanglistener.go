@@ -0,0 +1,150 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"fmt"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+	log "github.com/sirupsen/logrus"
+)
+
+// klangError is an error raised by throw or by an ERR value inside a try block. It is pending
+// on the listener until a catch clause handles it or it reaches the top level and aborts the script.
+type klangError struct {
+	message string
+	line    int
+}
+
+func (e *klangError) Error() string {
+	return fmt.Sprintf("%s at line %d", e.message, e.line)
+}
+
+// valHolder exposes the error to catch clauses as a MAP with message and line
+func (e *klangError) valHolder() valHolder {
+	return newMapValHolder(map[string]valHolder{
+		"message": newStringValHolder(e.message),
+		"line":    newIntValHolder(int64(e.line)),
+	})
+}
+
+// Err returns the error which aborted the script, nil if it ran to completion
+func (l *KlangListener) Err() error {
+	return l.err
+}
+
+func (l *KlangListener) handleTry_stat(ctx *parser.Try_statContext) {
+	l.tryDepth++
+	l.handleBlock(ctx.Block().(*parser.BlockContext))
+	l.tryDepth--
+	if l.thrown != nil && ctx.Catch_clause() != nil {
+		l.handleCatch_clause(ctx.Catch_clause().(*parser.Catch_clauseContext))
+	}
+	if ctx.Finally_clause() != nil {
+		l.handleFinally_clause(ctx.Finally_clause().(*parser.Finally_clauseContext))
+	}
+}
+
+func (l *KlangListener) handleCatch_clause(ctx *parser.Catch_clauseContext) {
+	e := l.thrown.valHolder()
+	e.name = ctx.ID().GetText()
+	l.thrown = nil
+	l.pushScope()
+	defer l.popScope()
+	l.declare(e, false)
+	l.handleBlock(ctx.Block().(*parser.BlockContext))
+}
+
+// pending errors, returns and breaks are suspended while finally runs and resumed unless finally ends it itself
+func (l *KlangListener) handleFinally_clause(ctx *parser.Finally_clauseContext) {
+	thrown, breaking := l.thrown, l.breaking
+	l.thrown, l.breaking = nil, false
+	returned := l.returned()
+	if returned {
+		l.currentFrame().returned = false
+	}
+	l.handleBlock(ctx.Block().(*parser.BlockContext))
+	if l.unwinding() {
+		return
+	}
+	l.thrown, l.breaking = thrown, breaking
+	if returned {
+		l.currentFrame().returned = true
+	}
+}
+
+func (l *KlangListener) handleThrow_stat(ctx *parser.Throw_statContext) {
+	r := l.handleExpr(ctx.Expr())
+	r = l.getValIfID(r)
+	l.raise(toKlangError(r, ctx.GetStart().GetLine()))
+}
+
+// toKlangError keeps message and line of a caught error so that it can be thrown again
+func toKlangError(val valHolder, line int) *klangError {
+	switch val.dataType {
+	case ERR:
+		if e, ok := val.value.(*klangError); ok {
+			return e
+		}
+		return &klangError{message: val.value.(error).Error(), line: line}
+	case MAP:
+		m := val.value.(map[string]valHolder)
+		if message, ok := m["message"]; ok && message.dataType == STRING {
+			e := &klangError{message: message.value.(string), line: line}
+			if ln, ok := m["line"]; ok && ln.dataType == INT {
+				e.line = int(ln.value.(int64))
+			}
+			return e
+		}
+	case NIL:
+		return &klangError{message: "nil", line: line}
+	}
+	val = toDocument(val)
+	return &klangError{message: fmt.Sprintf("%v", val.value), line: line}
+}
+
+// raise makes err pending, an error already pending is not replaced
+func (l *KlangListener) raise(err *klangError) {
+	if l.thrown != nil {
+		return
+	}
+	l.thrown = err
+}
+
+// raiseIfErr raises ERR values produced inside a try block, outside of one they are only logged
+func (l *KlangListener) raiseIfErr(val valHolder, line int) bool {
+	if l.tryDepth == 0 || val.dataType != ERR {
+		return false
+	}
+	l.raise(toKlangError(val, line))
+	return true
+}
+
+// unwinding reports whether a break, a return, a pending error or an exit ends the statements being executed
+func (l *KlangListener) unwinding() bool {
+	return l.breaking || l.returned() || l.thrown != nil || l.shouldExit
+}
+
+// abortIfThrown stops the script when an error reaches the top level without being caught
+func (l *KlangListener) abortIfThrown() {
+	if l.thrown == nil {
+		return
+	}
+	log.Errorf("{\"err\": \"uncaught error %s\"}\n", l.thrown.Error())
+	l.err = l.thrown
+	l.thrown = nil
+	l.shouldExit = true
+}
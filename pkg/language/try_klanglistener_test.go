@@ -0,0 +1,274 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"testing"
+)
+
+func TestKlangListener_handleTry_stat(t *testing.T) {
+	type fields struct {
+		input   string
+		values  map[string]valHolder
+		aborted bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+	}{
+		{
+			name: "catch thrown string",
+			fields: fields{
+				input: `
+try {
+  throw "boom";
+  x = 1;
+} catch (e) {
+  msg = e.message;
+  line = e.line;
+}
+`,
+				values: map[string]valHolder{
+					"msg": {
+						dataType: STRING,
+						name:     "msg",
+						value:    "boom",
+					},
+					"line": {
+						dataType: INT,
+						name:     "line",
+						value:    int64(3),
+					},
+				},
+			},
+		},
+		{
+			name: "finally runs with and without error",
+			fields: fields{
+				input: `
+a = 0;
+b = 0;
+try {
+  a = 1;
+} finally {
+  a = a + 10;
+}
+try {
+  throw "fail";
+} catch (e) {
+  b = 1;
+} finally {
+  b = b + 10;
+}
+`,
+				values: map[string]valHolder{
+					"a": {
+						dataType: INT,
+						name:     "a",
+						value:    int64(11),
+					},
+					"b": {
+						dataType: INT,
+						name:     "b",
+						value:    int64(11),
+					},
+				},
+			},
+		},
+		{
+			name: "error value raised inside try",
+			fields: fields{
+				input: `
+try {
+  x = missing(1);
+  y = 2;
+} catch (err) {
+  msg = err.message;
+}
+`,
+				values: map[string]valHolder{
+					"msg": {
+						dataType: STRING,
+						name:     "msg",
+						value:    "function missing is not defined",
+					},
+				},
+			},
+		},
+		{
+			name: "error value outside try is not raised",
+			fields: fields{
+				input: `
+x = missing(1);
+y = 2;
+`,
+				values: map[string]valHolder{
+					"x": {
+						dataType: ERR,
+						name:     "x",
+					},
+					"y": {
+						dataType: INT,
+						name:     "y",
+						value:    int64(2),
+					},
+				},
+			},
+		},
+		{
+			name: "throw from function and rethrow",
+			fields: fields{
+				input: `
+func check(n) {
+  if n > 2 {
+    throw "too big";
+  }
+  return n;
+}
+try {
+  try {
+    check(5);
+  } catch (e) {
+    throw e;
+  }
+} catch (outer) {
+  msg = outer.message;
+  line = outer.line;
+}
+`,
+				values: map[string]valHolder{
+					"msg": {
+						dataType: STRING,
+						name:     "msg",
+						value:    "too big",
+					},
+					"line": {
+						dataType: INT,
+						name:     "line",
+						value:    int64(4),
+					},
+				},
+			},
+		},
+		{
+			name: "return through finally",
+			fields: fields{
+				input: `
+func f() {
+  try {
+    return 1;
+  } finally {
+    n = 0;
+  }
+  return 2;
+}
+func g() {
+  try {
+    return 1;
+  } finally {
+    return 3;
+  }
+}
+x = f();
+y = g();
+`,
+				values: map[string]valHolder{
+					"x": {
+						dataType: INT,
+						name:     "x",
+						value:    int64(1),
+					},
+					"y": {
+						dataType: INT,
+						name:     "y",
+						value:    int64(3),
+					},
+				},
+			},
+		},
+		{
+			name: "uncaught throw aborts",
+			fields: fields{
+				input: `
+x = 1;
+if x == 1 {
+  throw "abort";
+}
+x = 2;
+`,
+				values: map[string]valHolder{
+					"x": {
+						dataType: INT,
+						name:     "x",
+						value:    int64(1),
+					},
+				},
+				aborted: true,
+			},
+		},
+		{
+			name: "nested statements follow enclosing condition",
+			fields: fields{
+				input: `
+x = 0;
+try {
+  if false {
+    if true {
+      x = 1;
+    }
+  }
+} finally {
+}
+`,
+				values: map[string]valHolder{
+					"x": {
+						dataType: INT,
+						name:     "x",
+						value:    int64(0),
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := setup(tt.fields.input)
+			if aborted := r.Err() != nil; aborted != tt.fields.aborted {
+				t.Errorf("expected aborted %t, found error %v\n", tt.fields.aborted, r.Err())
+			}
+			for k, v := range tt.fields.values {
+				d, ok := r.values[k]
+				if !ok {
+					t.Errorf("expected %s to be set, found %+v\n", k, r.Values())
+					continue
+				}
+				if v.dataType == ERR {
+					if d.dataType != ERR {
+						t.Errorf("expected %s to be an error, found %+v\n", k, d)
+					}
+					continue
+				}
+				if !compare(map[string]valHolder{k: v}, map[string]valHolder{k: d}) {
+					t.Errorf("expected %+v, found %+v\n", v, d)
+				}
+			}
+			if _, ok := r.values["y"]; ok && tt.name == "error value raised inside try" {
+				t.Errorf("statement after error was executed %+v\n", r.Values())
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package language
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"time"
 )
 
 type ManifestResponse struct {
@@ -70,3 +71,28 @@ type ApplyResponse struct {
 	Namespace        string                  `protobuf:"bytes,4,req,name=namespace" json:"namespace,omitempty"`
 	GroupVersionKind schema.GroupVersionKind `protobuf:"bytes,5,req,name=groupVersionKind" json:"groupVersionKind,omitempty"`
 }
+
+type WaitRequest struct {
+	Name             string                  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Namespace        string                  `protobuf:"bytes,2,req,name=namespace" json:"namespace,omitempty"`
+	GroupVersionKind schema.GroupVersionKind `protobuf:"bytes,3,req,name=groupVersionKind" json:"groupVersionKind,omitempty"`
+	Timeout          time.Duration           `protobuf:"bytes,4,req,name=timeout" json:"timeout,omitempty"`
+	Interval         time.Duration           `protobuf:"bytes,5,req,name=interval" json:"interval,omitempty"`
+}
+
+type CreateRequest struct {
+	Manifest  string `protobuf:"bytes,1,req,name=manifest" json:"manifest,omitempty"`
+	Namespace string `protobuf:"bytes,2,req,name=namespace" json:"namespace,omitempty"`
+}
+
+type ReplaceRequest struct {
+	Manifest  string `protobuf:"bytes,1,req,name=manifest" json:"manifest,omitempty"`
+	Namespace string `protobuf:"bytes,2,req,name=namespace" json:"namespace,omitempty"`
+}
+
+type ScaleRequest struct {
+	Name             string                  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Namespace        string                  `protobuf:"bytes,2,req,name=namespace" json:"namespace,omitempty"`
+	GroupVersionKind schema.GroupVersionKind `protobuf:"bytes,3,req,name=groupVersionKind" json:"groupVersionKind,omitempty"`
+	Replicas         int64                   `protobuf:"varint,4,req,name=replicas" json:"replicas,omitempty"`
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+	"github.com/tidwall/gjson"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	waitForFunc         = "waitFor"
+	defaultWaitTimeout  = 30 * time.Second
+	defaultWaitInterval = time.Second
+)
+
+var jsonPathIndex = regexp.MustCompile(`\[(\d+)\]`)
+
+// waitCondition reports whether a resource reached the state waited for, obj is nil once the resource is deleted
+type waitCondition func(obj *unstructured.Unstructured) bool
+
+// handleWaitFor evaluates its first argument every interval until it is true. Unlike other built-ins
+// the argument is not evaluated before the call, so kubectl commands in it are run on every poll.
+func (l *KlangListener) handleWaitFor(ctx *parser.Func_callContext) valHolder {
+	line := ctx.GetStart().GetLine()
+	exprs := ctx.AllExpr()
+	if len(exprs) < 1 || len(exprs) > 3 {
+		return newErrHolder(fmt.Errorf("%s expects 1 to 3 arguments, found %d", waitForFunc, len(exprs)))
+	}
+	if l.dryRun {
+		return notInDryRun(waitForFunc, line)
+	}
+	timeout := defaultWaitTimeout
+	interval := defaultWaitInterval
+	var err error
+	if len(exprs) > 1 {
+		if timeout, err = toDuration(l.getValIfID(l.handleExpr(exprs[1]))); err != nil {
+			return newErrHolder(fmt.Errorf("%s timeout at line %d: %v", waitForFunc, line, err))
+		}
+	}
+	if len(exprs) > 2 {
+		if interval, err = toDuration(l.getValIfID(l.handleExpr(exprs[2]))); err != nil {
+			return newErrHolder(fmt.Errorf("%s interval at line %d: %v", waitForFunc, line, err))
+		}
+	}
+	end := time.Now().Add(timeout)
+	for {
+		r := l.handleExpr(exprs[0])
+		r = l.getValIfID(r)
+		if l.isTrue(r) {
+			return newBooleanValHolder(true)
+		}
+		if l.unwinding() {
+			return newBooleanValHolder(false)
+		}
+		remaining := time.Until(end)
+		if remaining <= 0 {
+			return newErrHolder(fmt.Errorf("%s timed out after %s at line %d", waitForFunc, timeout, line))
+		}
+		if interval < remaining {
+			remaining = interval
+		}
+		l.sleep(remaining)
+		if l.unwinding() {
+			return newBooleanValHolder(false)
+		}
+	}
+}
+
+func (l *KlangListener) handleKubectlWait(ctx *parser.WaitKubectlCommandContext) valHolder {
+	var conditions []string
+	for _, c := range ctx.AllFORCONDITION() {
+		conditions = append(conditions, StripQuotes(strings.TrimPrefix(c.GetText(), "--for=")))
+	}
+	for _, c := range ctx.AllString_or_id() {
+		conditions = append(conditions, l.GetTextFromStringOrId(c.(*parser.String_or_idContext)))
+	}
+	if len(conditions) != 1 {
+		return newErrHolder(fmt.Errorf("wait expects exactly one --for condition, found %d", len(conditions)))
+	}
+	met, err := parseWaitCondition(conditions[0])
+	if err != nil {
+		return newErrHolder(err)
+	}
+	timeout := defaultWaitTimeout
+	if len(ctx.AllExpr()) != 0 {
+		r := l.handleExpr(ctx.Expr(len(ctx.AllExpr()) - 1))
+		r = l.getValIfID(r)
+		if timeout, err = toDuration(r); err != nil {
+			return newErrHolder(fmt.Errorf("wait --timeout: %v", err))
+		}
+	}
+	timeout = l.withinDeadlines(timeout)
+	namespace := "default"
+	if len(ctx.AllNs()) > 0 {
+		namespace = l.resolveNs(ctx.Ns(len(ctx.AllNs()) - 1))
+	}
+	a := NewFactory(l.mapper)
+	var args []string
+	for _, resource := range ctx.AllResource() {
+		args = append(args, l.resolveResource(resource))
+	}
+	a.ResourceTypeOrNameArgs(args...)
+	if len(a.errs) != 0 {
+		var errs []string
+		for _, err := range a.errs {
+			errs = append(errs, err.Error())
+		}
+		return newErrHolder(fmt.Errorf(strings.Join(errs, "\n")))
+	}
+	if len(a.ResourceTuples()) == 0 {
+		return newErrHolder(fmt.Errorf("unable to identify resources"))
+	}
+	k := NewKubectl()
+	end := time.Now().Add(timeout)
+	for _, rt := range a.ResourceTuples() {
+		resource, err := a.mappingFor(rt.Resource)
+		if err != nil {
+			return newErrHolder(err)
+		}
+		if len(rt.Name) == 0 {
+			return newErrHolder(fmt.Errorf("name is mandatory for wait operation"))
+		}
+		wr := WaitRequest{
+			Name:             rt.Name,
+			Namespace:        namespace,
+			GroupVersionKind: resource.GroupVersionKind,
+			Timeout:          time.Until(end),
+			Interval:         defaultWaitInterval,
+		}
+		if err := k.WaitForResource(context.Background(), &wr, met); err != nil {
+			return newErrHolder(fmt.Errorf("wait for %s/%s %s: %v", rt.Resource, rt.Name, conditions[0], err))
+		}
+	}
+	return newBooleanValHolder(true)
+}
+
+// parseWaitCondition supports the kubectl wait conditions delete, condition=<type>[=<status>] and jsonpath=<path>[=<value>]
+func parseWaitCondition(condition string) (waitCondition, error) {
+	switch {
+	case condition == "delete":
+		return func(obj *unstructured.Unstructured) bool {
+			return obj == nil
+		}, nil
+	case strings.HasPrefix(condition, "condition="):
+		conditionType := strings.TrimPrefix(condition, "condition=")
+		status := "True"
+		if i := strings.Index(conditionType, "="); i >= 0 {
+			conditionType, status = conditionType[:i], conditionType[i+1:]
+		}
+		if len(conditionType) == 0 {
+			return nil, fmt.Errorf("condition type is missing in %q", condition)
+		}
+		return func(obj *unstructured.Unstructured) bool {
+			return obj != nil && hasCondition(obj, conditionType, status)
+		}, nil
+	case strings.HasPrefix(condition, "jsonpath="):
+		path, value, hasValue := splitJsonPathCondition(strings.TrimPrefix(condition, "jsonpath="))
+		if len(path) == 0 {
+			return nil, fmt.Errorf("json path is missing in %q", condition)
+		}
+		return func(obj *unstructured.Unstructured) bool {
+			if obj == nil {
+				return false
+			}
+			data, err := json.Marshal(obj.Object)
+			if err != nil {
+				return false
+			}
+			res := gjson.GetBytes(data, path)
+			return res.Exists() && (!hasValue || res.String() == value)
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported wait condition %q, expected delete, condition=<type> or jsonpath=<path>=<value>", condition)
+	}
+}
+
+func hasCondition(obj *unstructured.Unstructured, conditionType, status string) bool {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if strings.EqualFold(fmt.Sprintf("%v", m["type"]), conditionType) {
+			return strings.EqualFold(fmt.Sprintf("%v", m["status"]), status)
+		}
+	}
+	return false
+}
+
+// splitJsonPathCondition converts kubectl json paths like '{.items[0].status}'=Ready to gjson paths
+func splitJsonPathCondition(spec string) (string, string, bool) {
+	spec = strings.NewReplacer("'", "", "\"", "").Replace(spec)
+	path, rest := spec, ""
+	if strings.HasPrefix(spec, "{") {
+		if i := strings.Index(spec, "}"); i > 0 {
+			path, rest = spec[1:i], spec[i+1:]
+		}
+	} else if i := strings.Index(spec, "="); i >= 0 {
+		path, rest = spec[:i], spec[i:]
+	}
+	path = jsonPathIndex.ReplaceAllString(strings.TrimPrefix(path, "."), ".$1")
+	if !strings.HasPrefix(rest, "=") {
+		return path, "", false
+	}
+	return path, rest[1:], true
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package language
+
+import (
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	parser2 "github.com/devtron-labs/inception/pkg/language/parser"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestKlangListener_handleWaitFor(t *testing.T) {
+	polls := 0
+	l := NewKlangListener(nil)
+	err := l.RegisterFunction("ready", func(args ...interface{}) (interface{}, error) {
+		polls++
+		return polls >= 3, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := `
+a = waitFor(ready(), 5s, 10ms);
+b = waitFor(false, 30ms, 10ms);
+try {
+  waitFor(1 > 2, 20ms);
+} catch (e) {
+  msg = e.message;
+}
+c = waitFor("done");
+`
+	start := time.Now()
+	p := parser2.NewKlangParser(antlr.NewCommonTokenStream(parser2.NewKlangLexer(antlr.NewInputStream(input)), antlr.TokenDefaultChannel))
+	l.Run(p.Parse())
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected waits to end at their timeout, took %s", elapsed)
+	}
+	if a := l.values["a"]; a.dataType != BOOLEAN || a.value != true || polls != 3 {
+		t.Errorf("expected true after 3 polls, found %+v after %d polls", a, polls)
+	}
+	if b := l.values["b"]; b.dataType != ERR {
+		t.Errorf("expected timeout error, found %+v", b)
+	}
+	if msg := l.values["msg"]; msg.dataType != STRING || msg.value != "waitFor timed out after 20ms at line 5" {
+		t.Errorf("expected timeout to be raised, found %+v", msg)
+	}
+	if c := l.values["c"]; c.dataType != BOOLEAN || c.value != true {
+		t.Errorf("expected true, found %+v", c)
+	}
+}
+
+func TestKlangListener_handleKubectlWait_invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "missing condition",
+			input: `x = kubectl wait deployment/web --timeout=10s;`,
+			err:   "exactly one --for condition",
+		},
+		{
+			name:  "unsupported condition",
+			input: `x = kubectl wait deployment/web -n apps --for=ready --timeout 10s;`,
+			err:   "unsupported wait condition",
+		},
+		{
+			name:  "invalid timeout",
+			input: `x = kubectl wait deployment/web --for "condition=Available" --timeout "soon";`,
+			err:   "wait --timeout",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := setup(tt.input)
+			x := r.values["x"]
+			if x.dataType != ERR || !strings.Contains(x.value.(error).Error(), tt.err) {
+				t.Errorf("expected error containing %q, found %+v", tt.err, x)
+			}
+		})
+	}
+}
+
+func Test_parseWaitCondition(t *testing.T) {
+	deployment := &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "Deployment",
+		"status": map[string]interface{}{
+			"readyReplicas": int64(2),
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Available", "status": "True"},
+				map[string]interface{}{"type": "Progressing", "status": "False"},
+			},
+			"containerStatuses": []interface{}{
+				map[string]interface{}{"name": "web", "ready": true},
+			},
+		},
+	}}
+	tests := []struct {
+		condition string
+		obj       *unstructured.Unstructured
+		want      bool
+		wantErr   bool
+	}{
+		{condition: "condition=Available", obj: deployment, want: true},
+		{condition: "condition=available", obj: deployment, want: true},
+		{condition: "condition=Progressing", obj: deployment, want: false},
+		{condition: "condition=Progressing=False", obj: deployment, want: true},
+		{condition: "condition=Complete", obj: deployment, want: false},
+		{condition: "condition=Available", obj: nil, want: false},
+		{condition: "delete", obj: deployment, want: false},
+		{condition: "delete", obj: nil, want: true},
+		{condition: "jsonpath={.status.readyReplicas}=2", obj: deployment, want: true},
+		{condition: "jsonpath='{.status.readyReplicas}'=3", obj: deployment, want: false},
+		{condition: "jsonpath={.status.containerStatuses[0].ready}=true", obj: deployment, want: true},
+		{condition: "jsonpath={.status.readyReplicas}", obj: deployment, want: true},
+		{condition: "jsonpath={.status.replicas}", obj: deployment, want: false},
+		{condition: "condition=", wantErr: true},
+		{condition: "ready", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.condition, func(t *testing.T) {
+			met, err := parseWaitCondition(tt.condition)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseWaitCondition() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := met(tt.obj); got != tt.want {
+				t.Errorf("parseWaitCondition(%q) = %v, want %v", tt.condition, got, tt.want)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package language
 import (
 	"github.com/tidwall/gjson"
 	"sigs.k8s.io/yaml"
+	"strings"
 )
 
 func YamlSelect(yml, pattern string) valHolder {
@@ -38,6 +39,41 @@ func YamlSelect(yml, pattern string) valHolder {
 	return vh
 }
 
+// YamlItems returns the documents of a multi document yaml, otherwise the elements of
+// a yaml sequence or the items of a kubernetes List
+func YamlItems(yml string) ([]valHolder, error) {
+	var items []valHolder
+	if strings.Contains(yml, yamlSeperator) {
+		for _, doc := range strings.Split(yml, yamlSeperator) {
+			if len(strings.TrimSpace(doc)) == 0 {
+				continue
+			}
+			items = append(items, newStringValHolder(doc))
+		}
+		return items, nil
+	}
+	json, err := yaml.YAMLToJSON([]byte(yml))
+	if err != nil {
+		return nil, err
+	}
+	arr, err := jsonArray(string(json))
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range arr.Array() {
+		vh := toValHolder(item)
+		if item.Type == gjson.JSON {
+			ry, err := yaml.JSONToYAML([]byte(item.Raw))
+			if err != nil {
+				return nil, err
+			}
+			vh.value = string(ry)
+		}
+		items = append(items, vh)
+	}
+	return items, nil
+}
+
 func YamlEdit(yml, pattern string, value interface{}) valHolder {
 	json, err := yaml.YAMLToJSON([]byte(yml))
 	if err != nil {
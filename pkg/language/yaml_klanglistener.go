@@ -35,7 +35,9 @@ func (l *KlangListener) handleYaml_select_fn(ctx parser.IYaml_select_fnContext)
 
 	patternLabel := yctx.String_or_id().(*parser.String_or_idContext)
 	pattern := l.GetTextFromStringOrId(patternLabel)
-	if data, ok := l.values[id]; ok && data.dataType == STRING && len(pattern) != 0 {
+	data, ok := l.getValue(id)
+	data = toDocument(data)
+	if ok && data.dataType == STRING && len(pattern) != 0 {
 		yamls := strings.Split(data.value.(string), yamlSeperator)
 		if index == -1 && len(yamls) != 1 {
 			return newErrHolder(fmt.Errorf("in case of multiyaml (len is %d) its important to define index of yaml for selection", len(yamls)))
@@ -50,17 +52,11 @@ func (l *KlangListener) handleYaml_select_fn(ctx parser.IYaml_select_fnContext)
 	return newNilValHolder()
 }
 
-// ExitYaml_delete_fn is called when production yaml_delete_fn is exited.
-func (l *KlangListener) ExitYaml_delete_fn(ctx *parser.Yaml_delete_fnContext) {
-	if l.ifWhileCount != 0 {
-		return
-	}
-	l.handleYaml_delete_fn(ctx)
-}
-
 func (l *KlangListener) handleYaml_delete_fn(ctx *parser.Yaml_delete_fnContext) valHolder {
-	yaml := l.values[ctx.ID().GetText()]
+	yaml, _ := l.getValue(ctx.ID().GetText())
 	yaml = l.getValIfID(yaml)
+	collection := isCollection(yaml)
+	yaml = toDocument(yaml)
 	if yaml.dataType != STRING || len(yaml.value.(string)) == 0 {
 		return newErrHolder(fmt.Errorf("yaml should be string of non zero length found %+v\n", yaml))
 	}
@@ -85,22 +81,18 @@ func (l *KlangListener) handleYaml_delete_fn(ctx *parser.Yaml_delete_fnContext)
 		}
 	}
 	yaml.value = strings.Join(outYamls, yamlSeperator)
-	l.values[yaml.name] = yaml
+	if err := l.assign(fromDocument(yaml, collection)); err != nil {
+		return newErrHolder(err)
+	}
 	//fmt.Printf("delete pattern %s, out %+v\n", pattern, yaml)
 	return newEmptyHolder()
 }
 
-// ExitYamledit_fn is called when production yamledit_fn is exited.
-func (l *KlangListener) ExitYaml_edit_fn(ctx *parser.Yaml_edit_fnContext) {
-	if l.ifWhileCount != 0 {
-		return
-	}
-	l.handleYaml_edit_fn(ctx)
-}
-
 func (l *KlangListener) handleYaml_edit_fn(ctx *parser.Yaml_edit_fnContext) valHolder {
-	yaml := l.values[ctx.ID().GetText()]
+	yaml, _ := l.getValue(ctx.ID().GetText())
 	yaml = l.getValIfID(yaml)
+	collection := isCollection(yaml)
+	yaml = toDocument(yaml)
 	if yaml.dataType != STRING || len(yaml.value.(string)) == 0 {
 		return newErrHolder(fmt.Errorf("yaml should be string of non zero length found %+v\n", yaml))
 	}
@@ -112,6 +104,9 @@ func (l *KlangListener) handleYaml_edit_fn(ctx *parser.Yaml_edit_fnContext) valH
 		return newErrHolder(fmt.Errorf("value cannot be nil"))
 	}
 	val := valVh.value
+	if isCollection(valVh) {
+		val = toNative(valVh)
+	}
 	index := -1
 	if ctx.NUMBER() != nil && len(ctx.NUMBER().GetText()) > 0 {
 		i, err := strconv.Atoi(ctx.NUMBER().GetText())
@@ -130,7 +125,9 @@ func (l *KlangListener) handleYaml_edit_fn(ctx *parser.Yaml_edit_fnContext) valH
 		}
 	}
 	yaml.value = strings.Join(outYamls, yamlSeperator)
-	l.values[yaml.name] = yaml
+	if err := l.assign(fromDocument(yaml, collection)); err != nil {
+		return newErrHolder(err)
+	}
 	//fmt.Printf("val %+v, pattern %s, out %+v\n", val, pattern, yaml)
 	return newEmptyHolder()
 }
@@ -0,0 +1,141 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lsp
+
+import (
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/devtron-labs/inception/pkg/language"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// completion offers the kubectl verbs after kubectl, otherwise the keywords, the functions and the variables,
+// functions and modules of the script starting with the word before p. Nothing is offered in strings and comments.
+func (d *document) completion(p Position) CompletionList {
+	list := CompletionList{Items: make([]CompletionItem, 0)}
+	if t := d.tokenAt(p); t != nil && d.inText(t, p) {
+		return list
+	}
+	line, column := d.column(p)
+	prefix, start := d.wordBefore(line, column)
+	if start > 0 && []rune(d.lines[line-1])[start-1] == '.' {
+		return list
+	}
+	if prev := d.tokenBefore(line, start); prev != nil && prev.GetTokenType() == parser.KlangParserKUBECTL {
+		for verb, doc := range kubectlDocs {
+			list.Items = append(list.Items, completionItem(verb, CompletionItemKindKeyword, doc))
+		}
+		return filterItems(list, prefix)
+	}
+	for keyword, doc := range keywordDocs {
+		list.Items = append(list.Items, completionItem(keyword, CompletionItemKindKeyword, doc))
+	}
+	for name, doc := range functionDocs {
+		list.Items = append(list.Items, completionItem(name, CompletionItemKindFunction, doc))
+	}
+	//builtins registered later may not be documented yet
+	for _, name := range language.BuiltinNames() {
+		if _, ok := functionDocs[name]; !ok {
+			list.Items = append(list.Items, CompletionItem{Label: name, Kind: CompletionItemKindFunction})
+		}
+	}
+	seen := make(map[string]bool)
+	for _, sym := range d.index.symbols() {
+		if seen[sym.name] {
+			continue
+		}
+		seen[sym.name] = true
+		item := CompletionItem{Label: sym.name, Kind: CompletionItemKindVariable, Detail: d.symbolDetail(sym)}
+		switch sym.kind {
+		case functionSymbol:
+			item.Kind = CompletionItemKindFunction
+		case moduleSymbol:
+			item.Kind = CompletionItemKindModule
+		}
+		list.Items = append(list.Items, item)
+	}
+	return filterItems(list, prefix)
+}
+
+func completionItem(label string, kind CompletionItemKind, doc doc) CompletionItem {
+	return CompletionItem{
+		Label:         label,
+		Kind:          kind,
+		Detail:        strings.Split(doc.signature, "\n")[0],
+		Documentation: &MarkupContent{Kind: "markdown", Value: doc.text},
+	}
+}
+
+// filterItems keeps the items starting with prefix sorted by label
+func filterItems(list CompletionList, prefix string) CompletionList {
+	items := make([]CompletionItem, 0, len(list.Items))
+	for _, item := range list.Items {
+		if strings.HasPrefix(item.Label, prefix) {
+			items = append(items, item)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Label < items[j].Label
+	})
+	list.Items = items
+	return list
+}
+
+// inText is true if p is in a comment or inside the quotes of a string
+func (d *document) inText(t antlr.Token, p Position) bool {
+	r := d.tokenRange(t)
+	switch t.GetTokenType() {
+	case parser.KlangLexerCOMMENT:
+		return less(r.Start, p)
+	case parser.KlangLexerSTRING, parser.KlangLexerRAW_STRING_LIT:
+		return less(r.Start, p) && less(p, r.End)
+	}
+	return false
+}
+
+// wordBefore returns the identifier ending at the column and the column it starts at
+func (d *document) wordBefore(line int, column int) (string, int) {
+	if line < 1 || line > len(d.lines) {
+		return "", column
+	}
+	runes := []rune(d.lines[line-1])
+	if column > len(runes) {
+		column = len(runes)
+	}
+	start := column
+	for start > 0 && (unicode.IsLetter(runes[start-1]) || unicode.IsDigit(runes[start-1]) || runes[start-1] == '_') {
+		start--
+	}
+	return string(runes[start:column]), start
+}
+
+// tokenBefore returns the last token of the parser ending before the line and column
+func (d *document) tokenBefore(line int, column int) antlr.Token {
+	end := d.position(line, column)
+	var before antlr.Token
+	for _, t := range d.tokens {
+		if t.GetTokenType() == antlr.TokenEOF || less(end, d.tokenRange(t).End) {
+			break
+		}
+		if t.GetChannel() == antlr.TokenDefaultChannel {
+			before = t
+		}
+	}
+	return before
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lsp
+
+// doc describes a keyword, a built-in function or a kubectl verb for hover and completion
+type doc struct {
+	signature string
+	text      string
+}
+
+// keywordDocs covers the statements and literals of the language
+var keywordDocs = map[string]doc{
+	"if":       {"if condition { ... } else if condition { ... } else { ... }", "Runs the block of the first condition which is true."},
+	"else":     {"else { ... }", "Runs when no condition of the if statement is true."},
+	"while":    {"while condition { ... }", "Runs the block as long as the condition is true."},
+	"for":      {"for item in list { ... }\nfor index, item in list { ... }", "Runs the block for every item of a list, json array or yaml sequence."},
+	"in":       {"for item in list { ... }", "Separates the loop variables from the collection of a for loop."},
+	"func":     {"func name(a, b) { ... }", "Declares a function, it can be called after the declaration and by other functions and sees the global variables."},
+	"return":   {"return value;", "Ends the function and returns the value."},
+	"break":    {"break;", "Ends the innermost while or for loop."},
+	"try":      {"try { ... } catch (e) { ... } finally { ... }", "Runs the block, errors raised in it are passed to catch and finally always runs."},
+	"catch":    {"catch (e) { ... }", "Handles an error raised in the try block, e is a map with the message and the line of the error."},
+	"finally":  {"finally { ... }", "Runs after the try and catch blocks whether they failed or not."},
+	"throw":    {"throw message;", "Raises an error, it ends the script unless it is caught."},
+	"import":   {"import \"path\" as name;", "Loads a module, its functions and variables are used as name.member."},
+	"as":       {"import \"path\" as name;", "Names an imported module."},
+	"let":      {"let name = value;", "Declares a variable in the current block."},
+	"const":    {"const name = value;", "Declares a variable of the current block which cannot be assigned again."},
+	"timeout":  {"timeout 5m { ... }", "Raises an error if the block runs longer than the duration."},
+	"log":      {"log value;", "Prints the value, collections are printed as json."},
+	"exit":     {"exit code;", "Ends the script with the exit code, codes other than 0 mark the run as failed."},
+	"sleep":    {"sleep duration;", "Waits for the duration, like 10s or 1m30s."},
+	"stepInfo": {"stepInfo \"name\";", "Starts a step of the script, the steps are reported in the status of the installer."},
+	"true":     {"true", "The boolean true."},
+	"false":    {"false", "The boolean false."},
+	"nil":      {"nil", "No value."},
+	"kubectl": {"kubectl verb arguments", "Runs a kubectl command against the cluster, the verbs are apply, create, replace, patch, " +
+		"get, delete, scale, wait and rollout."},
+	"filter":  {"filter=\"group/Kind/namespace/name\"", "Selects the kubernetes objects edited or deleted by kubeJsonDelete and kubeYamlDelete."},
+	"pattern": {"pattern=\"path\"", "Selects the path deleted by kubeJsonDelete and kubeYamlDelete."},
+}
+
+// functionDocs covers the functions with a syntax of their own and the built-in functions
+var functionDocs = map[string]doc{
+	"jsonSelect":     {"jsonSelect(doc, path)", "Returns the value at path of the json document in the variable doc, paths use the gjson syntax like spec.containers.0.image."},
+	"jsonEdit":       {"jsonEdit(doc, path, value);", "Sets the value at path of the json document in the variable doc."},
+	"jsonDelete":     {"jsonDelete(doc, path);", "Deletes the value at path of the json document in the variable doc."},
+	"yamlSelect":     {"yamlSelect(doc, path, index)", "Returns the value at path of the yaml document in the variable doc, index selects a document of a multi-document yaml."},
+	"yamlEdit":       {"yamlEdit(doc, path, value, index);", "Sets the value at path of the yaml document in the variable doc, index selects a document of a multi-document yaml."},
+	"yamlDelete":     {"yamlDelete(doc, path, index);", "Deletes the value at path of the yaml document in the variable doc."},
+	"kubeJsonEdit":   {"kubeJsonEdit(doc, path, value, filter, \"asObject\");", "Sets the value at path of the kubernetes objects in the json of the variable doc, filter like group/Kind/namespace/name selects the objects of a list. With \"asObject\" a string value is parsed as json or yaml."},
+	"kubeJsonDelete": {"kubeJsonDelete(doc, filter=\"group/Kind/namespace/name\", pattern=\"path\");", "Deletes the objects selected by filter or the path of every object from the json of the variable doc."},
+	"kubeYamlEdit":   {"kubeYamlEdit(doc, path, value, filter, \"asObject\");", "Sets the value at path of the kubernetes objects in the yaml of the variable doc, filter like group/Kind/namespace/name selects the objects. With \"asObject\" a string value is parsed as json or yaml."},
+	"kubeYamlDelete": {"kubeYamlDelete(doc, filter=\"group/Kind/namespace/name\", pattern=\"path\");", "Deletes the objects selected by filter or the path of every object from the yaml of the variable doc."},
+	"shellScript":    {"shellScript script", "Runs the script with sh and returns its output."},
+	"download":       {"download(url, file)", "Downloads url to file, or to a file named after the hash of url, and returns the content."},
+	"load":           {"name = load(path);", "Assigns the content of a file, it is empty if the file cannot be read."},
+	"waitFor":        {"waitFor(condition, timeout, interval)", "Evaluates the condition every interval, 1s by default, until it is true and raises an error after the timeout, 30s by default."},
+	"len":            {"len(x)", "The number of characters of a string or the number of items of a list or map."},
+	"split":          {"split(s, sep)", "Splits s into a list around sep."},
+	"join":           {"join(list, sep)", "Joins the items of a list with sep, list can also be a json or yaml array."},
+	"contains":       {"contains(s, substr)\ncontains(list, item)", "Whether s contains substr or list contains item."},
+	"hasPrefix":      {"hasPrefix(s, prefix)", "Whether s starts with prefix."},
+	"hasSuffix":      {"hasSuffix(s, suffix)", "Whether s ends with suffix."},
+	"replace":        {"replace(s, old, new)", "Replaces all occurrences of old in s."},
+	"regexMatch":     {"regexMatch(s, pattern)", "Whether s matches the regular expression."},
+	"regexReplace":   {"regexReplace(s, pattern, replacement)", "Replaces the matches of the regular expression, replacement can refer to groups as $1 or ${name}."},
+	"trim":           {"trim(s)\ntrim(s, cutset)", "Removes leading and trailing white space or the characters in cutset."},
+	"upper":          {"upper(s)", "Converts s to upper case."},
+	"lower":          {"lower(s)", "Converts s to lower case."},
+	"substr":         {"substr(s, start)\nsubstr(s, start, end)", "The characters of s from start to end, end is exclusive."},
+	"format":         {"format(format, args...)", "Formats the arguments like fmt.Sprintf."},
+	"base64Encode":   {"base64Encode(s)", "Standard padded base64 as expected in the data of kubernetes secrets."},
+	"base64Decode":   {"base64Decode(s)", "Decodes standard padded base64."},
+	"sha256":         {"sha256(s)", "The hex encoded sha256 checksum of s."},
+	"sha1":           {"sha1(s)", "The hex encoded sha1 checksum of s."},
+	"hexEncode":      {"hexEncode(s)", "Encodes s as hex."},
+	"urlEncode":      {"urlEncode(s)", "Escapes s for use in a url query."},
+	"toJson":         {"toJson(x)", "The json of any value."},
+	"fromJson":       {"fromJson(s)", "Parses json into the corresponding value."},
+}
+
+// kubectlDocs covers the verbs following kubectl
+var kubectlDocs = map[string]doc{
+	"apply":   {"kubectl apply [-n namespace] manifest... [-u config]", "Applies the manifests and returns whether every object was applied, config is merged into objects of the same kind and name."},
+	"create":  {"kubectl create [-n namespace] manifest... [-u config]", "Creates the objects of the manifests."},
+	"replace": {"kubectl replace [-n namespace] manifest... [-u config]", "Replaces the objects of the manifests."},
+	"patch":   {"kubectl patch [-n namespace] kind/name [--type type] -p patch", "Patches an object, the type is the content type of the patch like application/merge-patch+json."},
+	"get":     {"kubectl get [-n namespace | -A] kind/name [-l selector] [--field-selector selector] [-o format]", "Returns an object or a list of objects as json or in the output format."},
+	"delete":  {"kubectl delete [-n namespace] kind/name [-l selector] [--all]", "Deletes the objects."},
+	"scale":   {"kubectl scale [-n namespace] kind/name --replicas=n", "Sets the number of replicas of a workload."},
+	"wait":    {"kubectl wait [-n namespace] kind/name --for=condition=Ready [--timeout=duration]", "Waits until the objects have the condition, are deleted or the jsonpath has the value."},
+	"rollout": {"kubectl rollout status|restart [-n namespace] kind/name [--timeout=duration]", "Waits until a rollout is complete or restarts the pods of a workload."},
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lsp
+
+import (
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/devtron-labs/inception/pkg/language"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+const diagnosticSource = "klang"
+
+// document is an open script, it is parsed again on every change. Lines of the parser start at 1 and its
+// columns count characters while the protocol counts lines from 0 and columns in utf-16 code units.
+type document struct {
+	uri          string
+	lines        []string
+	tokens       []antlr.Token
+	tree         parser.IParseContext
+	syntaxErrors []language.SyntaxError
+	index        *index
+}
+
+func newDocument(uri string, text string) *document {
+	tokens, tree, syntaxErrors := language.ParseTokens(text)
+	d := &document{
+		uri:          uri,
+		lines:        strings.Split(text, "\n"),
+		tokens:       tokens,
+		tree:         tree,
+		syntaxErrors: syntaxErrors,
+	}
+	d.index = newIndex(tree)
+	return d
+}
+
+// diagnostics are the syntax errors, the mistakes found by the checker are added if there are none
+func (d *document) diagnostics() []Diagnostic {
+	diagnostics := make([]Diagnostic, 0)
+	for _, e := range d.syntaxErrors {
+		diagnostics = append(diagnostics, Diagnostic{
+			Range:    d.errorRange(e.Line, e.Column),
+			Severity: DiagnosticSeverityError,
+			Source:   diagnosticSource,
+			Message:  e.Message,
+		})
+	}
+	if len(d.syntaxErrors) != 0 {
+		return diagnostics
+	}
+	for _, c := range language.NewKlangListener(nil).Check(d.tree) {
+		severity := DiagnosticSeverityError
+		if c.Severity == language.SeverityWarning {
+			severity = DiagnosticSeverityWarning
+		}
+		diagnostics = append(diagnostics, Diagnostic{
+			Range:    d.errorRange(c.Line, c.Column),
+			Severity: severity,
+			Source:   diagnosticSource,
+			Message:  c.Message,
+		})
+	}
+	return diagnostics
+}
+
+// errorRange covers the token starting at the position of an error or a single character
+func (d *document) errorRange(line int, column int) Range {
+	for _, t := range d.tokens {
+		if t.GetLine() == line && t.GetColumn() == column && t.GetTokenType() != antlr.TokenEOF {
+			return d.tokenRange(t)
+		}
+	}
+	start := d.position(line, column)
+	return Range{Start: start, End: d.position(line, column+1)}
+}
+
+// position converts a line and column of the parser
+func (d *document) position(line int, column int) Position {
+	if line < 1 || line > len(d.lines) {
+		return Position{Line: line - 1, Character: column}
+	}
+	runes := []rune(d.lines[line-1])
+	if column > len(runes) {
+		column = len(runes)
+	}
+	return Position{Line: line - 1, Character: len(utf16.Encode(runes[:column]))}
+}
+
+// column converts a position of the protocol to a line and column of the parser
+func (d *document) column(p Position) (int, int) {
+	if p.Line < 0 || p.Line >= len(d.lines) {
+		return p.Line + 1, p.Character
+	}
+	units := utf16.Encode([]rune(d.lines[p.Line]))
+	if p.Character < len(units) {
+		units = units[:p.Character]
+	}
+	return p.Line + 1, len(utf16.Decode(units))
+}
+
+func (d *document) tokenRange(t antlr.Token) Range {
+	text := t.GetText()
+	end := d.position(t.GetLine(), t.GetColumn()+utf8.RuneCountInString(text))
+	if i := strings.LastIndex(text, "\n"); i >= 0 {
+		line := t.GetLine() + strings.Count(text, "\n")
+		end = d.position(line, utf8.RuneCountInString(text[i+1:]))
+	}
+	return Range{Start: d.position(t.GetLine(), t.GetColumn()), End: end}
+}
+
+// tokenAt returns the token under p, a token ending at p is returned if no token starts there so
+// that a word can be completed or hovered with the cursor right after it
+func (d *document) tokenAt(p Position) antlr.Token {
+	var before antlr.Token
+	for _, t := range d.tokens {
+		if t.GetTokenType() == antlr.TokenEOF {
+			break
+		}
+		r := d.tokenRange(t)
+		if less(p, r.Start) {
+			break
+		}
+		if less(p, r.End) {
+			return t
+		}
+		if r.End == p {
+			before = t
+		}
+	}
+	return before
+}
+
+func less(a Position, b Position) bool {
+	return a.Line < b.Line || (a.Line == b.Line && a.Character < b.Character)
+}
+
+// ruleRange covers a rule from its first to its last token
+func (d *document) ruleRange(ctx antlr.ParserRuleContext) Range {
+	start := d.tokenRange(ctx.GetStart()).Start
+	stop := ctx.GetStop()
+	if stop == nil || stop.GetTokenIndex() < ctx.GetStart().GetTokenIndex() {
+		return Range{Start: start, End: start}
+	}
+	return Range{Start: start, End: d.tokenRange(stop).End}
+}
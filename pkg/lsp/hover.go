@@ -0,0 +1,109 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lsp
+
+import (
+	"fmt"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+	"strings"
+)
+
+// hover describes the variable, function or module under p or documents the keyword, the built-in
+// function or the kubectl verb
+func (d *document) hover(p Position) *Hover {
+	t := d.tokenAt(p)
+	if t == nil {
+		return nil
+	}
+	r := d.tokenRange(t)
+	if sym, ok := d.index.refs[t.GetTokenIndex()]; ok {
+		return &Hover{Contents: MarkupContent{Kind: "markdown", Value: d.symbolDoc(sym)}, Range: &r}
+	}
+	text := t.GetText()
+	var found bool
+	var doc doc
+	switch t.GetTokenType() {
+	case parser.KlangParserSTRING, parser.KlangParserRAW_STRING_LIT, parser.KlangParserCOMMENT, parser.KlangParserNUMBER:
+	case parser.KlangParserID:
+		doc, found = functionDocs[text]
+	default:
+		if prev := d.tokenBefore(t.GetLine(), t.GetColumn()); prev != nil && prev.GetTokenType() == parser.KlangParserKUBECTL {
+			doc, found = kubectlDocs[text]
+		}
+		if !found {
+			doc, found = functionDocs[text]
+		}
+		if !found {
+			doc, found = keywordDocs[text]
+		}
+	}
+	if !found {
+		return nil
+	}
+	return &Hover{Contents: MarkupContent{Kind: "markdown", Value: markdown(doc.signature, doc.text)}, Range: &r}
+}
+
+// definition returns the location where the variable, function or module under p is defined
+func (d *document) definition(p Position) []Location {
+	locations := make([]Location, 0)
+	t := d.tokenAt(p)
+	if t == nil {
+		return locations
+	}
+	if sym, ok := d.index.refs[t.GetTokenIndex()]; ok {
+		locations = append(locations, Location{URI: d.uri, Range: d.tokenRange(sym.token)})
+	}
+	return locations
+}
+
+func markdown(code string, text string) string {
+	return "```klang\n" + code + "\n```\n" + text
+}
+
+func (d *document) symbolDoc(sym *symbol) string {
+	line := sym.token.GetLine()
+	switch sym.kind {
+	case functionSymbol:
+		return markdown(d.symbolDetail(sym), fmt.Sprintf("function declared at line %d", line))
+	case parameterSymbol:
+		return markdown(d.symbolDetail(sym), fmt.Sprintf("parameter of %s", sym.fn))
+	case moduleSymbol:
+		return markdown(d.symbolDetail(sym), fmt.Sprintf("module imported at line %d", line))
+	}
+	if len(sym.fn) != 0 {
+		return markdown(d.symbolDetail(sym), fmt.Sprintf("variable of %s, defined at line %d", sym.fn, line))
+	}
+	return markdown(d.symbolDetail(sym), fmt.Sprintf("variable defined at line %d", line))
+}
+
+// symbolDetail is the signature of a function or the line defining a variable or module
+func (d *document) symbolDetail(sym *symbol) string {
+	if fd, ok := sym.decl.(*parser.Func_declContext); ok {
+		var params []string
+		if fd.Param_list() != nil {
+			for _, param := range fd.Param_list().(*parser.Param_listContext).AllID() {
+				params = append(params, param.GetText())
+			}
+		}
+		return "func " + fd.ID().GetText() + "(" + strings.Join(params, ", ") + ")"
+	}
+	line := sym.decl.GetStart().GetLine()
+	if line < 1 || line > len(d.lines) {
+		return sym.name
+	}
+	return strings.TrimSpace(d.lines[line-1])
+}
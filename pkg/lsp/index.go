@@ -0,0 +1,258 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lsp
+
+import (
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+	"strings"
+)
+
+type symbolKind int
+
+const (
+	variableSymbol symbolKind = iota
+	parameterSymbol
+	functionSymbol
+	moduleSymbol
+)
+
+// symbol is a variable, parameter, function or module defined in the script
+type symbol struct {
+	name  string
+	kind  symbolKind
+	token antlr.Token
+	//decl is the rule defining the symbol, its text is shown on hover
+	decl antlr.ParserRuleContext
+	//fn is the function of a parameter or a local variable
+	fn string
+}
+
+// index links the tokens referring to a symbol to its definition. It follows the scoping of the listener:
+// blocks have their own scope, an assignment declares a variable in the outermost scope of the script or
+// of the function unless the variable is visible already and functions see the globals but not the
+// scopes they are called from.
+type index struct {
+	refs    map[int]*symbol
+	funcs   map[string]*symbol
+	globals map[string]*symbol
+	scopes  []map[string]*symbol
+	fn      string
+	//unresolved are references to globals assigned later, functions may be called after they are assigned
+	unresolved map[int]string
+}
+
+func newIndex(tree parser.IParseContext) *index {
+	i := &index{
+		refs:       make(map[int]*symbol),
+		funcs:      make(map[string]*symbol),
+		globals:    make(map[string]*symbol),
+		unresolved: make(map[int]string),
+	}
+	i.scopes = []map[string]*symbol{i.globals}
+	pc, ok := tree.(*parser.ParseContext)
+	if !ok || pc.Block() == nil {
+		return i
+	}
+	i.collect(pc)
+	i.visitChildren(pc.Block())
+	for index, name := range i.unresolved {
+		if sym, ok := i.globals[name]; ok {
+			i.refs[index] = sym
+		}
+	}
+	return i
+}
+
+// collect finds the functions first as they can be called before they are declared
+func (i *index) collect(tree antlr.Tree) {
+	if fd, ok := tree.(*parser.Func_declContext); ok && fd.ID() != nil {
+		if _, ok := i.funcs[fd.ID().GetText()]; !ok {
+			i.funcs[fd.ID().GetText()] = &symbol{name: fd.ID().GetText(), kind: functionSymbol, token: fd.ID().GetSymbol(), decl: fd}
+		}
+	}
+	for _, child := range tree.GetChildren() {
+		i.collect(child)
+	}
+}
+
+// symbols returns every symbol defined in the script
+func (i *index) symbols() []*symbol {
+	seen := make(map[*symbol]bool)
+	var symbols []*symbol
+	for _, sym := range i.refs {
+		if !seen[sym] {
+			seen[sym] = true
+			symbols = append(symbols, sym)
+		}
+	}
+	for _, sym := range i.funcs {
+		if !seen[sym] {
+			seen[sym] = true
+			symbols = append(symbols, sym)
+		}
+	}
+	return symbols
+}
+
+func (i *index) pushScope() {
+	i.scopes = append(i.scopes, make(map[string]*symbol))
+}
+
+func (i *index) popScope() {
+	i.scopes = i.scopes[:len(i.scopes)-1]
+}
+
+func (i *index) lookup(name string) *symbol {
+	for j := len(i.scopes) - 1; j >= 0; j-- {
+		if sym, ok := i.scopes[j][name]; ok {
+			return sym
+		}
+	}
+	if len(i.fn) != 0 {
+		return i.globals[name]
+	}
+	return nil
+}
+
+func (i *index) declare(scope map[string]*symbol, id antlr.TerminalNode, kind symbolKind, decl antlr.ParserRuleContext) {
+	if id == nil {
+		return
+	}
+	sym := &symbol{name: id.GetText(), kind: kind, token: id.GetSymbol(), decl: decl, fn: i.fn}
+	scope[sym.name] = sym
+	i.refs[sym.token.GetTokenIndex()] = sym
+}
+
+func (i *index) reference(token antlr.Token, name string) {
+	if sym := i.lookup(name); sym != nil {
+		i.refs[token.GetTokenIndex()] = sym
+	} else if len(i.fn) != 0 {
+		i.unresolved[token.GetTokenIndex()] = name
+	}
+}
+
+func (i *index) visit(tree antlr.Tree) {
+	switch v := tree.(type) {
+	case *parser.AssignmentContext:
+		i.visitRules(v)
+		if v.ID() == nil {
+			return
+		}
+		if v.LET() != nil || v.CONST() != nil {
+			i.declare(i.scopes[len(i.scopes)-1], v.ID(), variableSymbol, v)
+		} else if sym := i.lookup(v.ID().GetText()); sym != nil {
+			i.refs[v.ID().GetSymbol().GetTokenIndex()] = sym
+		} else {
+			i.declare(i.scopes[0], v.ID(), variableSymbol, v)
+		}
+	case *parser.Func_declContext:
+		if v.ID() == nil {
+			return
+		}
+		if sym, ok := i.funcs[v.ID().GetText()]; ok {
+			i.refs[v.ID().GetSymbol().GetTokenIndex()] = sym
+		}
+		scopes, fn := i.scopes, i.fn
+		i.fn = v.ID().GetText()
+		i.scopes = []map[string]*symbol{make(map[string]*symbol)}
+		if v.Param_list() != nil {
+			for _, param := range v.Param_list().(*parser.Param_listContext).AllID() {
+				i.declare(i.scopes[0], param, parameterSymbol, v)
+			}
+		}
+		if v.Block() != nil {
+			i.visit(v.Block())
+		}
+		i.scopes, i.fn = scopes, fn
+	case *parser.For_statContext:
+		if v.Expr() != nil {
+			i.visit(v.Expr())
+		}
+		i.pushScope()
+		for _, id := range v.AllID() {
+			i.declare(i.scopes[len(i.scopes)-1], id, variableSymbol, v)
+		}
+		if v.Stat_block() != nil {
+			i.visit(v.Stat_block())
+		}
+		i.popScope()
+	case *parser.Catch_clauseContext:
+		i.pushScope()
+		i.declare(i.scopes[len(i.scopes)-1], v.ID(), variableSymbol, v)
+		if v.Block() != nil {
+			i.visit(v.Block())
+		}
+		i.popScope()
+	case *parser.Import_statContext:
+		i.visitRules(v)
+		i.declare(i.scopes[len(i.scopes)-1], v.ID(), moduleSymbol, v)
+	case *parser.BlockContext:
+		i.pushScope()
+		i.visitChildren(v)
+		i.popScope()
+	case *parser.Stat_blockContext:
+		if v.Block() != nil {
+			i.visit(v.Block())
+		} else if v.Stat() != nil {
+			i.pushScope()
+			i.visit(v.Stat())
+			i.popScope()
+		}
+	default:
+		i.visitChildren(tree)
+	}
+}
+
+// visitRules visits the rules of tree but not its tokens
+func (i *index) visitRules(tree antlr.Tree) {
+	for _, child := range tree.GetChildren() {
+		if _, ok := child.(antlr.TerminalNode); !ok {
+			i.visit(child)
+		}
+	}
+}
+
+// visitChildren visits the rules of tree and records its identifiers referring to variables or functions
+func (i *index) visitChildren(tree antlr.Tree) {
+	for _, child := range tree.GetChildren() {
+		tn, ok := child.(antlr.TerminalNode)
+		if !ok {
+			i.visit(child)
+			continue
+		}
+		token := tn.GetSymbol()
+		switch token.GetTokenType() {
+		case parser.KlangParserID:
+			switch tree.(type) {
+			case *parser.Map_entryContext, *parser.MemberExprContext:
+			case *parser.Func_nameContext:
+				if sym, ok := i.funcs[token.GetText()]; ok {
+					i.refs[token.GetTokenIndex()] = sym
+				}
+			default:
+				i.reference(token, token.GetText())
+			}
+		case parser.KlangParserPATH:
+			//members like m.a are written as a single token, the name of the variable comes first
+			switch tree.(type) {
+			case *parser.PathAtomContext, *parser.Func_nameContext:
+				i.reference(token, strings.SplitN(token.GetText(), ".", 2)[0])
+			}
+		}
+	}
+}
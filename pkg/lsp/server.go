@@ -0,0 +1,203 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package lsp is a language server for Klang scripts. It publishes the syntax errors and the mistakes found
+// by the checker, completes keywords, functions and variables, documents them on hover, finds the
+// definition of variables and functions and outlines the stepInfo sections of a script.
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+const serverName = "klang"
+
+// Server speaks the language server protocol over a stream like stdio, documents are synchronized in full
+type Server struct {
+	in       *bufio.Reader
+	out      io.Writer
+	docs     map[string]*document
+	shutdown bool
+}
+
+func NewServer(in io.Reader, out io.Writer) *Server {
+	return &Server{
+		in:   bufio.NewReader(in),
+		out:  out,
+		docs: make(map[string]*document),
+	}
+}
+
+// Run handles messages until the exit notification or the end of the input, it fails if the client exits
+// without asking for a shutdown first or the stream is broken
+func (s *Server) Run() error {
+	for {
+		content, err := readMessage(s.in)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var req request
+		if err := json.Unmarshal(content, &req); err != nil {
+			if err := s.respond(nil, nil, &rpcError{Code: codeParseError, Message: err.Error()}); err != nil {
+				return err
+			}
+			continue
+		}
+		if req.Method == "exit" {
+			if !s.shutdown {
+				return fmt.Errorf("exit before shutdown")
+			}
+			return nil
+		}
+		result, rerr := s.handle(req)
+		if req.ID == nil {
+			continue
+		}
+		if err := s.respond(req.ID, result, rerr); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) respond(id *json.RawMessage, result interface{}, err *rpcError) error {
+	if err != nil {
+		return writeMessage(s.out, errorResponse{JSONRPC: "2.0", ID: id, Error: err})
+	}
+	return writeMessage(s.out, response{JSONRPC: "2.0", ID: id, Result: result})
+}
+
+func (s *Server) notify(method string, params interface{}) *rpcError {
+	if err := writeMessage(s.out, notification{JSONRPC: "2.0", Method: method, Params: params}); err != nil {
+		return &rpcError{Code: codeInvalidRequest, Message: err.Error()}
+	}
+	return nil
+}
+
+// handle runs a request or notification, unknown notifications are ignored
+func (s *Server) handle(req request) (interface{}, *rpcError) {
+	if s.shutdown {
+		return nil, &rpcError{Code: codeInvalidRequest, Message: "the server is shut down"}
+	}
+	switch req.Method {
+	case "initialize":
+		return InitializeResult{
+			Capabilities: ServerCapabilities{
+				TextDocumentSync:       TextDocumentSyncKindFull,
+				CompletionProvider:     &CompletionOptions{},
+				HoverProvider:          true,
+				DefinitionProvider:     true,
+				DocumentSymbolProvider: true,
+			},
+			ServerInfo: ServerInfo{Name: serverName},
+		}, nil
+	case "initialized":
+		return nil, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "textDocument/didOpen":
+		var params DidOpenTextDocumentParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+		return nil, s.open(params.TextDocument.URI, params.TextDocument.Text)
+	case "textDocument/didChange":
+		var params DidChangeTextDocumentParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+		if len(params.ContentChanges) == 0 {
+			return nil, nil
+		}
+		return nil, s.open(params.TextDocument.URI, params.ContentChanges[len(params.ContentChanges)-1].Text)
+	case "textDocument/didClose":
+		var params DidCloseTextDocumentParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+		delete(s.docs, params.TextDocument.URI)
+		return nil, s.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{URI: params.TextDocument.URI, Diagnostics: make([]Diagnostic, 0)})
+	case "textDocument/completion":
+		d, p, err := s.position(req)
+		if err != nil {
+			return nil, err
+		}
+		return d.completion(p), nil
+	case "textDocument/hover":
+		d, p, err := s.position(req)
+		if err != nil {
+			return nil, err
+		}
+		return d.hover(p), nil
+	case "textDocument/definition":
+		d, p, err := s.position(req)
+		if err != nil {
+			return nil, err
+		}
+		return d.definition(p), nil
+	case "textDocument/documentSymbol":
+		var params DocumentSymbolParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+		d, err := s.document(params.TextDocument.URI)
+		if err != nil {
+			return nil, err
+		}
+		return d.documentSymbols(), nil
+	}
+	if req.ID == nil {
+		return nil, nil
+	}
+	return nil, &rpcError{Code: codeMethodNotFound, Message: fmt.Sprintf("method %s is not supported", req.Method)}
+}
+
+// open parses the text of a document and publishes its diagnostics
+func (s *Server) open(uri string, text string) *rpcError {
+	d := newDocument(uri, text)
+	s.docs[uri] = d
+	return s.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{URI: uri, Diagnostics: d.diagnostics()})
+}
+
+func (s *Server) document(uri string) (*document, *rpcError) {
+	d, ok := s.docs[uri]
+	if !ok {
+		return nil, &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("document %s is not open", uri)}
+	}
+	return d, nil
+}
+
+func (s *Server) position(req request) (*document, Position, *rpcError) {
+	var params TextDocumentPositionParams
+	if err := unmarshalParams(req, &params); err != nil {
+		return nil, Position{}, err
+	}
+	d, err := s.document(params.TextDocument.URI)
+	return d, params.Position, err
+}
+
+func unmarshalParams(req request, params interface{}) *rpcError {
+	if err := json.Unmarshal(req.Params, params); err != nil {
+		return &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("invalid params of %s, %v", req.Method, err)}
+	}
+	return nil
+}
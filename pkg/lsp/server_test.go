@@ -0,0 +1,353 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+)
+
+const testURI = "file:///scripts/install.k"
+
+const testScript = `import "lib.k" as lib;
+stepInfo "prepare";
+replicas = 2;
+func double(n) {
+  return n * replicas;
+}
+stepInfo "install";
+if replicas > 1 {
+  d = kubectl get deploy/web;
+  kubeYamlEdit(d, "spec.replicas", double(replicas));
+}
+x = len(lib.version());
+`
+
+type testClient struct {
+	input bytes.Buffer
+	id    int
+}
+
+func (c *testClient) send(method string, params interface{}, request bool) int {
+	message := map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params}
+	if request {
+		c.id++
+		message["id"] = c.id
+	}
+	if err := writeMessage(&c.input, message); err != nil {
+		panic(err)
+	}
+	return c.id
+}
+
+func (c *testClient) at(method string, line int, character int) int {
+	return c.send(method, TextDocumentPositionParams{
+		TextDocument: TextDocumentIdentifier{URI: testURI},
+		Position:     Position{Line: line, Character: character},
+	}, true)
+}
+
+type testMessage struct {
+	ID     *int            `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+}
+
+// run sends the messages of the client to a server and returns its responses by id and its notifications
+func (c *testClient) run(t *testing.T) (map[int]testMessage, []testMessage) {
+	var out bytes.Buffer
+	if err := NewServer(&c.input, &out).Run(); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	responses := make(map[int]testMessage)
+	var notifications []testMessage
+	r := bufio.NewReader(&out)
+	for {
+		content, err := readMessage(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		var m testMessage
+		if err := json.Unmarshal(content, &m); err != nil {
+			t.Fatal(err)
+		}
+		if m.ID == nil {
+			notifications = append(notifications, m)
+		} else {
+			responses[*m.ID] = m
+		}
+	}
+	return responses, notifications
+}
+
+func decode(t *testing.T, m testMessage, v interface{}) {
+	if m.Error != nil {
+		t.Fatalf("unexpected error %v", m.Error)
+	}
+	if err := json.Unmarshal(m.Result, v); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestServer_Run(t *testing.T) {
+	c := &testClient{}
+	initialize := c.send("initialize", map[string]interface{}{}, true)
+	c.send("initialized", map[string]interface{}{}, false)
+	c.send("textDocument/didOpen", DidOpenTextDocumentParams{TextDocument: TextDocumentItem{URI: testURI, LanguageID: "klang", Version: 1, Text: testScript}}, false)
+	keywords := c.at("textDocument/completion", 12, 0)
+	kubectlVerbs := c.at("textDocument/completion", 8, 14)
+	prefix := c.at("textDocument/completion", 9, 6)
+	inString := c.at("textDocument/completion", 6, 12)
+	hoverBuiltin := c.at("textDocument/hover", 9, 5)
+	hoverVerb := c.at("textDocument/hover", 8, 17)
+	hoverVariable := c.at("textDocument/hover", 9, 42)
+	hoverFunction := c.at("textDocument/hover", 9, 38)
+	hoverNothing := c.at("textDocument/hover", 2, 11)
+	definitionVariable := c.at("textDocument/definition", 4, 14)
+	definitionParameter := c.at("textDocument/definition", 4, 10)
+	definitionFunction := c.at("textDocument/definition", 9, 36)
+	definitionModule := c.at("textDocument/definition", 11, 9)
+	definitionNothing := c.at("textDocument/definition", 9, 20)
+	symbols := c.send("textDocument/documentSymbol", DocumentSymbolParams{TextDocument: TextDocumentIdentifier{URI: testURI}}, true)
+	unknown := c.send("workspace/unknown", map[string]interface{}{}, true)
+	c.send("textDocument/didChange", DidChangeTextDocumentParams{
+		TextDocument:   VersionedTextDocumentIdentifier{URI: testURI, Version: 2},
+		ContentChanges: []TextDocumentContentChangeEvent{{Text: "x = ;\ny = z;"}},
+	}, false)
+	c.send("textDocument/didChange", DidChangeTextDocumentParams{
+		TextDocument:   VersionedTextDocumentIdentifier{URI: testURI, Version: 3},
+		ContentChanges: []TextDocumentContentChangeEvent{{Text: "y = z;\nexit 0;\nlog y;"}},
+	}, false)
+	c.send("textDocument/didClose", DidCloseTextDocumentParams{TextDocument: TextDocumentIdentifier{URI: testURI}}, false)
+	closed := c.at("textDocument/hover", 0, 0)
+	shutdown := c.send("shutdown", nil, true)
+	c.send("exit", nil, false)
+	responses, notifications := c.run(t)
+
+	var result InitializeResult
+	decode(t, responses[initialize], &result)
+	if !result.Capabilities.HoverProvider || !result.Capabilities.DefinitionProvider || !result.Capabilities.DocumentSymbolProvider ||
+		result.Capabilities.CompletionProvider == nil || result.Capabilities.TextDocumentSync != TextDocumentSyncKindFull {
+		t.Errorf("unexpected capabilities %+v", result.Capabilities)
+	}
+
+	t.Run("diagnostics", func(t *testing.T) {
+		want := []string{
+			"[]",
+			"[{0 4 0 5 error no viable alternative at input 'x=;'}]",
+			"[{0 4 0 5 error undefined variable z} {2 0 2 3 warning unreachable code after exit}]",
+			"[]",
+		}
+		if len(notifications) != len(want) {
+			t.Fatalf("expected %d notifications, found %d", len(want), len(notifications))
+		}
+		for i, n := range notifications {
+			var params PublishDiagnosticsParams
+			if err := json.Unmarshal(n.Params, &params); err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, d := range params.Diagnostics {
+				severity := "error"
+				if d.Severity == DiagnosticSeverityWarning {
+					severity = "warning"
+				}
+				got = append(got, fmt.Sprintf("{%d %d %d %d %s %s}", d.Range.Start.Line, d.Range.Start.Character, d.Range.End.Line, d.Range.End.Character, severity, d.Message))
+			}
+			if s := fmt.Sprintf("%v", got); s != want[i] && !(len(got) == 0 && want[i] == "[]") {
+				t.Errorf("notification %d %s = %s, want %s", i, n.Method, s, want[i])
+			}
+		}
+	})
+
+	t.Run("completion", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			id      int
+			want    []string
+			notWant []string
+		}{
+			{name: "keywords, functions and variables", id: keywords, want: []string{"if", "kubeYamlEdit", "jsonSelect", "kubectl", "len", "replicas", "double", "lib"}, notWant: []string{"apply"}},
+			{name: "kubectl verbs", id: kubectlVerbs, want: []string{"apply", "get", "rollout"}, notWant: []string{"if", "replicas"}},
+			{name: "prefix", id: prefix, want: []string{"kubeYamlEdit", "kubeYamlDelete", "kubectl"}, notWant: []string{"if", "jsonSelect"}},
+			{name: "in string", id: inString},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var list CompletionList
+				decode(t, responses[tt.id], &list)
+				labels := make(map[string]bool)
+				for _, item := range list.Items {
+					labels[item.Label] = true
+				}
+				for _, label := range tt.want {
+					if !labels[label] {
+						t.Errorf("expected %s in %v", label, labels)
+					}
+				}
+				for _, label := range tt.notWant {
+					if labels[label] {
+						t.Errorf("unexpected %s", label)
+					}
+				}
+				if tt.want == nil && len(list.Items) != 0 {
+					t.Errorf("expected no items, found %d", len(list.Items))
+				}
+			})
+		}
+	})
+
+	t.Run("hover", func(t *testing.T) {
+		tests := []struct {
+			name string
+			id   int
+			want string
+		}{
+			{name: "builtin", id: hoverBuiltin, want: "```klang\nkubeYamlEdit(doc, path, value, filter, \"asObject\");\n```\nSets the value at path of the kubernetes objects in the yaml of the variable doc, filter like group/Kind/namespace/name selects the objects. With \"asObject\" a string value is parsed as json or yaml."},
+			{name: "kubectl verb", id: hoverVerb, want: "```klang\nkubectl get [-n namespace | -A] kind/name [-l selector] [--field-selector selector] [-o format]\n```\nReturns an object or a list of objects as json or in the output format."},
+			{name: "variable", id: hoverVariable, want: "```klang\nreplicas = 2;\n```\nvariable defined at line 3"},
+			{name: "function", id: hoverFunction, want: "```klang\nfunc double(n)\n```\nfunction declared at line 4"},
+			{name: "number", id: hoverNothing, want: "null"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if tt.want == "null" {
+					if string(responses[tt.id].Result) != "null" {
+						t.Errorf("expected null, found %s", responses[tt.id].Result)
+					}
+					return
+				}
+				var hover Hover
+				decode(t, responses[tt.id], &hover)
+				if hover.Contents.Value != tt.want {
+					t.Errorf("hover = %q, want %q", hover.Contents.Value, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("definition", func(t *testing.T) {
+		tests := []struct {
+			name string
+			id   int
+			want []Location
+		}{
+			{name: "global used in a function", id: definitionVariable, want: []Location{{URI: testURI, Range: Range{Start: Position{Line: 2, Character: 0}, End: Position{Line: 2, Character: 8}}}}},
+			{name: "parameter", id: definitionParameter, want: []Location{{URI: testURI, Range: Range{Start: Position{Line: 3, Character: 12}, End: Position{Line: 3, Character: 13}}}}},
+			{name: "function", id: definitionFunction, want: []Location{{URI: testURI, Range: Range{Start: Position{Line: 3, Character: 5}, End: Position{Line: 3, Character: 11}}}}},
+			{name: "module", id: definitionModule, want: []Location{{URI: testURI, Range: Range{Start: Position{Line: 0, Character: 18}, End: Position{Line: 0, Character: 21}}}}},
+			{name: "string", id: definitionNothing, want: []Location{}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var locations []Location
+				decode(t, responses[tt.id], &locations)
+				if fmt.Sprint(locations) != fmt.Sprint(tt.want) {
+					t.Errorf("definition = %v, want %v", locations, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("document symbols", func(t *testing.T) {
+		var got []DocumentSymbol
+		decode(t, responses[symbols], &got)
+		want := []DocumentSymbol{
+			{Name: "prepare", Detail: "step", Kind: SymbolKindNamespace,
+				Range:          Range{Start: Position{Line: 1, Character: 0}, End: Position{Line: 5, Character: 1}},
+				SelectionRange: Range{Start: Position{Line: 1, Character: 9}, End: Position{Line: 1, Character: 18}},
+				Children: []DocumentSymbol{{Name: "double", Detail: "func double(n)", Kind: SymbolKindFunction,
+					Range:          Range{Start: Position{Line: 3, Character: 0}, End: Position{Line: 5, Character: 1}},
+					SelectionRange: Range{Start: Position{Line: 3, Character: 5}, End: Position{Line: 3, Character: 11}},
+				}},
+			},
+			{Name: "install", Detail: "step", Kind: SymbolKindNamespace,
+				Range:          Range{Start: Position{Line: 6, Character: 0}, End: Position{Line: 11, Character: 23}},
+				SelectionRange: Range{Start: Position{Line: 6, Character: 9}, End: Position{Line: 6, Character: 18}},
+			},
+		}
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("documentSymbol = %+v, want %+v", got, want)
+		}
+	})
+
+	if responses[unknown].Error == nil || responses[unknown].Error.Code != codeMethodNotFound {
+		t.Errorf("expected method not found, found %+v", responses[unknown])
+	}
+	if responses[closed].Error == nil || responses[closed].Error.Code != codeInvalidParams {
+		t.Errorf("expected closed document error, found %+v", responses[closed])
+	}
+	if responses[shutdown].Error != nil {
+		t.Errorf("unexpected shutdown error %v", responses[shutdown].Error)
+	}
+}
+
+func TestServer_Run_exitBeforeShutdown(t *testing.T) {
+	c := &testClient{}
+	c.send("exit", nil, false)
+	if err := NewServer(&c.input, &bytes.Buffer{}).Run(); err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestDocument_position(t *testing.T) {
+	d := newDocument(testURI, "s = \"é😀\"; t = 1;")
+	tokens := d.tokens
+	//the emoji takes two utf-16 code units but is a single character for the parser
+	if r := d.tokenRange(tokens[4]); r.Start.Character != 11 || r.End.Character != 12 {
+		t.Errorf("range of %s = %+v", tokens[4].GetText(), r)
+	}
+	if line, column := d.column(Position{Line: 0, Character: 11}); line != 1 || column != 10 {
+		t.Errorf("column = %d:%d, want 1:10", line, column)
+	}
+	if tok := d.tokenAt(Position{Line: 0, Character: 11}); tok == nil || tok.GetText() != "t" {
+		t.Errorf("tokenAt() = %v, want t", tok)
+	}
+}
+
+func TestDocument_definitionScopes(t *testing.T) {
+	d := newDocument(testURI, "x = 1;\nif x > 0 {\n  let x = 2;\n  log x;\n}\nfor k, v in {a: 1} {\n  log v;\n}\nfunc f() {\n  x = 3;\n  y = 4;\n  return y;\n}\nlog x;\n")
+	tests := []struct {
+		name string
+		at   Position
+		want Position
+	}{
+		{name: "global in condition", at: Position{Line: 1, Character: 3}, want: Position{Line: 0, Character: 0}},
+		{name: "let shadows the global", at: Position{Line: 3, Character: 6}, want: Position{Line: 2, Character: 6}},
+		{name: "loop variable", at: Position{Line: 6, Character: 6}, want: Position{Line: 5, Character: 7}},
+		{name: "global assigned in a function", at: Position{Line: 9, Character: 2}, want: Position{Line: 0, Character: 0}},
+		{name: "local of a function", at: Position{Line: 11, Character: 9}, want: Position{Line: 10, Character: 2}},
+		{name: "global after the block", at: Position{Line: 13, Character: 4}, want: Position{Line: 0, Character: 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			locations := d.definition(tt.at)
+			if len(locations) != 1 || locations[0].Range.Start != tt.want {
+				t.Errorf("definition() = %v, want %v", locations, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2020 Devtron Labs Pvt Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lsp
+
+import (
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/devtron-labs/inception/pkg/language"
+	"github.com/devtron-labs/inception/pkg/language/parser"
+)
+
+// documentSymbols outlines the script: a stepInfo statement starts a section which lasts until the next
+// stepInfo of the same block or the end of the block, functions are listed in the section declaring them
+func (d *document) documentSymbols() []DocumentSymbol {
+	pc, ok := d.tree.(*parser.ParseContext)
+	if !ok || pc.Block() == nil {
+		return make([]DocumentSymbol, 0)
+	}
+	return d.blockSymbols(pc.Block().(*parser.BlockContext).AllStat())
+}
+
+func (d *document) blockSymbols(stats []parser.IStatContext) []DocumentSymbol {
+	symbols := make([]DocumentSymbol, 0)
+	var section *DocumentSymbol
+	for _, stat := range stats {
+		ctx := stat.(*parser.StatContext)
+		if si, ok := ctx.GetChild(0).(*parser.StepInfoContext); ok {
+			if section != nil {
+				symbols = append(symbols, *section)
+			}
+			name := si.GetStop()
+			if si.STRING() != nil {
+				name = si.STRING().GetSymbol()
+			} else if si.RAW_STRING_LIT() != nil {
+				name = si.RAW_STRING_LIT().GetSymbol()
+			}
+			section = &DocumentSymbol{
+				Name:           language.StripQuotes(name.GetText()),
+				Detail:         "step",
+				Kind:           SymbolKindNamespace,
+				Range:          d.ruleRange(ctx),
+				SelectionRange: d.tokenRange(name),
+			}
+			continue
+		}
+		nested := d.statSymbols(ctx)
+		if section == nil {
+			symbols = append(symbols, nested...)
+			continue
+		}
+		section.Range.End = d.ruleRange(ctx).End
+		section.Children = append(section.Children, nested...)
+	}
+	if section != nil {
+		symbols = append(symbols, *section)
+	}
+	return symbols
+}
+
+// statSymbols returns the symbols of a function declaration or of the blocks of a statement
+func (d *document) statSymbols(ctx *parser.StatContext) []DocumentSymbol {
+	var symbols []DocumentSymbol
+	var visit func(tree antlr.Tree)
+	visit = func(tree antlr.Tree) {
+		switch v := tree.(type) {
+		case *parser.BlockContext:
+			symbols = append(symbols, d.blockSymbols(v.AllStat())...)
+			return
+		case *parser.Func_declContext:
+			if v.ID() == nil {
+				return
+			}
+			var children []DocumentSymbol
+			if v.Block() != nil {
+				children = d.blockSymbols(v.Block().(*parser.BlockContext).AllStat())
+			}
+			symbols = append(symbols, DocumentSymbol{
+				Name:           v.ID().GetText(),
+				Detail:         d.symbolDetail(&symbol{decl: v}),
+				Kind:           SymbolKindFunction,
+				Range:          d.ruleRange(v),
+				SelectionRange: d.tokenRange(v.ID().GetSymbol()),
+				Children:       children,
+			})
+			return
+		case *parser.StatContext:
+			if v != ctx {
+				symbols = append(symbols, d.blockSymbols([]parser.IStatContext{v})...)
+				return
+			}
+		}
+		for _, child := range tree.GetChildren() {
+			visit(child)
+		}
+	}
+	visit(ctx)
+	return symbols
+}